@@ -1,10 +1,27 @@
 package app
 
 import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
+	"github.com/pokt-network/pocket-core/store"
+	"github.com/pokt-network/pocket-core/store/export"
+	"github.com/pokt-network/pocket-core/store/rootmulti"
+	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmState "github.com/tendermint/tendermint/state"
+	tmStore "github.com/tendermint/tendermint/store"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 )
 
 /*
@@ -27,3 +44,194 @@ func TestAppVersionIsSensible(t *testing.T) {
 		require.True(t, exp.MatchString(matcher))
 	}
 }
+
+func TestStatePruning(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	ctx, err := PCA.NewContext(PCA.LastBlockHeight())
+	require.NoError(t, err)
+	history := PCA.pocketKeeper.StateHistory(ctx)
+	// every version is kept by default
+	require.Equal(t, store.PruneNothing, PCA.StatePruning(ctx))
+	// the versions kept are raised to the state history needed by pocketcore
+	PCA.SetPruning(store.NewPruningOptions(10, 0))
+	require.Equal(t, store.NewPruningOptions(history, 0), PCA.StatePruning(ctx))
+	PCA.SetPruning(store.NewPruningOptions(history+10, 100))
+	require.Equal(t, store.NewPruningOptions(history+10, 100), PCA.StatePruning(ctx))
+	PCA.SetPruning(store.PruneNothing)
+	cleanup()
+	stopCli()
+}
+
+func TestSnapshot(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	<-evtChan // Wait for block
+	<-evtChan // Wait for block
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	// the block after the height of the snapshot is needed
+	height := PCA.BlockStore().Height() - 1
+	snapshotDir := filepath.Join(dir, "snapshot")
+	manifest, err := PCA.CreateSnapshot(height, snapshotDir, PCA.BlockStore(), PCA.TMNode().StateDB())
+	require.NoError(t, err)
+	require.Equal(t, height, manifest.Height)
+	require.Equal(t, int64(1), manifest.FromHeight)
+	_, err = PCA.CreateSnapshot(PCA.BlockStore().Height()+1, filepath.Join(dir, "missing"), PCA.BlockStore(), PCA.TMNode().StateDB())
+	require.Error(t, err)
+	expected := PCA.BlockStore().LoadBlock(height + 1).AppHash
+	trusted := PCA.BlockStore().LoadBlock(height + 1).Hash()
+	require.Equal(t, hex.EncodeToString(trusted), manifest.NextBlockHash)
+	cleanup()
+	stopCli()
+	// restore to a new node
+	restoreFrom := func(snapshotDir string, trusted []byte) (*PocketCoreApp, *tmStore.BlockStore, dbm.DB, error) {
+		a := GetApp(log.NewNopLogger(), dbm.NewMemDB(), nil)
+		blockStore, stateDB := tmStore.NewBlockStore(dbm.NewMemDB()), dbm.NewMemDB()
+		a.SetBlockstore(blockStore)
+		_, err := a.RestoreSnapshot(snapshotDir, blockStore, stateDB, trusted)
+		return a, blockStore, stateDB, err
+	}
+	restore := func() (*PocketCoreApp, *tmStore.BlockStore, dbm.DB, error) {
+		return restoreFrom(snapshotDir, trusted)
+	}
+	// forges a snapshot from the records of the snapshot
+	forge := func(name string, fn func(kind byte, key, value []byte) ([]byte, bool)) string {
+		forged := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(forged, os.ModePerm))
+		w := &snapshotWriter{dir: forged}
+		require.NoError(t, readSnapshot(snapshotDir, manifest, func(kind byte, key, value []byte) error {
+			if value, ok := fn(kind, key, value); ok {
+				return w.write(kind, key, value)
+			}
+			return nil
+		}))
+		require.NoError(t, w.flush())
+		m := manifest
+		m.Chunks = w.hashes
+		j, err := json.Marshal(m)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(forged, SnapshotManifestName), j, 0644))
+		return forged
+	}
+	// the validators of the state are verified against the headers
+	forged := forge("validators", func(kind byte, key, value []byte) ([]byte, bool) {
+		if kind != snapshotStateRecord {
+			return value, true
+		}
+		state := new(tmState.State)
+		require.NoError(t, snapshotCdc.UnmarshalBinaryBare(value, state))
+		state.NextValidators.Validators[0].VotingPower++
+		return snapshotCdc.MustMarshalBinaryBare(state), true
+	})
+	_, blockStore, stateDB, err := restoreFrom(forged, trusted)
+	require.Error(t, err)
+	require.Zero(t, blockStore.Height()) // nothing written
+	require.Zero(t, tmState.LoadState(stateDB).LastBlockHeight)
+	// every version of the history is verified
+	forged = forge("history", func(kind byte, key, value []byte) ([]byte, bool) {
+		return value, kind != snapshotAppRecord || string(key) != fmt.Sprintf("s/%d", manifest.FromHeight)
+	})
+	_, _, _, err = restoreFrom(forged, trusted)
+	require.Error(t, err)
+	// the restore is anchored on the trusted header
+	_, _, _, err = restoreFrom(snapshotDir, expected)
+	require.Error(t, err)
+	a, blockStore, stateDB, err := restore()
+	require.NoError(t, err)
+	require.Equal(t, height, a.LastBlockHeight())
+	require.Equal(t, []byte(expected), a.LastCommitID().Hash)
+	require.Equal(t, height, blockStore.Height())
+	require.Equal(t, manifest.FromHeight, blockStore.Base())
+	state := tmState.LoadState(stateDB)
+	require.Equal(t, height, state.LastBlockHeight)
+	require.Equal(t, []byte(expected), []byte(state.AppHash))
+	_, err = a.RestoreSnapshot(snapshotDir, blockStore, stateDB, trusted)
+	require.Error(t, err) // not empty
+	// a trusted block hash is needed
+	untrusted := GetApp(log.NewNopLogger(), dbm.NewMemDB(), nil)
+	_, err = untrusted.RestoreSnapshot(snapshotDir, tmStore.NewBlockStore(dbm.NewMemDB()), dbm.NewMemDB(), nil)
+	require.Error(t, err)
+	// a tampered chunk is rejected
+	chunk := filepath.Join(snapshotDir, snapshotChunkName(0))
+	bz, err := ioutil.ReadFile(chunk)
+	require.NoError(t, err)
+	bz[len(bz)/2]++
+	require.NoError(t, ioutil.WriteFile(chunk, bz, 0644))
+	_, _, _, err = restore()
+	require.Error(t, err)
+}
+
+func TestStateRecordsExport(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNodeProto(t, oneAppTwoNodeGenesis())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	height := PCA.LastBlockHeight()
+	var buf bytes.Buffer
+	exported, err := PCA.ExportStateRecords(height, &buf)
+	require.NoError(t, err)
+	require.NotZero(t, exported)
+	original, err := PCA.Store().(*rootmulti.Store).LoadLazyVersion(height)
+	require.NoError(t, err)
+	originalKeys := PCA.Keys
+	<-evtChan // Wait for the block with the app hash of the height
+	expected := PCA.BlockStore().LoadBlock(height + 1).AppHash
+	cleanup()
+	stopCli()
+	// import at another height
+	a := GetApp(log.NewNopLogger(), dbm.NewMemDB(), nil)
+	commitID, imported, err := a.ImportStateRecords(bytes.NewReader(buf.Bytes()), 1000)
+	require.NoError(t, err)
+	require.Equal(t, exported, imported)
+	require.Equal(t, int64(1000), commitID.Version)
+	require.Equal(t, int64(1000), a.LastBlockHeight())
+	restored, err := a.Store().(*rootmulti.Store).LoadLazyVersion(1000)
+	require.NoError(t, err)
+	for name, key := range a.Keys {
+		it, err := (*original).(sdk.MultiStore).GetKVStore(originalKeys[name]).Iterator(nil, nil)
+		require.NoError(t, err)
+		for ; it.Valid(); it.Next() {
+			value, err := (*restored).(sdk.MultiStore).GetKVStore(key).Get(it.Key())
+			require.NoError(t, err)
+			require.Equal(t, it.Value(), value, name)
+		}
+		it.Close()
+	}
+	require.Equal(t, []byte(expected), commitID.Hash)
+	// the application db must be empty
+	_, _, err = a.ImportStateRecords(bytes.NewReader(buf.Bytes()), 0)
+	require.Error(t, err)
+	// the import must match the app hash of the export
+	var n [binary.MaxVarintLen64]byte
+	tampered := append([]byte{}, buf.Bytes()...)
+	tampered[len(export.Magic)+1+binary.PutVarint(n[:], height)+1]++
+	db := dbm.NewMemDB()
+	a = GetApp(log.NewNopLogger(), db, nil)
+	records := func() map[string][]byte {
+		m := make(map[string][]byte)
+		it, err := db.Iterator(nil, nil)
+		require.NoError(t, err)
+		defer it.Close()
+		for ; it.Valid(); it.Next() {
+			m[string(it.Key())] = append([]byte{}, it.Value()...)
+		}
+		return m
+	}
+	before := records()
+	_, _, err = a.ImportStateRecords(bytes.NewReader(tampered), 0)
+	require.Error(t, err)
+	// nothing is left in the application db
+	require.Equal(t, before, records())
+	require.Zero(t, a.LastBlockHeight())
+	commitID, _, err = a.ImportStateRecords(bytes.NewReader(buf.Bytes()), 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte(expected), commitID.Hash)
+	// the export is imported at its height by default
+	a = GetApp(log.NewNopLogger(), dbm.NewMemDB(), nil)
+	commitID, _, err = a.ImportStateRecords(bytes.NewReader(buf.Bytes()), 0)
+	require.NoError(t, err)
+	require.Equal(t, height, commitID.Version)
+}
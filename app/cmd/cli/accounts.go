@@ -16,12 +16,14 @@ import (
 	"github.com/pokt-network/pocket-core/crypto"
 	"github.com/pokt-network/pocket-core/crypto/keys"
 	"github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
 	"github.com/spf13/cobra"
 )
 
 func init() {
 	rootCmd.AddCommand(accountsCmd)
 	accountsCmd.AddCommand(createCmd)
+	accountsCmd.AddCommand(recoverCmd)
 	accountsCmd.AddCommand(getValidator)
 	accountsCmd.AddCommand(setValidator)
 	accountsCmd.AddCommand(deleteCmd)
@@ -35,10 +37,15 @@ func init() {
 	accountsCmd.AddCommand(exportRawCmd)
 	accountsCmd.AddCommand(sendTxCmd)
 	accountsCmd.AddCommand(sendRawTxCmd)
+	accountsCmd.AddCommand(signTxCmd)
 	accountsCmd.AddCommand(newMultiPublicKey)
 	accountsCmd.AddCommand(signMS)
 	accountsCmd.AddCommand(signNexMS)
 	accountsCmd.AddCommand(buildMultisig)
+	accountsCmd.AddCommand(buildMSPartial)
+	accountsCmd.AddCommand(signMSPartial)
+	accountsCmd.AddCommand(combineMSPartial)
+	accountsCmd.AddCommand(inspectMSPartial)
 	accountsCmd.AddCommand(unsafeDeleteCmd)
 }
 
@@ -50,17 +57,33 @@ var accountsCmd = &cobra.Command{
 from creating and deleting accounts; to importing and exporting accounts.`,
 }
 
-var pwd, oldPwd, decryptPwd, encryptPwd string
+var pwd, oldPwd, decryptPwd, encryptPwd, bip39Pwd, mnemonic, pubKey, outputFile, memo string
+var useMnemonic bool
+var hdIndex, hdCount uint32
 
 func init() {
 	buildMultisig.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	createCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	createCmd.Flags().BoolVar(&useMnemonic, "mnemonic", false, "derive the account from a new BIP-39 mnemonic (SLIP-10), printed once so it can be backed up")
+	createCmd.Flags().StringVar(&bip39Pwd, "bip39-pwd", "", "optional BIP-39 passphrase of the mnemonic, non empty usage bypass interactive prompt")
+	createCmd.Flags().Uint32Var(&hdIndex, "index", 0, "the account index of the derivation path (m/44'/635'/0'/0'/<index>')")
+	recoverCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	recoverCmd.Flags().StringVar(&bip39Pwd, "bip39-pwd", "", "optional BIP-39 passphrase of the mnemonic, non empty usage bypass interactive prompt")
+	recoverCmd.Flags().StringVar(&mnemonic, "mnemonic", "", "the BIP-39 mnemonic, non empty usage bypass interactive prompt")
+	recoverCmd.Flags().Uint32Var(&hdIndex, "index", 0, "the first account index of the derivation path (m/44'/635'/0'/0'/<index>')")
+	recoverCmd.Flags().Uint32Var(&hdCount, "count", 1, "the number of accounts to recover, starting at the index")
 	deleteCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	sendTxCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	setValidator.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	signCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	signMS.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	signNexMS.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	signTxCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	signTxCmd.Flags().StringVar(&outputFile, "output", "", "write the signed tx (send-raw-tx params) to the file")
+	buildMSPartial.Flags().StringVar(&memo, "memo", "", "the memo of the multisig tx")
+	signMSPartial.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	signMSPartial.Flags().StringVar(&outputFile, "output", "", "write the signed partial tx to the file instead of updating the partial tx file")
+	addGenerateOnlyFlag(sendTxCmd)
 
 	exportCmd.Flags().StringVar(&decryptPwd, "pwd-decrypt", "", "decrypt passphrase used by the cmd, non empty usage bypass interactive prompt")
 	exportCmd.Flags().StringVar(&encryptPwd, "pwd-encrypt", "", "encrypt passphrase used by the cmd, non empty usage bypass interactive prompt")
@@ -78,10 +101,12 @@ func init() {
 
 // createCmd represents the create command
 var createCmd = &cobra.Command{
-	Use:   "create",
+	Use:   "create [--mnemonic] [--index <index>]",
 	Short: "Create a new account",
 	Long: `Creates and persists a new account in the Keybase.
-Will prompt the user for a passphrase to encrypt the generated keypair.`,
+Will prompt the user for a passphrase to encrypt the generated keypair.
+With --mnemonic, the account is derived from a new BIP-39 mnemonic along m/44'/635'/0'/0'/<index>' (SLIP-10)
+and the mnemonic is printed once: it recovers every account of the path (see accounts recover).`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
 		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
@@ -89,7 +114,17 @@ Will prompt the user for a passphrase to encrypt the generated keypair.`,
 		pass := app.Credentials(pwd)
 		fmt.Print("Enter passphrase again: \n")
 		confirmedpass := app.Credentials(pwd)
-		if pass == confirmedpass {
+		if pass == confirmedpass && useMnemonic {
+			fmt.Print("Enter an optional BIP-39 passphrase (needed with the mnemonic to recover the account): \n")
+			bip39Pass := app.Credentials(bip39Pwd)
+			kp, m, err := kb.CreateMnemonic(bip39Pass, confirmedpass, hdIndex)
+			if err != nil {
+				fmt.Printf("Account generation Failed, %s", err)
+				return
+			}
+			fmt.Printf("Account generated successfully:\nAddress: %s\nPath: %s\n", kp.GetAddress(), keys.HDPathForIndex(hdIndex))
+			fmt.Printf("\nMnemonic (write it down and keep it in a secure place, it is not stored):\n%s\n", m)
+		} else if pass == confirmedpass {
 			kp, err := kb.Create(confirmedpass)
 			if err != nil {
 				fmt.Printf("Account generation Failed, %s", err)
@@ -104,6 +139,38 @@ Will prompt the user for a passphrase to encrypt the generated keypair.`,
 	},
 }
 
+// recoverCmd represents the recover command
+var recoverCmd = &cobra.Command{
+	Use:   "recover [--index <index>] [--count <count>]",
+	Short: "Recover accounts from a mnemonic",
+	Long: `Derives the accounts m/44'/635'/0'/0'/<index>' to m/44'/635'/0'/0'/<index+count-1>' from a BIP-39 mnemonic (SLIP-10) and persists them in the Keybase.
+Will prompt the user for the mnemonic, its optional BIP-39 passphrase and a passphrase to encrypt the recovered keypairs.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
+		fmt.Print("Enter Mnemonic: \n")
+		m := app.Credentials(mnemonic)
+		fmt.Print("Enter the BIP-39 passphrase of the mnemonic (empty if none): \n")
+		bip39Pass := app.Credentials(bip39Pwd)
+		fmt.Print("Enter Passphrase: \n")
+		pass := app.Credentials(pwd)
+		fmt.Print("Enter passphrase again: \n")
+		confirmedpass := app.Credentials(pwd)
+		if pass != confirmedpass {
+			fmt.Println("Account recovery Failed, Passphrases do not match")
+			return
+		}
+		for i := hdIndex; i < hdIndex+hdCount; i++ {
+			kp, err := kb.Recover(m, bip39Pass, confirmedpass, i)
+			if err != nil {
+				fmt.Printf("Account recovery Failed for path %s, %s\n", keys.HDPathForIndex(i), err)
+				continue
+			}
+			fmt.Printf("Account recovered successfully:\nAddress: %s\nPath: %s\n", kp.GetAddress(), keys.HDPathForIndex(i))
+		}
+	},
+}
+
 var getValidator = &cobra.Command{
 	Use:   "get-validator",
 	Short: "Retrieves the main validator from the priv_val file",
@@ -465,26 +532,74 @@ Prompts the user for <fromAddr> account passphrase.`,
 		}
 		memo := args[5]
 		fmt.Printf("Adding Memo: %v\n", memo)
-		fmt.Println("Enter passphrase: ")
-		res, err := SendTransaction(args[0], args[1], app.Credentials(pwd), args[3], types.NewInt(int64(amount)), int64(fees), memo, false)
+		res, err := SendTransaction(args[0], args[1], txPassphrase(), args[3], types.NewInt(int64(amount)), int64(fees), memo, false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
+		broadcastTx(res)
+	},
+}
+
+// signTxCmd represents the sign-tx command
+var signTxCmd = &cobra.Command{
+	Use:   "sign-tx <unsigned-tx-file>",
+	Short: "Sign an unsigned transaction",
+	Long: `Signs the unsigned transaction written by a tx command with --generate-only, with the signer account of the Keybase.
+Doesn't need a connection to a node, so it can be run on an offline machine. Broadcast the signed tx with send-raw-tx.
+Prompts the user for the signer account passphrase.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		bz, err := ioutil.ReadFile(args[0])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		var utx UnsignedTx
+		if err := json.Unmarshal(bz, &utx); err != nil {
+			fmt.Println(err)
+			return
+		}
+		kb, err := app.GetKeybase()
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		fmt.Printf("Signer: %s\nChainID: %s\nMsg: %s\nFee: %s\nMemo: %s\n", utx.Signer, utx.ChainID, string(utx.Msg), utx.Fee, utx.Memo)
+		fmt.Println("Enter passphrase: ")
+		txBz, err := SignTx(app.Codec(), utx, kb, app.Credentials(pwd))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		p := rpc.SendRawTxParams{
+			Addr:        utx.Signer,
+			RawHexBytes: hex.EncodeToString(txBz),
+		}
+		if outputFile != "" {
+			j, err := json.MarshalIndent(p, "", "  ")
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			if err := ioutil.WriteFile(outputFile, j, 0600); err != nil {
+				fmt.Println(err)
+				return
+			}
+			fmt.Printf("Signed tx written to %s\n", outputFile)
+		}
+		fmt.Printf("Signed tx:\n%s\nBroadcast it with: pocket accounts send-raw-tx %s <signed tx>\n", p.RawHexBytes, p.Addr)
 	},
 }
 
+// addGenerateOnlyFlag - Adds the --generate-only flag to the tx commands
+func addGenerateOnlyFlag(cmds ...*cobra.Command) {
+	for _, c := range cmds {
+		c.Flags().StringVar(&generateOnly, "generate-only", "", "write the unsigned tx to the file instead of signing and broadcasting it (see accounts sign-tx)")
+	}
+}
+
 // sendRawTxCmd represents the sendTx command
 var sendRawTxCmd = &cobra.Command{
 	Use:   "send-raw-tx <fromAddr> <txBytes>",
@@ -645,3 +760,185 @@ NOTE: you MUST be the next signer (in order of public keys in the ms public key
 		fmt.Println("Multisig transaction: \n" + hex.EncodeToString(bz))
 	},
 }
+
+var buildMSPartial = &cobra.Command{
+	Use:   "build-ms-partial <json-message> <ordered-comma-separated-hex-pubkeys> <networkID> <fees> <partial-tx-file>",
+	Short: "Build a multisig tx proposal",
+	Long: `Build a multisignature transaction proposal and write it to a JSON partial tx file, without signatures.
+The signers add their signature to the file with sign-ms-partial, in any order; independently signed files are merged with combine-ms-partial.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		rawPKs := strings.Split(strings.TrimSpace(args[1]), ",")
+		var pks []crypto.PublicKey
+		for _, pk := range rawPKs {
+			p, err := crypto.NewPublicKey(pk)
+			if err != nil {
+				fmt.Println(fmt.Errorf("error creating the public key: %v", err))
+				return
+			}
+			pks = append(pks, p)
+		}
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		ptx, err := app.BuildMultisigPartial(args[0], args[2], crypto.PublicKeyMultiSignature{PublicKeys: pks}, int64(fees), memo, false)
+		if err != nil {
+			fmt.Println(fmt.Errorf("error building the multisig: %v", err))
+			return
+		}
+		if err := writePartialTx(args[4], ptx); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Multisig transaction proposal written to %s\n", args[4])
+		printPartialTxStatus(ptx)
+	},
+}
+
+var signMSPartial = &cobra.Command{
+	Use:   "sign-ms-partial <signer-address> <partial-tx-file>",
+	Short: "Sign a multisig tx proposal",
+	Long: `Add the signature of the signer to the multisignature partial tx file (in place, or to --output), in any order of the public keys.
+Once every key signed, the hex encoded std tx is printed.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		ptx, err := readPartialTx(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		if err := app.SignMultisigPartial(args[0], &ptx, app.Credentials(pwd)); err != nil {
+			fmt.Println(fmt.Errorf("error signing the multisig: %v", err))
+			return
+		}
+		out := args[1]
+		if outputFile != "" {
+			out = outputFile
+		}
+		if err := writePartialTx(out, ptx); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Signature added to %s\n", out)
+		printPartialTxStatus(ptx)
+	},
+}
+
+var combineMSPartial = &cobra.Command{
+	Use:   "combine-ms-partial <output-file> <partial-tx-file>...",
+	Short: "Combine multisig tx proposals",
+	Long: `Merge the signatures of independently signed multisignature partial tx files (of the same proposal) into the output file.
+Once every key signed, the hex encoded std tx is printed.`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var partials []auth.MultisigPartialTx
+		for _, f := range args[1:] {
+			ptx, err := readPartialTx(f)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			partials = append(partials, ptx)
+		}
+		ptx := partials[0]
+		if err := ptx.Combine(app.Codec(), partials[1:]...); err != nil {
+			fmt.Println(err)
+			return
+		}
+		if err := writePartialTx(args[0], ptx); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Combined multisig transaction written to %s\n", args[0])
+		printPartialTxStatus(ptx)
+	},
+}
+
+var inspectMSPartial = &cobra.Command{
+	Use:   "inspect-ms-partial <partial-tx-file>",
+	Short: "Inspect a multisig tx proposal",
+	Long:  `Decode the message, fee and memo of the multisignature partial tx file and print the signers that signed and the signers that did not sign yet.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		ptx, err := readPartialTx(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		msg, err := ptx.GetMsg(app.Codec())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		pk, err := ptx.MultisigKey()
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		msgJSON, err := app.Codec().MarshalJSONIndent(msg, "", "  ")
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Multisig Address: %s\nChainID: %s\nMsg:\n%s\nFee: %s\nMemo: %s\n", types.Address(pk.Address()).String(), ptx.ChainID, string(msgJSON), ptx.Fee, ptx.Memo)
+		printPartialTxStatus(ptx)
+	},
+}
+
+// readPartialTx - Reads the multisig partial tx file and verifies its signatures
+func readPartialTx(path string) (auth.MultisigPartialTx, error) {
+	var ptx auth.MultisigPartialTx
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ptx, err
+	}
+	if err := json.Unmarshal(bz, &ptx); err != nil {
+		return ptx, fmt.Errorf("unable to decode the multisig partial tx %s: %s", path, err.Error())
+	}
+	if err := ptx.Verify(app.Codec()); err != nil {
+		return ptx, fmt.Errorf("invalid multisig partial tx %s: %s", path, err.Error())
+	}
+	return ptx, nil
+}
+
+// writePartialTx - Writes the multisig partial tx file
+func writePartialTx(path string, ptx auth.MultisigPartialTx) error {
+	j, err := json.MarshalIndent(ptx, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, j, 0600)
+}
+
+// printPartialTxStatus - Prints the signers of the multisig partial tx and the std tx once every key signed
+func printPartialTxStatus(ptx auth.MultisigPartialTx) {
+	missing := ptx.Missing()
+	fmt.Printf("Signatures: %d/%d\n", len(ptx.PublicKeys)-len(missing), len(ptx.PublicKeys))
+	for _, s := range ptx.Signatures {
+		fmt.Printf("  signed:  %s\n", s.PublicKey)
+	}
+	for _, k := range missing {
+		fmt.Printf("  missing: %s\n", k)
+	}
+	if len(missing) != 0 {
+		return
+	}
+	bz, err := ptx.Encode(app.Codec())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	pk, err := ptx.MultisigKey()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Multisig transaction: \n%s\nBroadcast it with: pocket accounts send-raw-tx %s <multisig transaction>\n", hex.EncodeToString(bz), types.Address(pk.Address()).String())
+}
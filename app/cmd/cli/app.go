@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"regexp"
 	"strconv"
@@ -11,7 +12,9 @@ import (
 
 	"github.com/pokt-network/pocket-core/app"
 	"github.com/pokt-network/pocket-core/crypto/keys/mintkey"
+	"github.com/pokt-network/pocket-core/pkg/relayclient"
 	"github.com/pokt-network/pocket-core/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +23,10 @@ func init() {
 	appCmd.AddCommand(appStakeCmd)
 	appCmd.AddCommand(appUnstakeCmd)
 	appCmd.AddCommand(createAATCmd)
+	appCmd.AddCommand(appDelegateCmd)
+	appCmd.AddCommand(appUndelegateCmd)
+	appCmd.AddCommand(createDelegatedAATCmd)
+	appCmd.AddCommand(appChallengeCmd)
 }
 
 var appCmd = &cobra.Command{
@@ -33,6 +40,14 @@ func init() {
 	appStakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	appUnstakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	createAATCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	appDelegateCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	appUndelegateCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	createDelegatedAATCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	appChallengeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	appChallengeCmd.Flags().StringVar(&dispatcher, "dispatcher", "", "the pocket node (url) used to dispatch the session, defaults to the remoteCLIURL")
+	appChallengeCmd.Flags().BoolVar(&dryRun, "dry-run", false, "only print the detected challenges, without submitting them")
+	appStakeCmd.Flags().StringVar(&pubKey, "pub-key", "", "the hex public key of <fromAddr>, needed with --generate-only if the account is not in the keybase")
+	addGenerateOnlyFlag(appStakeCmd, appUnstakeCmd, appDelegateCmd, appUndelegateCmd)
 }
 
 var appStakeCmd = &cobra.Command{
@@ -63,31 +78,110 @@ If no changes are desired for the parameter, just enter the current param value
 		}
 		rawChains := reg.ReplaceAllString(args[2], "")
 		chains := strings.Split(rawChains, ",")
+		res, err := StakeApp(chains, fromAddr, txPassphrase(), args[3], types.NewInt(int64(amount)), int64(fee), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		broadcastTx(res)
+	},
+}
+
+var appUnstakeCmd = &cobra.Command{
+	Use:   "unstake <fromAddr> <networkID> <fee>",
+	Short: "Unstake an app from the network",
+	Long: `Unstake an app from the network, changing it's status to Unstaking.
+Prompts the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fee, err := strconv.Atoi(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := UnstakeApp(args[0], txPassphrase(), args[1], int64(fee), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		broadcastTx(res)
+	},
+}
+
+var createAATCmd = &cobra.Command{
+	Use:   "create-aat <appAddr> <clientPubKey>",
+	Short: "Creates an application authentication token",
+	Long: `Creates a signed application authentication token (version 0.0.1 of the AAT spec), that can be embedded into application software for Relay servicing.
+Will prompt the user for the <appAddr> account passphrase.
+Read the Application Authentication Token documentation for more information.
+NOTE: USE THIS METHOD AT YOUR OWN RISK. READ THE APPLICATION SECURITY GUIDELINES IN ORDER TO UNDERSTAND WHAT'S THE RECOMMENDED AAT CONFIGURATION FOR YOUR APPLICATION.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		kb := app.MustGetKeybase()
+		if kb == nil {
+			fmt.Println(app.UninitializedKeybaseError)
+			return
+		}
+		addr, err := types.AddressFromHex(args[0])
+		if err != nil {
+			fmt.Printf("Address Error %s", err)
+			return
+		}
+		kp, err := kb.Get(addr)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
 		fmt.Println("Enter passphrase: ")
-		res, err := StakeApp(chains, fromAddr, app.Credentials(pwd), args[3], types.NewInt(int64(amount)), int64(fee), false)
+		cred := app.Credentials(pwd)
+		privkey, err := mintkey.UnarmorDecryptPrivKey(kp.PrivKeyArmor, cred)
+		if err != nil {
+			return
+		}
+		aat, err := app.GenerateAAT(hex.EncodeToString(kp.PublicKey.RawBytes()), args[1], privkey)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
+		fmt.Println(string(aat))
+	},
+}
+
+var appDelegateCmd = &cobra.Command{
+	Use:   "delegate <fromAddr> <gatewayPubKeys> <networkID> <fee>",
+	Short: "Delegate the signing of AATs to gateways",
+	Long: `Authorizes the gateway public keys (comma separated) to sign AATs on behalf of the staked app.
+The list replaces the previously delegated gateways and takes effect at the next session.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fee, err := strconv.Atoi(args[3])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		reg, err := regexp.Compile("[^,a-fA-F0-9]+")
+		if err != nil {
+			log.Fatal(err)
+		}
+		gateways := strings.Split(reg.ReplaceAllString(args[1], ""), ",")
+		res, err := DelegateApp(args[0], gateways, txPassphrase(), args[2], int64(fee), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		broadcastTx(res)
 	},
 }
 
-var appUnstakeCmd = &cobra.Command{
-	Use:   "unstake <fromAddr> <networkID> <fee>",
-	Short: "Unstake an app from the network",
-	Long: `Unstake an app from the network, changing it's status to Unstaking.
-Prompts the user for the <fromAddr> account passphrase.`,
+var appUndelegateCmd = &cobra.Command{
+	Use:   "undelegate <fromAddr> <networkID> <fee>",
+	Short: "Revoke every gateway of the app",
+	Long: `Revokes the gateways allowed to sign AATs on behalf of the staked app, the AATs signed by the gateways are rejected from the next session.
+Will prompt the user for the <fromAddr> account passphrase.`,
 	Args: cobra.ExactArgs(3),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
@@ -96,34 +190,65 @@ Prompts the user for the <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		res, err := UnstakeApp(args[0], app.Credentials(pwd), args[1], int64(fee), false)
+		res, err := DelegateApp(args[0], nil, txPassphrase(), args[1], int64(fee), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		broadcastTx(res)
+	},
+}
+
+var createDelegatedAATCmd = &cobra.Command{
+	Use:   "create-delegated-aat <gatewayAddr> <appPubKey> <clientPubKey>",
+	Short: "Creates an application authentication token signed by a gateway",
+	Long: `Creates an application authentication token for the <appPubKey> signed by the <gatewayAddr> account.
+The app must have delegated the gateway public key (see apps delegate), else the relays of the AAT are rejected.
+Will prompt the user for the <gatewayAddr> account passphrase.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		kb := app.MustGetKeybase()
+		if kb == nil {
+			fmt.Println(app.UninitializedKeybaseError)
+			return
+		}
+		addr, err := types.AddressFromHex(args[0])
+		if err != nil {
+			fmt.Printf("Address Error %s", err)
+			return
+		}
+		kp, err := kb.Get(addr)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
+		fmt.Println("Enter passphrase: ")
+		cred := app.Credentials(pwd)
+		privkey, err := mintkey.UnarmorDecryptPrivKey(kp.PrivKeyArmor, cred)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		aat, err := app.GenerateDelegatedAAT(args[1], args[2], privkey)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		fmt.Println(string(aat))
 	},
 }
 
-var createAATCmd = &cobra.Command{
-	Use:   "create-aat <appAddr> <clientPubKey>",
-	Short: "Creates an application authentication token",
-	Long: `Creates a signed application authentication token (version 0.0.1 of the AAT spec), that can be embedded into application software for Relay servicing.
-Will prompt the user for the <appAddr> account passphrase.
-Read the Application Authentication Token documentation for more information.
-NOTE: USE THIS METHOD AT YOUR OWN RISK. READ THE APPLICATION SECURITY GUIDELINES IN ORDER TO UNDERSTAND WHAT'S THE RECOMMENDED AAT CONFIGURATION FOR YOUR APPLICATION.`,
-	Args: cobra.ExactArgs(2),
+var appChallengeCmd = &cobra.Command{
+	Use:   "challenge <clientAddr> <aatFile> <relayChainID> <payload> [--dispatcher <url>] [--dry-run]",
+	Short: "Sends a relay to every session node and challenges the ones that disagree with the majority",
+	Long: `Sends the same relay <payload> (json: {"data":"","method":"","path":"","headers":{}}) to every node of the session of the <aatFile> application for the <relayChainID>.
+The json responses are compared without their key order (and with the response comparison rules of the chain in the local chains.json, if any);
+for every servicer disagreeing with the majority (at least two servicers agreeing),
+a challenge (the two majority and the minority signed responses) is built and submitted to the servicers of the majority.
+The relays are signed with the <clientAddr> account, which must be the client public key of the AAT.
+Will prompt the user for the <clientAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
 		kb := app.MustGetKeybase()
@@ -141,17 +266,54 @@ NOTE: USE THIS METHOD AT YOUR OWN RISK. READ THE APPLICATION SECURITY GUIDELINES
 			fmt.Println(err)
 			return
 		}
+		bz, err := ioutil.ReadFile(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var aat pocketTypes.AAT
+		if err := json.Unmarshal(bz, &aat); err != nil {
+			fmt.Println("unable to decode the aat: ", err)
+			return
+		}
+		var payload pocketTypes.Payload
+		if err := json.Unmarshal([]byte(args[3]), &payload); err != nil {
+			fmt.Println("unable to decode the payload: ", err)
+			return
+		}
 		fmt.Println("Enter passphrase: ")
 		cred := app.Credentials(pwd)
 		privkey, err := mintkey.UnarmorDecryptPrivKey(kp.PrivKeyArmor, cred)
 		if err != nil {
+			fmt.Println(err)
 			return
 		}
-		aat, err := app.GenerateAAT(hex.EncodeToString(kp.PublicKey.RawBytes()), args[1], privkey)
+		if dispatcher == "" {
+			dispatcher = app.GlobalConfig.PocketConfig.RemoteCLIURL
+		}
+		// the response comparison rules of the local chains.json (if any)
+		comparison := map[string]*pocketTypes.ResponseComparison{args[2]: app.NewHostedChains(false).GetResponseComparison(args[2])}
+		client, err := relayclient.NewClient(aat, privkey, relayclient.Config{Dispatchers: []string{dispatcher}, ResponseComparison: comparison})
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(string(aat))
+		report, err := client.Challenge(args[2], payload, dryRun)
+		if report != nil {
+			j, er := json.MarshalIndent(report, "", "  ")
+			if er != nil {
+				fmt.Println(er)
+				return
+			}
+			fmt.Println(string(j))
+		}
+		if err != nil {
+			fmt.Println(err)
+		}
 	},
 }
+
+var (
+	dispatcher string
+	dryRun     bool
+)
@@ -1,7 +1,6 @@
 package cli
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
@@ -19,6 +18,7 @@ func init() {
 	govCmd.AddCommand(govDAOBurn)
 	govCmd.AddCommand(govChangeParam)
 	govCmd.AddCommand(govUpgrade)
+	govCmd.AddCommand(govEnable)
 }
 
 var govCmd = &cobra.Command{
@@ -33,6 +33,8 @@ func init() {
 	govDAOBurn.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
 	govChangeParam.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
 	govUpgrade.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govEnable.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	addGenerateOnlyFlag(govDAOTransfer, govDAOBurn, govChangeParam, govUpgrade, govEnable)
 }
 
 var govDAOTransfer = &cobra.Command{
@@ -55,24 +57,13 @@ Actions: [burn, transfer]`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		pass := app.Credentials(pwd)
+		pass := txPassphrase()
 		res, err := DAOTx(fromAddr, toAddr, pass, types.NewInt(int64(amount)), "dao_transfer", args[3], int64(fees), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		broadcastTx(res)
 	},
 }
 
@@ -99,24 +90,13 @@ Actions: [burn, transfer]`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		pass := app.Credentials(pwd)
+		pass := txPassphrase()
 		res, err := DAOTx(fromAddr, toAddr, pass, types.NewInt(int64(amount)), "dao_burn", args[3], int64(fees), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		broadcastTx(res)
 	},
 }
 var govChangeParam = &cobra.Command{
@@ -127,29 +107,18 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 	Args: cobra.ExactArgs(5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
-		fmt.Println("Enter Password: ")
 		fees, err := strconv.Atoi(args[4])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
 
-		res, err := ChangeParam(args[0], args[2], []byte(args[3]), app.Credentials(pwd), args[1], int64(fees), false)
+		res, err := ChangeParam(args[0], args[2], []byte(args[3]), txPassphrase(), args[1], int64(fees), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		broadcastTx(res)
 	},
 }
 
@@ -175,23 +144,40 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			return
 		}
 
-		fmt.Println("Enter Password: ")
-		res, err := Upgrade(args[0], u, app.Credentials(pwd), args[3], int64(fees), false)
+		res, err := Upgrade(args[0], u, txPassphrase(), args[3], int64(fees), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
+		broadcastTx(res)
+	},
+}
+
+var govEnable = &cobra.Command{
+	Use:   "enable <fromAddr> <atHeight> <key> <networkID> <fees>",
+	Short: "Enable a protocol feature",
+	Long: `If authorized, enable the protocol feature <key> (OADDR or ADELEG) at <atHeight>, without changing the upgrade height and version.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		i, err := strconv.Atoi(args[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+		u := govTypes.NewFeatureUpgrade(int64(i), args[2])
+		fees, err := strconv.Atoi(args[4])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+
+		res, err := Upgrade(args[0], u, txPassphrase(), args[3], int64(fees), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		broadcastTx(res)
 	},
 }
 
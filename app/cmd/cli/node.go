@@ -1,7 +1,6 @@
 package cli
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
@@ -31,16 +30,25 @@ func init() {
 	nodeStakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	nodeUnstakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	nodeUnjailCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	nodeStakeCmd.Flags().StringVar(&pubKey, "pub-key", "", "the hex public key of <fromAddr>, needed with --generate-only if the account is not in the keybase")
+	nodeStakeCmd.Flags().StringVar(&outputAddr, "output", "", "the optional address receiving the rewards and the unstaked tokens of the node (non-custodial staking)")
+	nodeUnstakeCmd.Flags().StringVar(&operatorAddr, "operator", "", "the address of the node, when <fromAddr> is its output address")
+	addGenerateOnlyFlag(nodeStakeCmd, nodeUnstakeCmd, nodeUnjailCmd)
 }
 
+var outputAddr string
+var operatorAddr string
+
 var nodeStakeCmd = &cobra.Command{
-	Use:   "stake <fromAddr> <amount> <RelayChainIDs> <serviceURI> <networkID> <fee>",
+	Use:   "stake <fromAddr> <amount> <RelayChainIDs> <serviceURI> <networkID> <fee> [--output <outputAddr>]",
 	Short: "Stake a node in the network",
 	Long: `Stake the node into the network, making it available for service.
 Will prompt the user for the <fromAddr> account passphrase. After the 0.6.X upgrade, if the node is already staked, this transaction acts as an *update* transaction.
 A node can updated relayChainIDs, serviceURI, and raise the stake amount with this transaction.
 If the node is currently staked at X and you submit an update with new stake Y. Only Y-X will be subtracted from an account
-If no changes are desired for the parameter, just enter the current param value just as before`,
+If no changes are desired for the parameter, just enter the current param value just as before.
+With --output, the rewards and the unstaked tokens are sent to <outputAddr> instead of <fromAddr> (non-custodial staking).
+The output address can be set once (by the stake or an update) and never changed afterwards`,
 	Args: cobra.ExactArgs(6),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
@@ -62,31 +70,21 @@ If no changes are desired for the parameter, just enter the current param value
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Passphrase: ")
-		res, err := StakeNode(chains, serviceURI, fromAddr, app.Credentials(pwd), args[4], types.NewInt(int64(amount)), int64(fee), false)
+		res, err := StakeNode(chains, serviceURI, fromAddr, outputAddr, txPassphrase(), args[4], types.NewInt(int64(amount)), int64(fee), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		broadcastTx(res)
 	},
 }
 
 var nodeUnstakeCmd = &cobra.Command{
-	Use:   "unstake <fromAddr> <networkID> <fee>",
+	Use:   "unstake <fromAddr> <networkID> <fee> [--operator <operatorAddr>]",
 	Short: "Unstake a node in the network",
 	Long: `Unstake a node from the network, changing it's status to Unstaking.
-Will prompt the user for the <fromAddr> account passphrase.`,
+Will prompt the user for the <fromAddr> account passphrase.
+The output address of a node may unstake it: <fromAddr> is then the output address and --operator the address of the node.`,
 	Args: cobra.ExactArgs(3),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
@@ -95,23 +93,12 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		res, err := UnstakeNode(args[0], app.Credentials(pwd), args[1], int64(fee), false)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		j, err := json.Marshal(res)
+		res, err := UnstakeNode(args[0], operatorAddr, txPassphrase(), args[1], int64(fee), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		broadcastTx(res)
 	},
 }
 
@@ -128,22 +115,11 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		res, err := UnjailNode(args[0], app.Credentials(pwd), args[1], int64(fee), false)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		res, err := UnjailNode(args[0], txPassphrase(), args[1], int64(fee), false)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		broadcastTx(res)
 	},
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/pokt-network/pocket-core/app"
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/spf13/cobra"
 )
 
@@ -32,6 +33,7 @@ func init() {
 	queryCmd.AddCommand(queryAppParams)
 	queryCmd.AddCommand(queryNodeClaims)
 	queryCmd.AddCommand(queryNodeClaim)
+	queryCmd.AddCommand(queryNodeSessions)
 	queryCmd.AddCommand(queryPocketParams)
 	queryCmd.AddCommand(queryPocketSupportedChains)
 	queryCmd.AddCommand(querySupply)
@@ -41,6 +43,8 @@ func init() {
 	queryCmd.AddCommand(queryParam)
 	queryCmd.AddCommand(queryDAOOwner)
 	queryCmd.AddCommand(querySigningInfo)
+	queryCmd.AddCommand(queryAccounting)
+	queryCmd.AddCommand(queryClaimEstimates)
 }
 
 var queryCmd = &cobra.Command{
@@ -338,6 +342,7 @@ var nodeJailedStatus string
 var blockchain string
 var nodePage int
 var nodeLimit int
+var nodeOutputAddress string
 
 func init() {
 	queryNodes.Flags().StringVar(&nodeStakingStatus, "staking-status", "", "the staking status of the node")
@@ -345,12 +350,13 @@ func init() {
 	queryNodes.Flags().StringVar(&blockchain, "blockchain", "", "the relay chain identifiers these nodes support")
 	queryNodes.Flags().IntVar(&nodePage, "nodePage", 1, "mark the nodePage you want")
 	queryNodes.Flags().IntVar(&nodeLimit, "nodeLimit", 10000, "reduce the amount of results")
+	queryNodes.Flags().StringVar(&nodeOutputAddress, "output-address", "", "the output address receiving the rewards of the nodes")
 }
 
 // NOTE: flag "blockchain" is defined but not implemented at this time 2020/10/03
 
 var queryNodes = &cobra.Command{
-	Use:   "nodes [--staking-status (staked | unstaking)] [--jailed-status (jailed | unjailed)] [--blockchain <relayChainID>] [--nodePage=<nodePage>] [--nodeLimit=<nodeLimit>] [<height>]",
+	Use:   "nodes [--staking-status (staked | unstaking)] [--jailed-status (jailed | unjailed)] [--blockchain <relayChainID>] [--output-address <outputAddr>] [--nodePage=<nodePage>] [--nodeLimit=<nodeLimit>] [<height>]",
 	Short: "Gets nodes",
 	Long:  `Retrieves the list of all nodes known at the specified <height>.`,
 	// Args:  cobra.ExactArgs(3),
@@ -393,6 +399,13 @@ var queryNodes = &cobra.Command{
 				fmt.Println(fmt.Errorf("unkown jailed status <jailed or unjailed>"))
 			}
 		}
+		if nodeOutputAddress != "" {
+			opts.OutputAddress, err = types.AddressFromHex(nodeOutputAddress)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
 		params := rpc.HeightAndValidatorOptsParams{
 			Height: int64(height),
 			Opts:   opts,
@@ -647,6 +660,44 @@ var queryNodeClaims = &cobra.Command{
 	},
 }
 
+var queryNodeSessions = &cobra.Command{
+	Use:   "node-sessions <nodeAddr> [<height>] [<page>] [<perPage>]",
+	Short: "Gets the sessions of a node",
+	Long: `Retrieves the app sessions <nodeAddr> belongs to, for the current and previous session at <height>.
+The staked apps are checked <perPage> at a time (10 by default, at most 25), see the total pages of the result.`,
+	Args: cobra.RangeArgs(1, 4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		// height, page and per page
+		var opts [3]int
+		for i, arg := range args[1:] {
+			var err error
+			opts[i], err = strconv.Atoi(arg)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.PaginatedHeightAndAddrParams{
+			Height:  int64(opts[0]),
+			Addr:    args[0],
+			Page:    opts[1],
+			PerPage: opts[2],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetNodeSessionsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryNodeClaim = &cobra.Command{
 	Use:   "node-claim <address> <appPubKey> <claimType=(relay | challenge)> <relayChainID> <sessionHeight> [<height>]`",
 	Short: "Gets node pending claim for work completed",
@@ -1003,3 +1054,74 @@ var querySigningInfo = &cobra.Command{
 		fmt.Println(res)
 	},
 }
+
+var accountingChain string
+var accountingApp string
+var accountingSessionHeight int64
+var accountingOutcome string
+var accountingPage int
+var accountingLimit int
+var accountingSummary bool
+
+func init() {
+	queryAccounting.Flags().StringVar(&accountingChain, "chain", "", "the relay chain identifier of the sessions")
+	queryAccounting.Flags().StringVar(&accountingApp, "app", "", "the application public key of the sessions")
+	queryAccounting.Flags().Int64Var(&accountingSessionHeight, "session-height", 0, "the session block height of the sessions")
+	queryAccounting.Flags().StringVar(&accountingOutcome, "outcome", "", "the outcome of the sessions (serviced | claimed | proven | expired | rejected)")
+	queryAccounting.Flags().IntVar(&accountingPage, "page", 1, "mark the page you want")
+	queryAccounting.Flags().IntVar(&accountingLimit, "limit", 30, "reduce the amount of results")
+	queryAccounting.Flags().BoolVar(&accountingSummary, "summary", false, "return the totals of the matching sessions instead of the sessions")
+}
+
+var queryAccounting = &cobra.Command{
+	Use:   "accounting [--chain <relayChainID>] [--app <appPubKey>] [--session-height <sessionHeight>] [--outcome <outcome>] [--page=<page>] [--limit=<limit>] [--summary]",
+	Short: "Gets the accounting of the sessions serviced by this node",
+	Long: `Retrieves the local accounting records of the sessions serviced by this node (relays served, claimed, proven and earned uPOKT).
+Only available against the local node, as it requires the auth token of the node.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		filter := pocketTypes.AccountingFilter{
+			Chain:         accountingChain,
+			AppPubKey:     accountingApp,
+			SessionHeight: accountingSessionHeight,
+			Outcome:       pocketTypes.SessionOutcome(strings.ToLower(accountingOutcome)),
+		}
+		var j []byte
+		var err error
+		path := GetAccountingSessionsPath
+		if accountingSummary {
+			path = GetAccountingSummaryPath
+			j, err = json.Marshal(filter)
+		} else {
+			j, err = json.Marshal(rpc.PaginatedAccountingParams{AccountingFilter: filter, Page: accountingPage, PerPage: accountingLimit})
+		}
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QuerySecuredRPC(path, j, app.GetAuthTokenFromFile())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryClaimEstimates = &cobra.Command{
+	Use:   "claim-estimates",
+	Short: "Estimates the rewards of the pending evidence of this node",
+	Long: `Dry run of the claims: for every piece of evidence stored by this node, estimates the uPOKT earned if it is claimed and proven.
+The estimate uses the RelaysToTokensMultiplier, DAOAllocation and ProposerAllocation of the nodes params, the minimum number of proofs,
+the claim and proof fees and the claim maturity height. Nothing is sent.
+Only available against the local node, as it requires the auth token of the node.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		res, err := QuerySecuredRPC(GetClaimEstimatesPath, []byte("{}"), app.GetAuthTokenFromFile())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
@@ -37,10 +37,14 @@ var (
 	GetPocketParamsPath,
 	GetNodeClaimsPath,
 	GetNodeClaimPath,
+	GetNodeSessionsPath,
 	GetBlockTxsPath,
 	GetSupplyPath,
 	GetAllParamsPath,
 	GetParamPath,
+	GetAccountingSessionsPath,
+	GetAccountingSummaryPath,
+	GetClaimEstimatesPath,
 	GetStopPath string
 )
 
@@ -94,12 +98,20 @@ func init() {
 			GetNodeClaimPath = route.Path
 		case "QueryNodeClaims":
 			GetNodeClaimsPath = route.Path
+		case "QueryNodeSessions":
+			GetNodeSessionsPath = route.Path
 		case "QueryAllParams":
 			GetAllParamsPath = route.Path
 		case "QueryParam":
 			GetParamPath = route.Path
 		case "Stop":
 			GetStopPath = route.Path
+		case "AccountingSessions":
+			GetAccountingSessionsPath = route.Path
+		case "AccountingSummary":
+			GetAccountingSummaryPath = route.Path
+		case "ClaimEstimates":
+			GetClaimEstimatesPath = route.Path
 		default:
 			continue
 		}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"os"
@@ -11,6 +13,9 @@ import (
 	"github.com/pokt-network/pocket-core/app"
 	"github.com/pokt-network/pocket-core/app/cmd/rpc"
 	"github.com/spf13/cobra"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	lite "github.com/tendermint/tendermint/lite2"
+	dbs "github.com/tendermint/tendermint/lite2/store/db"
 )
 
 var (
@@ -25,6 +30,15 @@ var (
 	testnet         bool
 	profileApp      bool
 	useCache        bool
+	trustHeight     int64
+	trustHash       string
+	trustPeriod     time.Duration
+	chainID         string
+	primary         string
+	witnesses       []string
+	upstreams       []string
+	lightPort       string
+	updateInterval  time.Duration
 )
 
 var CLIVersion = app.AppVersion
@@ -56,7 +70,17 @@ func init() {
 	startCmd.Flags().BoolVar(&testnet, "testnet", false, "run with testnet genesis")
 	startCmd.Flags().BoolVar(&profileApp, "profileApp", false, "expose cpu & memory profiling")
 	startCmd.Flags().BoolVar(&useCache, "useCache", false, "use cache")
+	lightCmd.Flags().Int64Var(&trustHeight, "trust-height", 0, "the height of the trusted header, to start the light client from (not needed once headers are verified)")
+	lightCmd.Flags().StringVar(&trustHash, "trust-hash", "", "the hash (hex) of the trusted header")
+	lightCmd.Flags().DurationVar(&trustPeriod, "trust-period", 336*time.Hour, "the period a verified header is trusted, less than the unstaking time")
+	lightCmd.Flags().StringVar(&chainID, "chain-id", "mainnet", "the chain id of the network")
+	lightCmd.Flags().StringVar(&primary, "primary", "", "the tendermint rpc url the headers are fetched from, in the form <protocol>://<host>:<port>")
+	lightCmd.Flags().StringSliceVar(&witnesses, "witnesses", nil, "a comma separated list of tendermint rpc urls the headers are cross-checked with")
+	lightCmd.Flags().StringSliceVar(&upstreams, "upstreams", nil, "a comma separated list of pocket rpc urls the proven state is fetched from, in the form <protocol>://<host>:<port>")
+	lightCmd.Flags().StringVar(&lightPort, "port", "", "the port the queries are served on (default is the rpc port of the config)")
+	lightCmd.Flags().DurationVar(&updateInterval, "update-interval", 5*time.Second, "the interval the latest header is verified at")
 	rootCmd.AddCommand(startCmd)
+	rootCmd.AddCommand(lightCmd)
 	rootCmd.AddCommand(resetCmd)
 	rootCmd.AddCommand(version)
 	rootCmd.AddCommand(stopCmd)
@@ -115,6 +139,75 @@ func start(cmd *cobra.Command, args []string) {
 	}()
 }
 
+// lightCmd represents the light command
+var lightCmd = &cobra.Command{
+	Use:   "light --primary <url> --witnesses <url,...> --upstreams <url,...> [--trust-height <height> --trust-hash <hash>] [--trust-period <duration>] [--chain-id <chainID>] [--port <port>] [--update-interval <duration>]",
+	Short: "starts a light client serving the queries of the state",
+	Long: `Starts a light client: instead of the state, it keeps the headers verified (lite2) from the trusted header,
+and serves the /v1/query/* routes of the state (account, balance, node, app, nodeclaim and height) by fetching the proven
+state from the upstream full nodes. Every response is verified against the app hash of a verified header; a response whose
+proof fails is refused. The other query routes are not available in light mode.
+The trusted header (height & hash) is needed on the first start only; the verified headers are kept in <datadir>.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		if primary == "" || len(witnesses) == 0 || len(upstreams) == 0 {
+			fmt.Println("a primary, at least one witness and one upstream are needed")
+			return
+		}
+		db, err := app.OpenLightClientDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		defer db.Close()
+		logger := tmlog.NewTMLogger(tmlog.NewSyncWriter(os.Stdout)).With("module", "light")
+		var liteClient *lite.Client
+		if trustHeight == 0 {
+			liteClient, err = lite.NewHTTPClientFromTrustedStore(chainID, trustPeriod, primary, witnesses, dbs.New(db, chainID), lite.Logger(logger))
+		} else {
+			hash, er := hex.DecodeString(trustHash)
+			if er != nil {
+				fmt.Println(er)
+				return
+			}
+			liteClient, err = lite.NewHTTPClient(chainID, lite.TrustOptions{Period: trustPeriod, Height: trustHeight, Hash: hash},
+				primary, witnesses, dbs.New(db, chainID), lite.Logger(logger))
+		}
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if lightPort == "" {
+			lightPort = app.GlobalConfig.PocketConfig.RPCPort
+		}
+		timeout := time.Duration(app.GlobalConfig.PocketConfig.RPCTimeout) * time.Millisecond
+		lc := rpc.NewLightClient(liteClient, upstreams, timeout, logger)
+		if err := lc.Start(updateInterval); err != nil {
+			fmt.Println("unable to update the light client: ", err.Error())
+			return
+		}
+		defer lc.Stop()
+		srv := rpc.NewLightRPCServer(lightPort, app.GlobalConfig.PocketConfig.RPCTimeout, lc)
+		serveErr := make(chan error, 1)
+		go func() { serveErr <- srv.ListenAndServe() }()
+		fmt.Printf("Serving the verified queries of the state on port %s\n", lightPort)
+		// trap kill signals (2,3,15)
+		signalChannel := make(chan os.Signal, 1)
+		signal.Notify(signalChannel, syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT, os.Interrupt)
+		select {
+		case err := <-serveErr:
+			fmt.Println("the light rpc server stopped: ", err.Error())
+		case sig := <-signalChannel:
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := srv.Shutdown(ctx); err != nil {
+				fmt.Println(err)
+			}
+			fmt.Printf("Exit signal %s received\n", sig)
+		}
+	},
+}
+
 // resetCmd represents the reset command
 var resetCmd = &cobra.Command{
 	Use:   "reset",
@@ -4,9 +4,12 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+
 	"github.com/pokt-network/pocket-core/app"
 	"github.com/pokt-network/pocket-core/app/cmd/rpc"
 	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/crypto"
 	"github.com/pokt-network/pocket-core/crypto/keys"
 	appsType "github.com/pokt-network/pocket-core/x/apps/types"
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
@@ -33,7 +36,7 @@ func SendTransaction(fromAddr, toAddr, passphrase, chainID string, amount sdk.Bi
 	if amount.LTE(sdk.ZeroInt()) {
 		return nil, sdk.ErrInternal("must send above 0")
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -46,27 +49,27 @@ func SendTransaction(fromAddr, toAddr, passphrase, chainID string, amount sdk.Bi
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, memo, legacyCodec)
-	if err != nil {
-		return nil, err
-	}
-	return &rpc.SendRawTxParams{
-		Addr:        fromAddr,
-		RawHexBytes: hex.EncodeToString(txBz),
-	}, nil
+	return newTx(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, memo, legacyCodec)
 }
 
 // StakeNode - Deliver Stake message to node
-func StakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID string, amount sdk.BigInt, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+func StakeNode(chains []string, serviceURL, fromAddr, outputAddr, passphrase, chainID string, amount sdk.BigInt, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	var oa sdk.Address
+	if outputAddr != "" {
+		oa, err = sdk.AddressFromHex(outputAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
-	kp, err := kb.Get(fa)
+	publicKey, err := stakePublicKey(kb, fa)
 	if err != nil {
 		return nil, err
 	}
@@ -91,27 +94,22 @@ func StakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID string
 		return nil, err
 	}
 	msg := nodeTypes.MsgStake{
-		PublicKey:  kp.PublicKey,
-		Chains:     chains,
-		Value:      amount,
-		ServiceUrl: serviceURL,
+		PublicKey:     publicKey,
+		Chains:        chains,
+		Value:         amount,
+		ServiceUrl:    serviceURL,
+		OutputAddress: oa,
 	}
 	err = msg.ValidateBasic()
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
-	if err != nil {
-		return nil, err
-	}
-	return &rpc.SendRawTxParams{
-		Addr:        fromAddr,
-		RawHexBytes: hex.EncodeToString(txBz),
-	}, nil
+	return newTx(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
 }
 
 // UnstakeNode - start unstaking message to node
-func UnstakeNode(fromAddr, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+// the operatorAddr (optional) is the node unstaked by its output address fromAddr
+func UnstakeNode(fromAddr, operatorAddr, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
@@ -119,22 +117,22 @@ func UnstakeNode(fromAddr, passphrase, chainID string, fees int64, legacyCodec b
 	msg := nodeTypes.MsgBeginUnstake{
 		Address: fa,
 	}
-	kb, err := app.GetKeybase()
-	if err != nil {
-		return nil, err
+	if operatorAddr != "" {
+		msg.Address, err = sdk.AddressFromHex(operatorAddr)
+		if err != nil {
+			return nil, err
+		}
+		msg.Signer = fa
 	}
-	err = msg.ValidateBasic()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	err = msg.ValidateBasic()
 	if err != nil {
 		return nil, err
 	}
-	return &rpc.SendRawTxParams{
-		Addr:        fromAddr,
-		RawHexBytes: hex.EncodeToString(txBz),
-	}, nil
+	return newTx(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
 }
 
 // UnjailNode - Remove node from jail
@@ -146,7 +144,7 @@ func UnjailNode(fromAddr, passphrase, chainID string, fees int64, legacyCodec bo
 	msg := nodeTypes.MsgUnjail{
 		ValidatorAddr: fa,
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -154,14 +152,7 @@ func UnjailNode(fromAddr, passphrase, chainID string, fees int64, legacyCodec bo
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
-	if err != nil {
-		return nil, err
-	}
-	return &rpc.SendRawTxParams{
-		Addr:        fromAddr,
-		RawHexBytes: hex.EncodeToString(txBz),
-	}, nil
+	return newTx(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
 }
 
 func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.BigInt, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
@@ -169,11 +160,11 @@ func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
-	kp, err := kb.Get(fa)
+	publicKey, err := stakePublicKey(kb, fa)
 	if err != nil {
 		return nil, err
 	}
@@ -188,7 +179,7 @@ func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.
 		return nil, sdk.ErrInternal("must stake above zero")
 	}
 	msg := appsType.MsgStake{
-		PubKey: kp.PublicKey,
+		PubKey: publicKey,
 		Chains: chains,
 		Value:  amount,
 	}
@@ -196,14 +187,7 @@ func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
-	if err != nil {
-		return nil, err
-	}
-	return &rpc.SendRawTxParams{
-		Addr:        fromAddr,
-		RawHexBytes: hex.EncodeToString(txBz),
-	}, nil
+	return newTx(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
 }
 
 func UnstakeApp(fromAddr, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
@@ -211,7 +195,7 @@ func UnstakeApp(fromAddr, passphrase, chainID string, fees int64, legacyCodec bo
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -222,14 +206,27 @@ func UnstakeApp(fromAddr, passphrase, chainID string, fees int64, legacyCodec bo
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	return newTx(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+}
+
+func DelegateApp(fromAddr string, gatewayPubKeys []string, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	return &rpc.SendRawTxParams{
-		Addr:        fromAddr,
-		RawHexBytes: hex.EncodeToString(txBz),
-	}, nil
+	kb, err := txKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := appsType.MsgDelegate{
+		Address:           fa,
+		GatewayPublicKeys: gatewayPubKeys,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return newTx(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
 }
 
 func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
@@ -241,7 +238,7 @@ func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chain
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -255,14 +252,7 @@ func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chain
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
-	if err != nil {
-		return nil, err
-	}
-	return &rpc.SendRawTxParams{
-		Addr:        fromAddr,
-		RawHexBytes: hex.EncodeToString(txBz),
-	}, nil
+	return newTx(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
 }
 
 func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
@@ -270,7 +260,7 @@ func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, passp
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -289,14 +279,7 @@ func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, passp
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
-	if err != nil {
-		return nil, err
-	}
-	return &rpc.SendRawTxParams{
-		Addr:        fromAddr,
-		RawHexBytes: hex.EncodeToString(txBz),
-	}, nil
+	return newTx(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
 }
 
 func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
@@ -304,7 +287,7 @@ func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID stri
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase()
 	if err != nil {
 		return nil, err
 	}
@@ -316,21 +299,159 @@ func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID stri
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	return newTx(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+}
+
+// generateOnly - the file the unsigned tx is written to (--generate-only) instead of being signed and broadcast
+var generateOnly string
+
+// UnsignedTx - A transaction built without signing it (--generate-only), to be signed offline (accounts sign-tx)
+type UnsignedTx struct {
+	Signer      string          `json:"signer"`
+	ChainID     string          `json:"chain_id"`
+	Msg         json.RawMessage `json:"msg"`
+	Fee         sdk.Coins       `json:"fee"`
+	Memo        string          `json:"memo"`
+	Entropy     int64           `json:"entropy"`
+	LegacyCodec bool            `json:"legacy_codec"`
+}
+
+// NewUnsignedTx - Returns the unsigned tx of the msg, to be signed by the fromAddr
+func NewUnsignedTx(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID string, fee int64, memo string, legacyCodec bool) (UnsignedTx, error) {
+	msgBz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return UnsignedTx{}, err
+	}
+	return UnsignedTx{
+		Signer:      fromAddr.String(),
+		ChainID:     chainID,
+		Msg:         msgBz,
+		Fee:         sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fee))),
+		Memo:        memo,
+		Entropy:     rand.Int64(),
+		LegacyCodec: legacyCodec,
+	}, nil
+}
+
+// GetMsg - Decodes and validates the msg of the unsigned tx
+func (utx UnsignedTx) GetMsg(cdc *codec.Codec) (sdk.ProtoMsg, error) {
+	msg, err := authTypes.UnmarshalJSONProtoMsg(cdc, utx.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the msg of the unsigned tx: %s", err.Error())
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// SignTx - Signs the unsigned tx with the signer account of the keybase and returns the encoded tx
+func SignTx(cdc *codec.Codec, utx UnsignedTx, keybase keys.Keybase, passphrase string) ([]byte, error) {
+	fromAddr, err := sdk.AddressFromHex(utx.Signer)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := utx.GetMsg(cdc)
+	if err != nil {
+		return nil, err
+	}
+	if utx.ChainID == "" {
+		return nil, fmt.Errorf("the chain id of the unsigned tx is empty")
+	}
+	return signTx(cdc, msg, fromAddr, utx.ChainID, keybase, passphrase, utx.Fee, utx.Memo, utx.Entropy, utx.LegacyCodec)
+}
+
+// newTx - Signs the msg and returns the params to broadcast it; with --generate-only, writes the unsigned tx
+// to the file instead and returns nil params
+func newTx(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID string, keybase keys.Keybase, passphrase string, fee int64, memo string, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	if generateOnly != "" {
+		utx, err := NewUnsignedTx(cdc, msg, fromAddr, chainID, fee, memo, legacyCodec)
+		if err != nil {
+			return nil, err
+		}
+		j, err := json.MarshalIndent(utx, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return nil, ioutil.WriteFile(generateOnly, j, 0600)
+	}
+	txBz, err := newTxBz(cdc, msg, fromAddr, chainID, keybase, passphrase, fee, memo, legacyCodec)
 	if err != nil {
 		return nil, err
 	}
 	return &rpc.SendRawTxParams{
-		Addr:        fromAddr,
+		Addr:        fromAddr.String(),
 		RawHexBytes: hex.EncodeToString(txBz),
 	}, nil
 }
 
+// txKeybase - Returns the keybase signing the txs; nil with --generate-only (nothing is signed)
+func txKeybase() (keys.Keybase, error) {
+	if generateOnly != "" {
+		return nil, nil
+	}
+	return app.GetKeybase()
+}
+
+// stakePublicKey - Returns the public key of the staking account: the --pub-key flag, or the keybase
+func stakePublicKey(kb keys.Keybase, fromAddr sdk.Address) (crypto.PublicKey, error) {
+	if pubKey != "" {
+		pk, err := crypto.NewPublicKey(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		if !sdk.Address(pk.Address()).Equals(fromAddr) {
+			return nil, fmt.Errorf("the public key %s is not the key of %s", pubKey, fromAddr)
+		}
+		return pk, nil
+	}
+	if kb == nil {
+		return nil, fmt.Errorf("the public key of %s is needed to generate the stake tx, use --pub-key", fromAddr)
+	}
+	kp, err := kb.Get(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	return kp.PublicKey, nil
+}
+
+// txPassphrase - Prompts for the passphrase of the signing account (nothing to sign with --generate-only)
+func txPassphrase() string {
+	if generateOnly != "" {
+		return ""
+	}
+	fmt.Println("Enter Passphrase: ")
+	return app.Credentials(pwd)
+}
+
+// broadcastTx - Broadcasts the signed tx; with --generate-only, the unsigned tx was written to the file instead
+func broadcastTx(res *rpc.SendRawTxParams) {
+	if res == nil {
+		fmt.Printf("Unsigned tx written to %s, sign it with: pocket accounts sign-tx %s\n", generateOnly, generateOnly)
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	resp, err := QueryRPC(SendRawTxPath, j)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(resp)
+}
+
 func newTxBz(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID string, keybase keys.Keybase, passphrase string, fee int64, memo string, legacyCodec bool) (transactionBz []byte, err error) {
 	// fees
 	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fee)))
 	// entroyp
 	entropy := rand.Int64()
+	return signTx(cdc, msg, fromAddr, chainID, keybase, passphrase, fees, memo, entropy, legacyCodec)
+}
+
+func signTx(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID string, keybase keys.Keybase, passphrase string, fees sdk.Coins, memo string, entropy int64, legacyCodec bool) (transactionBz []byte, err error) {
 	signBytes, err := auth.StdSignBytes(chainID, entropy, fees, msg, memo)
 	if err != nil {
 		return nil, err
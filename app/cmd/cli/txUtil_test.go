@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/pokt-network/pocket-core/crypto/keys"
+	sdk "github.com/pokt-network/pocket-core/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "pocket-test"
+
+func newTestSigner(t *testing.T) (keys.Keybase, sdk.Address) {
+	kb := keys.NewInMemory()
+	kp, err := kb.Create("test")
+	require.NoError(t, err)
+	return kb, sdk.Address(kp.PublicKey.Address())
+}
+
+func newTestMsgSend(from sdk.Address) *nodeTypes.MsgSend {
+	to := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	return &nodeTypes.MsgSend{FromAddress: from, ToAddress: to, Amount: sdk.NewInt(1)}
+}
+
+// assertSignedTx decodes the tx and checks it is the msg signed by the signer
+func assertSignedTx(t *testing.T, txBz []byte, msg sdk.ProtoMsg, signer sdk.Address) authTypes.StdTx {
+	tx, err := authTypes.DefaultTxDecoder(app.Codec())(txBz, -1)
+	require.Nil(t, err)
+	stdTx := tx.(authTypes.StdTx)
+	assert.Equal(t, msg.GetSignBytes(), stdTx.GetMsg().GetSignBytes())
+	signBytes, er := authTypes.StdSignBytes(testChainID, stdTx.Entropy, stdTx.Fee, stdTx.Msg, stdTx.Memo)
+	require.NoError(t, er)
+	sig := stdTx.GetSignature()
+	assert.True(t, sdk.Address(sig.PublicKey.Address()).Equals(signer))
+	assert.True(t, sig.PublicKey.VerifyBytes(signBytes, sig.Signature))
+	return stdTx
+}
+
+func TestNewTx(t *testing.T) {
+	cdc := app.Codec()
+	kb, from := newTestSigner(t)
+	msg := newTestMsgSend(from)
+	// signed
+	params, err := newTx(cdc, msg, from, testChainID, kb, "test", 10000, "memo", false)
+	require.NoError(t, err)
+	assert.Equal(t, from.String(), params.Addr)
+	txBz, err := hex.DecodeString(params.RawHexBytes)
+	require.NoError(t, err)
+	stdTx := assertSignedTx(t, txBz, msg, from)
+	assert.Equal(t, "memo", stdTx.Memo)
+	_, err = newTx(cdc, msg, from, testChainID, kb, "wrong", 10000, "memo", false)
+	assert.Error(t, err)
+	// generate only
+	generateOnly = filepath.Join(t.TempDir(), "tx.json")
+	defer func() { generateOnly = "" }()
+	params, err = newTx(cdc, msg, from, testChainID, nil, "", 10000, "memo", false)
+	require.NoError(t, err)
+	assert.Nil(t, params)
+	bz, err := ioutil.ReadFile(generateOnly)
+	require.NoError(t, err)
+	var utx UnsignedTx
+	require.NoError(t, json.Unmarshal(bz, &utx))
+	assert.Equal(t, from.String(), utx.Signer)
+	assert.Equal(t, testChainID, utx.ChainID)
+	assert.Equal(t, "memo", utx.Memo)
+	assert.Equal(t, "10000"+sdk.DefaultStakeDenom, utx.Fee.String())
+}
+
+func TestUnsignedTx_GetMsg(t *testing.T) {
+	cdc := app.Codec()
+	_, from := newTestSigner(t)
+	msg := newTestMsgSend(from)
+	utx, err := NewUnsignedTx(cdc, msg, from, testChainID, 10000, "", false)
+	require.NoError(t, err)
+	decoded, err := utx.GetMsg(cdc)
+	require.NoError(t, err)
+	assert.Equal(t, msg, decoded)
+	invalid, err := NewUnsignedTx(cdc, &nodeTypes.MsgSend{FromAddress: from, ToAddress: msg.ToAddress, Amount: sdk.ZeroInt()}, from, testChainID, 10000, "", false)
+	require.NoError(t, err)
+	tests := []struct {
+		name string
+		msg  json.RawMessage
+	}{
+		{"missing msg", nil},
+		{"null msg", json.RawMessage("null")},
+		{"not json", json.RawMessage("msg")},
+		{"unknown msg type", json.RawMessage(`{"type":"pos/Unknown","value":{}}`)},
+		{"invalid msg", invalid.Msg},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utx.Msg = tt.msg
+			_, err := utx.GetMsg(cdc)
+			assert.Error(t, err)
+		})
+	}
+	// a missing msg in the file
+	var empty UnsignedTx
+	require.NoError(t, json.Unmarshal([]byte(`{"signer":"`+from.String()+`","msg":null}`), &empty))
+	_, err = empty.GetMsg(cdc)
+	assert.Error(t, err)
+}
+
+func TestSignTx(t *testing.T) {
+	cdc := app.Codec()
+	kb, from := newTestSigner(t)
+	msg := newTestMsgSend(from)
+	utx, err := NewUnsignedTx(cdc, msg, from, testChainID, 10000, "memo", false)
+	require.NoError(t, err)
+	txBz, err := SignTx(cdc, utx, kb, "test")
+	require.NoError(t, err)
+	stdTx := assertSignedTx(t, txBz, msg, from)
+	// the entropy of the unsigned tx is kept, so the signed tx is the one generated
+	assert.Equal(t, utx.Entropy, stdTx.Entropy)
+	_, err = SignTx(cdc, utx, kb, "wrong")
+	assert.Error(t, err)
+	noChainID := utx
+	noChainID.ChainID = ""
+	_, err = SignTx(cdc, noChainID, kb, "test")
+	assert.Error(t, err)
+	badSigner := utx
+	badSigner.Signer = "signer"
+	_, err = SignTx(cdc, badSigner, kb, "test")
+	assert.Error(t, err)
+	noMsg := utx
+	noMsg.Msg = json.RawMessage("null")
+	_, err = SignTx(cdc, noMsg, kb, "test")
+	assert.Error(t, err)
+}
@@ -8,10 +8,13 @@ import (
 	"encoding/hex"
 	"encoding/base64"
 	"crypto/sha256"
+	"io"
 	"os"
+	"path/filepath"
 	"strconv"
 
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/store/export"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/state"
@@ -25,6 +28,13 @@ func init() {
 	utilCmd.AddCommand(unsafeRollbackCmd)
 	utilCmd.AddCommand(exportGenesisForReset)
 	utilCmd.AddCommand(convertPocketEvidenceDB)
+	utilCmd.AddCommand(simulateSessionsCmd)
+	utilCmd.AddCommand(pruneStateCmd)
+	utilCmd.AddCommand(createSnapshotCmd)
+	utilCmd.AddCommand(restoreSnapshotCmd)
+	utilCmd.AddCommand(exportStateCmd)
+	utilCmd.AddCommand(importStateCmd)
+	utilCmd.AddCommand(inspectStateCmd)
 	utilCmd.AddCommand(completionCmd)
 	utilCmd.AddCommand(updateConfigsCmd)
 	utilCmd.AddCommand(printDefaultConfigCmd)
@@ -191,12 +201,334 @@ var convertPocketEvidenceDB = &cobra.Command{
 	},
 }
 
+var simulateSessionsCmd = &cobra.Command{
+	Use:   "simulate-sessions <fromHeight> <toHeight>",
+	Short: "replays the session generation",
+	Long: `Replays the session generation of every session between the heights from the local state: the nodes are drawn from and cross checked
+(jailed, unstaked) with the state of the session block height, like the dispatch at the start of the session does.
+A session without enough eligible nodes for its chain is skipped.
+Prints the selections of the nodes, the nodes of every application session and the uniformity (chi-square) of the selections.
+Optionally filtered by chain (--chain) and application public key (--app); printed as json or csv (--format)`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fromHeight, err := strconv.Atoi(args[0])
+		if err != nil {
+			fmt.Println("error parsing fromHeight: ", err)
+			return
+		}
+		toHeight, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println("error parsing toHeight: ", err)
+			return
+		}
+		if simulationFormat != "json" && simulationFormat != "csv" {
+			fmt.Println("invalid format (json or csv): ", simulationFormat)
+			return
+		}
+		db, err := app.OpenApplicationDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading application database: ", err)
+			return
+		}
+		loggerFile, _ := os.Open(os.DevNull)
+		a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false)
+		// initialize stores
+		blockStore, _, _, _, err := state.BlocksAndStateFromDB(&app.GlobalConfig.TendermintConfig, state.DefaultDBProvider)
+		if err != nil {
+			fmt.Println("err loading blockstore: ", err.Error())
+			return
+		}
+		a.SetBlockstore(blockStore)
+		res, err := a.SimulateSessions(int64(fromHeight), int64(toHeight), simulationChain, simulationApp)
+		if err != nil {
+			fmt.Println("could not simulate the sessions: ", err.Error())
+			return
+		}
+		if simulationFormat == "csv" {
+			c, err := res.CSV()
+			if err != nil {
+				fmt.Println(err.Error())
+				return
+			}
+			fmt.Print(c)
+			return
+		}
+		j, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		fmt.Println(string(j))
+	},
+}
+
+var pruneStateCmd = &cobra.Command{
+	Use:   "prune-state",
+	Short: "prunes the application state",
+	Long: `Deletes the versions of the application state (application.db) not kept by the pruning and compacts the database.
+The pruning is the one of the config (pruning_keep_recent, pruning_keep_every) unless set by --keep-recent and --keep-every;
+the versions kept must cover the state history needed by pocketcore. The node must be stopped`,
+	Args: cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		if cmd.Flags().Changed("keep-recent") {
+			app.GlobalConfig.PocketConfig.PruningKeepRecent = keepRecent
+		}
+		if cmd.Flags().Changed("keep-every") {
+			app.GlobalConfig.PocketConfig.PruningKeepEvery = keepEvery
+		}
+		pruning, err := app.PruningOptions()
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		db, err := app.OpenApplicationDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading application database: ", err)
+			return
+		}
+		defer db.Close()
+		loggerFile, _ := os.Open(os.DevNull)
+		a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false)
+		// initialize stores
+		blockStore, _, _, _, err := state.BlocksAndStateFromDB(&app.GlobalConfig.TendermintConfig, state.DefaultDBProvider)
+		if err != nil {
+			fmt.Println("err loading blockstore: ", err.Error())
+			return
+		}
+		a.SetBlockstore(blockStore)
+		pruned, err := a.PruneState(pruning)
+		if err != nil {
+			fmt.Println("could not prune the state: ", err.Error())
+			return
+		}
+		fmt.Printf("pruned %d versions of the state stores, compacting the database...\n", pruned)
+		if err := app.CompactApplicationDB(db); err != nil {
+			fmt.Println("could not compact the database: ", err.Error())
+			return
+		}
+		fmt.Println("Successfully pruned the state")
+	},
+}
+
+var createSnapshotCmd = &cobra.Command{
+	Use:   "create-snapshot <height>",
+	Short: "snapshots the state of a height",
+	Long: `Writes the snapshot of the state of the height: the application state with the state history needed by pocketcore,
+the blocks of the history and the tendermint state, in hashed chunks. The snapshot is written to <snapshot_dir>/<height>
+unless set by --dir. The block after the height is needed. The node must be stopped`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		height, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			fmt.Println("could not parse height: ", err.Error())
+			return
+		}
+		dir := snapshotDir
+		if dir == "" {
+			dir = filepath.Join(app.SnapshotDir(), args[0])
+		}
+		db, err := app.OpenApplicationDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading application database: ", err)
+			return
+		}
+		defer db.Close()
+		loggerFile, _ := os.Open(os.DevNull)
+		a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false)
+		blockStore, _, blockStoreDB, stateDB, err := state.BlocksAndStateFromDB(&app.GlobalConfig.TendermintConfig, state.DefaultDBProvider)
+		if err != nil {
+			fmt.Println("err loading blockstore: ", err.Error())
+			return
+		}
+		defer blockStoreDB.Close()
+		defer stateDB.Close()
+		a.SetBlockstore(blockStore)
+		manifest, err := a.CreateSnapshot(height, dir, blockStore, stateDB)
+		if err != nil {
+			fmt.Println("could not create the snapshot: ", err.Error())
+			return
+		}
+		fmt.Printf("Successfully created the snapshot of height %d (from height %d, app hash %s, next block hash %s) in %s\n",
+			manifest.Height, manifest.FromHeight, manifest.AppHash, manifest.NextBlockHash, dir)
+	},
+}
+
+var restoreSnapshotCmd = &cobra.Command{
+	Use:   "restore-snapshot <snapshotDir> <blockHash>",
+	Short: "restores a state snapshot to a new node",
+	Long: `Restores the snapshot of the directory to the empty data dir of a new node, verified against the trusted <blockHash>:
+the hash of the block after the height, from a trusted source. The blocks of the history are linked to it, the commit,
+the tendermint state and its validator sets are verified against it, the application state of every height of the
+history against the app hashes of the blocks. Once started, the node block syncs from the height of the snapshot.
+If the restore fails, the data dir must be reset before retrying`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		trustedHash, err := hex.DecodeString(args[1])
+		if err != nil || len(trustedHash) == 0 {
+			fmt.Println("could not decode the block hash: ", args[1])
+			return
+		}
+		db, err := app.OpenApplicationDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading application database: ", err)
+			return
+		}
+		defer db.Close()
+		loggerFile, _ := os.Open(os.DevNull)
+		a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false)
+		blockStore, _, blockStoreDB, stateDB, err := state.BlocksAndStateFromDB(&app.GlobalConfig.TendermintConfig, state.DefaultDBProvider)
+		if err != nil {
+			fmt.Println("err loading blockstore: ", err.Error())
+			return
+		}
+		defer blockStoreDB.Close()
+		defer stateDB.Close()
+		a.SetBlockstore(blockStore)
+		manifest, err := a.RestoreSnapshot(args[0], blockStore, stateDB, trustedHash)
+		if err != nil {
+			fmt.Println("could not restore the snapshot (reset the data dir before retrying): ", err.Error())
+			return
+		}
+		fmt.Printf("Successfully restored the snapshot of height %d (app hash %s)\n", manifest.Height, manifest.AppHash)
+	},
+}
+
+var exportStateCmd = &cobra.Command{
+	Use:   "export-state <height> <file>",
+	Short: "exports the state of a height to a file",
+	Long: `Streams the records (the iavl nodes) of the stores of the modules at the height to the file, with the app hash of the height,
+in chunks of consecutive nodes of a module and key prefix (the first byte of the iavl keys, inner nodes included) with
+checksums (a versioned binary format, see store/export). The node must be stopped`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		height, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			fmt.Println("error parsing height: ", err)
+			return
+		}
+		db, err := app.OpenApplicationDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading application database: ", err)
+			return
+		}
+		defer db.Close()
+		loggerFile, _ := os.Open(os.DevNull)
+		a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false)
+		blockStore, _, _, _, err := state.BlocksAndStateFromDB(&app.GlobalConfig.TendermintConfig, state.DefaultDBProvider)
+		if err != nil {
+			fmt.Println("err loading blockstore: ", err.Error())
+			return
+		}
+		a.SetBlockstore(blockStore)
+		f, err := os.Create(args[1])
+		if err != nil {
+			fmt.Println("could not create the file: ", err.Error())
+			return
+		}
+		defer f.Close()
+		records, err := a.ExportStateRecords(height, f)
+		if err == nil {
+			err = f.Sync()
+		}
+		if err != nil {
+			fmt.Println("could not export the state: ", err.Error())
+			return
+		}
+		fmt.Printf("Successfully exported %d records of the state of height %d to %s\n", records, height, args[1])
+	},
+}
+
+var importStateCmd = &cobra.Command{
+	Use:   "import-state <file>",
+	Short: "imports a state export to the application db",
+	Long: `Rebuilds the stores of the modules in the empty application db (application.db) from the records of the state export,
+verifying the checksums of the chunks, and the app hash of the import against the app hash of the export before it is
+committed (a failed import removes its records). The state is committed at the height of the export unless set by --height.
+The node must be stopped`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		f, err := os.Open(args[0])
+		if err != nil {
+			fmt.Println("could not open the file: ", err.Error())
+			return
+		}
+		defer f.Close()
+		db, err := app.OpenApplicationDB(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("error loading application database: ", err)
+			return
+		}
+		defer db.Close()
+		loggerFile, _ := os.Open(os.DevNull)
+		a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false)
+		commitID, records, err := a.ImportStateRecords(f, importHeight)
+		if err != nil {
+			fmt.Println("could not import the state (reset the application db before retrying): ", err.Error())
+			return
+		}
+		fmt.Printf("Successfully imported %d records at height %d, app hash %X\n", records, commitID.Version, commitID.Hash)
+	},
+}
+
+var inspectStateCmd = &cobra.Command{
+	Use:   "inspect-state <file>",
+	Short: "lists the chunks of a state export",
+	Long:  `Lists the chunks of the state export (module, record type, number of records and checksum), verifying their checksums`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		f, err := os.Open(args[0])
+		if err != nil {
+			fmt.Println("could not open the file: ", err.Error())
+			return
+		}
+		defer f.Close()
+		r, err := export.NewReader(f)
+		if err != nil {
+			fmt.Println("could not read the state export: ", err.Error())
+			return
+		}
+		fmt.Printf("state export of height %d (format %d, app hash %X)\n", r.Header.Height, r.Header.Version, r.Header.AppHash)
+		for {
+			chunk, _, err := r.ReadChunk()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				fmt.Println("invalid state export: ", err.Error())
+				return
+			}
+			fmt.Printf("%s\t0x%02x\t%d\t%X\n", chunk.Module, chunk.RecordType, chunk.Records, chunk.Checksum)
+		}
+		fmt.Printf("%d records\n", r.Records())
+	},
+}
+
 func init() {
+	pruneStateCmd.Flags().Int64Var(&keepRecent, "keep-recent", 0, "the number of recent versions kept (default: pruning_keep_recent of the config)")
+	pruneStateCmd.Flags().Int64Var(&keepEvery, "keep-every", 1, "keep every n-th version, 0 for none (default: pruning_keep_every of the config)")
+	createSnapshotCmd.Flags().StringVar(&snapshotDir, "dir", "", "the directory of the snapshot (default: <snapshot_dir>/<height>)")
+	importStateCmd.Flags().Int64Var(&importHeight, "height", 0, "the height the state is committed at (default: the height of the export)")
 	unsafeRollbackCmd.Flags().BoolVar(&blocks, "blocks", false, "rollback blocks as well as the state")
+	simulateSessionsCmd.Flags().StringVar(&simulationChain, "chain", "", "only simulate the sessions of the chain")
+	simulateSessionsCmd.Flags().StringVar(&simulationApp, "app", "", "only simulate the sessions of the application (public key)")
+	simulateSessionsCmd.Flags().StringVar(&simulationFormat, "format", "json", "the output format: json or csv")
 }
 
 var (
-	blocks bool
+	blocks           bool
+	simulationChain  string
+	simulationApp    string
+	simulationFormat string
+	keepRecent       int64
+	keepEvery        int64
+	snapshotDir      string
+	importHeight     int64
 )
 
 var unsafeRollbackCmd = &cobra.Command{
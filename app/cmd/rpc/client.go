@@ -9,10 +9,13 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/app"
+	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 )
 
@@ -86,6 +89,252 @@ func Relay(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+// RPCBatchRelayResponse is the result of a single relay of a batch, either the signed response or the error
+type RPCBatchRelayResponse struct {
+	Signature string                  `json:"signature,omitempty"`
+	Response  string                  `json:"response,omitempty"`
+	Error     error                   `json:"error,omitempty"`
+	Dispatch  *types.DispatchResponse `json:"dispatch,omitempty"`
+}
+
+// batchRelaysDeadline returns the time a batch of relays runs for, a fifth of the rpc timeout is left to write
+// the results before the timeout handler answers in place of the batch
+func batchRelaysDeadline() time.Duration {
+	timeout := app.GlobalConfig.PocketConfig.RPCTimeout
+	if timeout <= 0 {
+		timeout = sdk.DefaultRPCTimeout
+	}
+	return time.Duration(timeout) * time.Millisecond * 4 / 5
+}
+
+// Relays supports CORS functionality
+// every relay of the batch is validated, stored and executed on its own (concurrently, up to the configured bound)
+// the results are returned in the order of the relays; at the deadline of the batch, the relays not yet started
+// are not executed and the ones still running answer with an error, so the relays that finished are never lost
+func Relays(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var relays []types.Relay
+	if cors(&w, r) {
+		return
+	}
+	if err := PopModel(w, r, ps, &relays); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if len(relays) == 0 {
+		WriteErrorResponse(w, 400, "the batch of relays is empty")
+		return
+	}
+	maxRelays := app.GlobalConfig.PocketConfig.MaxBatchRelays
+	if maxRelays <= 0 {
+		maxRelays = sdk.DefaultMaxBatchRelays
+	}
+	if len(relays) > maxRelays {
+		WriteErrorResponse(w, 400, fmt.Sprintf("the batch of relays exceeds the maximum of %d", maxRelays))
+		return
+	}
+	concurrency := app.GlobalConfig.PocketConfig.BatchRelayConcurrency
+	if concurrency <= 0 {
+		concurrency = sdk.DefaultBatchRelayConcurrency
+	}
+	deadline := time.NewTimer(batchRelaysDeadline())
+	defer deadline.Stop()
+	var (
+		l       sync.Mutex // guards the results and expired
+		expired bool
+		started = make([]bool, len(relays))
+		done    = make([]bool, len(relays))
+		results = make([]RPCBatchRelayResponse, len(relays))
+	)
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		sem := make(chan struct{}, concurrency)
+		var wg sync.WaitGroup
+		for i := range relays {
+			sem <- struct{}{}
+			l.Lock()
+			if expired {
+				l.Unlock()
+				break
+			}
+			started[i] = true
+			l.Unlock()
+			wg.Add(1)
+			go func(i int) {
+				defer func() { <-sem; wg.Done() }()
+				res, dispatch, err := app.PCA.HandleRelay(relays[i])
+				l.Lock()
+				defer l.Unlock()
+				if expired {
+					return
+				}
+				done[i] = true
+				if err != nil {
+					results[i] = RPCBatchRelayResponse{Error: err, Dispatch: dispatch}
+					return
+				}
+				results[i] = RPCBatchRelayResponse{Signature: res.Signature, Response: res.Response}
+			}(i)
+		}
+		wg.Wait()
+	}()
+	select {
+	case <-finished:
+	case <-deadline.C:
+	}
+	l.Lock()
+	expired = true
+	for i := range results {
+		switch {
+		case done[i]:
+		case started[i]:
+			results[i] = RPCBatchRelayResponse{Error: types.NewBatchDeadlineError(types.ModuleName, "finished, it may still be served and stored")}
+		default:
+			results[i] = RPCBatchRelayResponse{Error: types.NewBatchDeadlineError(types.ModuleName, "was started, it was not executed")}
+		}
+	}
+	j, er := json.Marshal(results)
+	l.Unlock()
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	WriteRaw(w, string(j), r.URL.Path, r.Host)
+}
+
+type RPCRelayStreamResponse struct {
+	Signature    string `json:"signature"`
+	ResponseHash string `json:"response_hash"` // the hash of every chunk / message streamed, in order
+}
+
+const (
+	RelayStreamPath          = "/v1/client/relay/stream"
+	ResponseHashTrailer      = "Pocket-Response-Hash"
+	ResponseSignatureTrailer = "Pocket-Signature"
+	ResponseErrorTrailer     = "Pocket-Error"
+	streamTrailerTimeout     = 10 * time.Second
+)
+
+var relayStreamUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// RelayStream supports CORS functionality
+// a websocket upgrade request sends the relay as its first message and receives the signed stream response as its last
+// any other request streams the response body (chunked) and receives the signed stream response in the trailers
+func RelayStream(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if cors(&w, r) {
+		return
+	}
+	if websocket.IsWebSocketUpgrade(r) {
+		relayWebSocketStream(w, r)
+		return
+	}
+	var relay = types.Relay{}
+	if err := PopModel(w, r, ps, &relay); err != nil {
+		response := RPCRelayErrorResponse{
+			Error: err,
+		}
+		j, _ := json.Marshal(response)
+		WriteJSONResponseWithCode(w, string(j), r.URL.Path, r.Host, 400)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		WriteErrorResponse(w, 500, "streaming is not supported by this connection")
+		return
+	}
+	// the stream lifetime is bounded by the relay stream timeout (and the trailers), not the rpc write timeout
+	extendWriteDeadline(r, types.GetStreamTimeout()+streamTrailerTimeout)
+	stream := &httpStreamWriter{w: w, flusher: flusher}
+	res, dispatch, err := app.PCA.HandleStreamRelay(relay, types.HTTPStream, stream)
+	if err != nil {
+		if stream.started {
+			// the status code is already sent, report the error in the trailers
+			w.Header().Set(ResponseErrorTrailer, err.Error())
+			return
+		}
+		response := RPCRelayErrorResponse{
+			Error:    err,
+			Dispatch: dispatch,
+		}
+		j, _ := json.Marshal(response)
+		WriteJSONResponseWithCode(w, string(j), r.URL.Path, r.Host, 400)
+		return
+	}
+	stream.start()
+	w.Header().Set(ResponseHashTrailer, res.Response)
+	w.Header().Set(ResponseSignatureTrailer, res.Signature)
+}
+
+// "relayWebSocketStream" - upgrades the connection, reads the relay and forwards the subscription messages
+func relayWebSocketStream(w http.ResponseWriter, r *http.Request) {
+	conn, err := relayStreamUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied with an http error
+		return
+	}
+	defer conn.Close()
+	// the stream lifetime is bounded by the relay stream timeout, not the rpc server timeouts
+	_ = conn.UnderlyingConn().SetDeadline(time.Time{})
+	var relay = types.Relay{}
+	if err := conn.ReadJSON(&relay); err != nil {
+		_ = conn.WriteJSON(RPCRelayErrorResponse{Error: err})
+		return
+	}
+	stream := &webSocketStreamWriter{conn: conn}
+	res, dispatch, err := app.PCA.HandleStreamRelay(relay, types.WebSocketStream, stream)
+	if err != nil {
+		_ = conn.WriteJSON(RPCRelayErrorResponse{
+			Error:    err,
+			Dispatch: dispatch,
+		})
+		return
+	}
+	_ = conn.WriteJSON(RPCRelayStreamResponse{
+		Signature:    res.Signature,
+		ResponseHash: res.Response,
+	})
+	_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+// "httpStreamWriter" - writes and flushes every chunk of a streamed relay to the http response
+type httpStreamWriter struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	started bool
+}
+
+// "start" - writes the status code and announces the trailers; only done once the stream has begun
+func (s *httpStreamWriter) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	s.w.Header().Set("Trailer", strings.Join([]string{ResponseHashTrailer, ResponseSignatureTrailer, ResponseErrorTrailer}, ", "))
+	s.w.WriteHeader(http.StatusOK)
+}
+
+func (s *httpStreamWriter) WriteChunk(bz []byte) error {
+	s.start()
+	if _, err := s.w.Write(bz); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// "webSocketStreamWriter" - forwards every message of a streamed relay to the websocket client
+type webSocketStreamWriter struct {
+	conn *websocket.Conn
+}
+
+func (s *webSocketStreamWriter) WriteChunk(bz []byte) error {
+	return s.conn.WriteMessage(websocket.TextMessage, bz)
+}
+
 // Stop
 func Stop(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	value := r.URL.Query().Get("authtoken")
@@ -105,6 +354,141 @@ func Stop(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	}
 }
 
+type ReloadChainsResponse struct {
+	Chains []string `json:"chains"`
+}
+
+// ReloadChains reloads the chains file into the running node
+func ReloadChains(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	value := r.URL.Query().Get("authtoken")
+	if value != app.AuthToken.Value {
+		WriteErrorResponse(w, 401, "wrong authtoken "+value)
+		return
+	}
+	chains, err := app.ReloadHostedChains()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res := ReloadChainsResponse{Chains: chains.IDs()}
+	j, er := json.Marshal(res)
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type PaginatedAccountingParams struct {
+	types.AccountingFilter
+	Page    int `json:"page,omitempty"`
+	PerPage int `json:"per_page,omitempty"`
+}
+
+// AccountingSessions returns the accounting records of the sessions serviced by this node
+func AccountingSessions(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	value := r.URL.Query().Get("authtoken")
+	if value != app.AuthToken.Value {
+		WriteErrorResponse(w, 401, "wrong authtoken "+value)
+		return
+	}
+	var params = PaginatedAccountingParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryAccounting(params.AccountingFilter, params.Page, params.PerPage)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := res.JSON()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+// AccountingSummary returns the totals of the sessions serviced by this node
+func AccountingSummary(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	value := r.URL.Query().Get("authtoken")
+	if value != app.AuthToken.Value {
+		WriteErrorResponse(w, 401, "wrong authtoken "+value)
+		return
+	}
+	var params = types.AccountingFilter{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryAccountingSummary(params)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, er := json.Marshal(res)
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type JobsParams struct {
+	Kind  types.JobKind  `json:"kind,omitempty"`
+	State types.JobState `json:"state,omitempty"`
+}
+
+type JobsResponse struct {
+	Jobs []types.Job `json:"jobs"`
+}
+
+// Jobs returns the claim and proof jobs of this node
+func Jobs(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	value := r.URL.Query().Get("authtoken")
+	if value != app.AuthToken.Value {
+		WriteErrorResponse(w, 401, "wrong authtoken "+value)
+		return
+	}
+	var params = JobsParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	jobs, err := app.PCA.QueryJobs(params.Kind, params.State)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, er := json.Marshal(JobsResponse{Jobs: jobs})
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+// ClaimEstimates returns the expected payout of the evidence stored by this node; nothing is sent
+func ClaimEstimates(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	value := r.URL.Query().Get("authtoken")
+	if value != app.AuthToken.Value {
+		WriteErrorResponse(w, 401, "wrong authtoken "+value)
+		return
+	}
+	res, err := app.PCA.QueryClaimEstimates()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, er := json.Marshal(res)
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 // Challenge supports CORS functionality
 func Challenge(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var challenge = types.ChallengeProofInvalidData{}
@@ -0,0 +1,430 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/store/rootmulti"
+	sdk "github.com/pokt-network/pocket-core/types"
+	appTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/pokt-network/pocket-core/x/auth/exported"
+	types2 "github.com/pokt-network/pocket-core/x/auth/types"
+	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	lite "github.com/tendermint/tendermint/lite2"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// LightClient serves the queries of the state from upstream full nodes without keeping the state: every query is
+// answered from a proved query of an upstream (see rootmulti.StateProof), verified against the app hash of a header
+// verified by the lite client; a response whose proof fails is refused.
+// The headers are only verified by the loop of the light client (the lite client is not safe for concurrent
+// verifications): the latest header every update interval, and the headers requested by the queries; the queries
+// read the verified headers from the trusted store
+type LightClient struct {
+	lite      *lite.Client
+	upstreams []string
+	client    *http.Client
+	logger    tmlog.Logger
+	requests  chan headerRequest // the headers to verify, served by the loop
+	quit      chan struct{}
+	stopOnce  sync.Once
+}
+
+type headerRequest struct {
+	height int64
+	res    chan headerResponse
+}
+
+type headerResponse struct {
+	header *tmTypes.Header
+	err    error
+}
+
+// NewLightClient returns a light client verifying the responses of the upstreams (pocket rpc urls) with the lite client
+func NewLightClient(liteClient *lite.Client, upstreams []string, timeout time.Duration, logger tmlog.Logger) *LightClient {
+	return &LightClient{
+		lite:      liteClient,
+		upstreams: upstreams,
+		client:    &http.Client{Timeout: timeout},
+		logger:    logger,
+		requests:  make(chan headerRequest),
+		quit:      make(chan struct{}),
+	}
+}
+
+// Start updates the lite client to the latest header of the primary, then every interval in the background until Stop
+func (lc *LightClient) Start(interval time.Duration) error {
+	if _, err := lc.lite.Update(time.Now()); err != nil {
+		return err
+	}
+	go lc.loop(interval)
+	return nil
+}
+
+// Stop stops the loop of the light client, the pending queries fail
+func (lc *LightClient) Stop() {
+	lc.stopOnce.Do(func() { close(lc.quit) })
+}
+
+// loop updates the lite client and verifies the headers requested, one at a time
+func (lc *LightClient) loop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-lc.quit:
+			return
+		case <-ticker.C:
+			if _, err := lc.lite.Update(time.Now()); err != nil {
+				lc.logger.Error(fmt.Sprintf("unable to update the light client: %s", err.Error()))
+			}
+		case req := <-lc.requests:
+			h, err := lc.lite.VerifyHeaderAtHeight(req.height, time.Now())
+			if err != nil {
+				req.res <- headerResponse{err: err}
+				continue
+			}
+			req.res <- headerResponse{header: h.Header}
+		}
+	}
+}
+
+// NewLightRPCServer returns the server of the routes of the light client
+func NewLightRPCServer(port string, timeout int64, lc *LightClient) *http.Server {
+	return &http.Server{
+		ReadTimeout:       30 * time.Second,
+		ReadHeaderTimeout: 20 * time.Second,
+		WriteTimeout:      60 * time.Second,
+		Addr:              ":" + port,
+		Handler:           http.TimeoutHandler(Router(lc.Routes()), time.Duration(timeout)*time.Millisecond, "Server Timeout Handling Request"),
+	}
+}
+
+// Routes returns the query routes of a full node: the routes of the state that can be proven are served by the light
+// client, the others answer that they are not available in light mode
+func (lc *LightClient) Routes() Routes {
+	handlers := map[string]httprouter.Handle{
+		"QueryAccount":   lc.Account,
+		"QueryApp":       lc.App,
+		"QueryBalance":   lc.Balance,
+		"QueryHeight":    lc.Height,
+		"QueryNode":      lc.Node,
+		"QueryNodeClaim": lc.NodeClaim,
+	}
+	routes := Routes{Route{Name: "AppVersion", Method: "GET", Path: "/v1", HandlerFunc: Version}}
+	for _, route := range GetRoutes() {
+		if !strings.HasPrefix(route.Path, "/v1/query/") {
+			continue
+		}
+		if handler, ok := handlers[route.Name]; ok {
+			route.HandlerFunc = handler
+		} else {
+			route.HandlerFunc = lightUnavailable
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+func lightUnavailable(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	WriteErrorResponse(w, http.StatusNotImplemented, fmt.Sprintf("%s can not be verified in light mode", r.URL.Path))
+}
+
+func (lc *LightClient) Height(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	height, err := lc.latestHeight()
+	if err != nil {
+		WriteErrorResponse(w, http.StatusBadGateway, err.Error())
+		return
+	}
+	j, err := json.Marshal(&queryHeightResponse{Height: height})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func (lc *LightClient) Balance(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	acc, ok := lc.account(w, r, ps, &params)
+	if !ok {
+		return
+	}
+	balance := sdk.NewInt(0)
+	if acc != nil {
+		balance = acc.GetCoins().AmountOf(sdk.DefaultStakeDenom)
+	}
+	s, err := json.MarshalIndent(&queryBalanceResponse{Balance: balance.BigInt()}, "", "")
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
+func (lc *LightClient) Account(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	acc, ok := lc.account(w, r, ps, &params)
+	if !ok {
+		return
+	}
+	s, err := json.Marshal(acc)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
+// account returns the verified account of the params (nil if it does not exist); false once an error is written
+func (lc *LightClient) account(w http.ResponseWriter, r *http.Request, ps httprouter.Params, params *HeightAndAddrParams) (exported.Account, bool) {
+	if err := PopModel(w, r, ps, params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return nil, false
+	}
+	a, err := sdk.AddressFromHex(params.Address)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return nil, false
+	}
+	params.Prove = true
+	proof, ok := lc.verifiedProof(w, "/v1/query/account", types2.StoreKey, types2.AddressStoreKey(a), &params.Height, params)
+	if !ok {
+		return nil, false
+	}
+	acc, err := accountFromProof(proof)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return nil, false
+	}
+	return acc, true
+}
+
+func (lc *LightClient) Node(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	a, err := sdk.AddressFromHex(params.Address)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	params.Prove = true
+	proof, ok := lc.verifiedProof(w, "/v1/query/node", nodeTypes.StoreKey, nodeTypes.KeyForValByAllVals(a), &params.Height, &params)
+	if !ok {
+		return
+	}
+	if len(proof.Value) == 0 {
+		WriteErrorResponse(w, 400, fmt.Sprintf("validator not found for %s", a.String()))
+		return
+	}
+	var res nodeTypes.Validator
+	if err := app.Codec().UnmarshalBinaryLengthPrefixed(proof.Value, &res, proof.Height); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := res.MarshalJSON()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func (lc *LightClient) App(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	a, err := sdk.AddressFromHex(params.Address)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	params.Prove = true
+	proof, ok := lc.verifiedProof(w, "/v1/query/app", appTypes.StoreKey, appTypes.KeyForAppByAllApps(a), &params.Height, &params)
+	if !ok {
+		return
+	}
+	if len(proof.Value) == 0 {
+		WriteErrorResponse(w, 400, appTypes.ErrNoApplicationFound(appTypes.ModuleName).Error())
+		return
+	}
+	var res appTypes.Application
+	if err := app.Codec().UnmarshalBinaryLengthPrefixed(proof.Value, &res, proof.Height); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := res.MarshalJSON()
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func (lc *LightClient) NodeClaim(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = QueryNodeReceiptParam{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	a, err := sdk.AddressFromHex(params.Address)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	et, err := pocketTypes.EvidenceTypeFromString(params.ReceiptType)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	header := pocketTypes.SessionHeader{
+		ApplicationPubKey:  params.AppPubKey,
+		Chain:              params.Blockchain,
+		SessionBlockHeight: params.SBlockHeight,
+	}
+	// the key of a claim does not depend on the context
+	key, err := pocketTypes.KeyForClaim(nil, a, header, et)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	params.Prove = true
+	proof, ok := lc.verifiedProof(w, "/v1/query/nodeclaim", pocketTypes.StoreKey, key, &params.Height, &params)
+	if !ok {
+		return
+	}
+	if len(proof.Value) == 0 {
+		WriteErrorResponse(w, 400, pocketTypes.NewClaimNotFoundError(pocketTypes.ModuleName).Error())
+		return
+	}
+	var res pocketTypes.MsgClaim
+	if err := app.Codec().UnmarshalBinaryBare(proof.Value, &res, proof.Height); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(&res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+// verifiedProof requests the proved query of the params (with their height and prove) from the upstreams and
+// returns the first proof of the key of the store that is verified; the height of the query defaults to the one before
+// the latest verified header. False once an error is written
+func (lc *LightClient) verifiedProof(w http.ResponseWriter, path, store string, key []byte, height *int64, params interface{}) (rootmulti.StateProof, bool) {
+	if *height == 0 {
+		latest, err := lc.latestHeight()
+		if err != nil {
+			WriteErrorResponse(w, http.StatusBadGateway, err.Error())
+			return rootmulti.StateProof{}, false
+		}
+		*height = latest - 1
+	}
+	if *height <= 0 {
+		WriteErrorResponse(w, 400, fmt.Sprintf("invalid height: %d", *height))
+		return rootmulti.StateProof{}, false
+	}
+	body, err := json.Marshal(params)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return rootmulti.StateProof{}, false
+	}
+	// the state of the height is committed by the app hash of the header of the next height
+	header, err := lc.verifyHeader(*height + 1)
+	if err != nil {
+		WriteErrorResponse(w, http.StatusBadGateway, fmt.Sprintf("unable to verify the header of height %d: %s", *height+1, err.Error()))
+		return rootmulti.StateProof{}, false
+	}
+	errs := make([]string, 0, len(lc.upstreams))
+	for _, upstream := range lc.upstreams {
+		proof, err := lc.queryProof(upstream+path, body)
+		if err == nil {
+			err = verifyStateProof(proof, *height, store, key, header)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", upstream, err.Error()))
+			continue
+		}
+		return proof, true
+	}
+	WriteErrorResponse(w, http.StatusBadGateway, "no verified response from the upstreams: "+strings.Join(errs, "; "))
+	return rootmulti.StateProof{}, false
+}
+
+// verifyStateProof verifies that the proof is the one of the key of the store at the height against the header
+func verifyStateProof(proof rootmulti.StateProof, height int64, store string, key []byte, header *tmTypes.Header) error {
+	if proof.Height != height || proof.Store != store || !bytes.Equal(proof.Key, key) {
+		return fmt.Errorf("the proof is of %s/%X at height %d, expected %s/%X at height %d", proof.Store, proof.Key, proof.Height, store, key, height)
+	}
+	if err := proof.VerifyHeader(*header); err != nil {
+		return fmt.Errorf("invalid proof: %s", err.Error())
+	}
+	return nil
+}
+
+// queryProof posts the proved query to the url and returns its proof
+func (lc *LightClient) queryProof(url string, body []byte) (rootmulti.StateProof, error) {
+	resp, err := lc.client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return rootmulti.StateProof{}, err
+	}
+	defer resp.Body.Close()
+	bz, err := ioutil.ReadAll(io.LimitReader(resp.Body, 10<<20))
+	if err != nil {
+		return rootmulti.StateProof{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return rootmulti.StateProof{}, fmt.Errorf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(bz)))
+	}
+	var res provedResponse
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return rootmulti.StateProof{}, err
+	}
+	return res.Proof, nil
+}
+
+// latestHeight returns the height of the latest verified header (updated by the loop)
+func (lc *LightClient) latestHeight() (int64, error) {
+	height, err := lc.lite.LastTrustedHeight()
+	if err == nil && height <= 0 {
+		err = fmt.Errorf("no verified header")
+	}
+	return height, err
+}
+
+// verifyHeader returns the header of the height from the trusted store, or verified by the loop if not yet verified
+func (lc *LightClient) verifyHeader(height int64) (*tmTypes.Header, error) {
+	if h, err := lc.lite.TrustedHeader(height); err == nil {
+		return h.Header, nil
+	}
+	req := headerRequest{height: height, res: make(chan headerResponse, 1)}
+	select {
+	case lc.requests <- req:
+	case <-lc.quit:
+		return nil, fmt.Errorf("the light client is stopped")
+	}
+	select {
+	case res := <-req.res:
+		return res.header, res.err
+	case <-lc.quit:
+		return nil, fmt.Errorf("the light client is stopped")
+	}
+}
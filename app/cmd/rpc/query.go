@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/exported"
 	types2 "github.com/pokt-network/pocket-core/x/auth/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/bytes"
@@ -14,8 +15,10 @@ import (
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/store/rootmulti"
 	appTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	core_types "github.com/tendermint/tendermint/rpc/core/types"
 )
 
@@ -39,6 +42,7 @@ type HashAndProveParams struct {
 type HeightAndAddrParams struct {
 	Height  int64  `json:"height"`
 	Address string `json:"address"`
+	Prove   bool   `json:"prove,omitempty"`
 }
 
 type HeightAndValidatorOptsParams struct {
@@ -297,7 +301,35 @@ func Balance(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 	if params.Height == 0 {
-		params.Height = app.PCA.BaseApp.LastBlockHeight()
+		params.Height = latestHeight(params.Prove)
+	}
+	if params.Prove {
+		// the balance of the account read from the proof
+		proof, err := app.PCA.QueryAccountProof(params.Address, params.Height)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		acc, err := accountFromProof(proof)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		balance := sdk.NewInt(0)
+		if acc != nil {
+			balance = acc.GetCoins().AmountOf(sdk.DefaultStakeDenom)
+		}
+		s, err := json.MarshalIndent(&queryBalanceResponse{Balance: balance.BigInt()}, "", "")
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		if s, err = json.Marshal(provedResponse{Result: s, Proof: proof}); err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+		return
 	}
 	balance, err := app.PCA.QueryBalance(params.Address, params.Height)
 	if err != nil {
@@ -319,7 +351,22 @@ func Account(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 	if params.Height == 0 {
-		params.Height = app.PCA.BaseApp.LastBlockHeight()
+		params.Height = latestHeight(params.Prove)
+	}
+	if params.Prove {
+		proof, err := app.PCA.QueryAccountProof(params.Address, params.Height)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		writeProvedResponse(w, r, proof, func() ([]byte, error) {
+			res, err := app.PCA.QueryAccount(params.Address, params.Height)
+			if err != nil {
+				return nil, err
+			}
+			return json.Marshal(res)
+		})
+		return
 	}
 	res, err := app.PCA.QueryAccount(params.Address, params.Height)
 	if err != nil {
@@ -334,6 +381,56 @@ func Account(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
+// latestHeight returns the default height of a query: the latest height, or the previous one for a proved query
+// as the state of a height is proven against the app hash of the header of the next height
+func latestHeight(prove bool) int64 {
+	if prove {
+		return app.PCA.BaseApp.LastBlockHeight() - 1
+	}
+	return app.PCA.BaseApp.LastBlockHeight()
+}
+
+type provedResponse struct {
+	Result json.RawMessage      `json:"result"`
+	Proof  rootmulti.StateProof `json:"proof"`
+}
+
+// accountFromProof decodes the account of an account proof (nil if the proof is of its absence)
+func accountFromProof(proof rootmulti.StateProof) (exported.Account, error) {
+	if len(proof.Value) == 0 {
+		return nil, nil
+	}
+	var ba types2.BaseAccount
+	if err := app.Codec().UnmarshalBinaryBare(proof.Value, &ba, proof.Height); err == nil {
+		return &ba, nil
+	}
+	var ma types2.ModuleAccount
+	if err := app.Codec().UnmarshalBinaryBare(proof.Value, &ma, proof.Height); err != nil {
+		return nil, err
+	}
+	return &ma, nil
+}
+
+// writeProvedResponse writes the result of a proved query with the proof of the state it was read from;
+// the result is null if the proof is of the absence of the key
+func writeProvedResponse(w http.ResponseWriter, r *http.Request, proof rootmulti.StateProof, result func() ([]byte, error)) {
+	res := provedResponse{Result: json.RawMessage("null"), Proof: proof}
+	if len(proof.Value) != 0 {
+		j, err := result()
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		res.Result = j
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func Nodes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightAndValidatorOptsParams{}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -373,7 +470,22 @@ func Node(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 	if params.Height == 0 {
-		params.Height = app.PCA.BaseApp.LastBlockHeight()
+		params.Height = latestHeight(params.Prove)
+	}
+	if params.Prove {
+		proof, err := app.PCA.QueryNodeProof(params.Address, params.Height)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		writeProvedResponse(w, r, proof, func() ([]byte, error) {
+			res, err := app.PCA.QueryNode(params.Address, params.Height)
+			if err != nil {
+				return nil, err
+			}
+			return res.MarshalJSON()
+		})
+		return
 	}
 	res, err := app.PCA.QueryNode(params.Address, params.Height)
 	if err != nil {
@@ -453,6 +565,7 @@ type QueryNodeReceiptParam struct {
 	SBlockHeight int64  `json:"session_block_height"`
 	Height       int64  `json:"height"`
 	ReceiptType  string `json:"receipt_type"`
+	Prove        bool   `json:"prove,omitempty"`
 }
 
 func NodeClaim(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -462,7 +575,22 @@ func NodeClaim(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 	if params.Height == 0 {
-		params.Height = app.PCA.BaseApp.LastBlockHeight()
+		params.Height = latestHeight(params.Prove)
+	}
+	if params.Prove {
+		proof, err := app.PCA.QueryClaimProof(params.Address, params.AppPubKey, params.Blockchain, params.ReceiptType, params.SBlockHeight, params.Height)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		writeProvedResponse(w, r, proof, func() ([]byte, error) {
+			res, err := app.PCA.QueryClaim(params.Address, params.AppPubKey, params.Blockchain, params.ReceiptType, params.SBlockHeight, params.Height)
+			if err != nil {
+				return nil, err
+			}
+			return app.Codec().MarshalJSON(res)
+		})
+		return
 	}
 	res, err := app.PCA.QueryClaim(params.Address, params.AppPubKey, params.Blockchain, params.ReceiptType, params.SBlockHeight, params.Height)
 	if err != nil {
@@ -499,6 +627,32 @@ func NodeClaims(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+type queryNodeSessionsResponse struct {
+	NodeSessions []pocketTypes.NodeSessions `json:"node_sessions"`
+}
+
+func NodeSessions(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = PaginatedHeightAndAddrParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryNodeSessions(params.Addr, params.Height, params.Page, params.PerPage)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(queryNodeSessionsResponse{NodeSessions: res})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func Apps(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightAndApplicaitonOptsParams{}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -538,7 +692,22 @@ func App(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 	if params.Height == 0 {
-		params.Height = app.PCA.BaseApp.LastBlockHeight()
+		params.Height = latestHeight(params.Prove)
+	}
+	if params.Prove {
+		proof, err := app.PCA.QueryAppProof(params.Address, params.Height)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		writeProvedResponse(w, r, proof, func() ([]byte, error) {
+			res, err := app.PCA.QueryApp(params.Address, params.Height)
+			if err != nil {
+				return nil, err
+			}
+			return res.MarshalJSON()
+		})
+		return
 	}
 	res, err := app.PCA.QueryApp(params.Address, params.Height)
 	if err != nil {
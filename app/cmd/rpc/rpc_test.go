@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pokt-network/pocket-core/codec"
 
@@ -31,8 +32,15 @@ import (
 	types2 "github.com/pokt-network/pocket-core/x/nodes/types"
 	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	lite "github.com/tendermint/tendermint/lite2"
+	"github.com/tendermint/tendermint/lite2/provider"
+	liteHTTP "github.com/tendermint/tendermint/lite2/provider/http"
+	dbs "github.com/tendermint/tendermint/lite2/store/db"
+	"github.com/tendermint/tendermint/rpc/client"
 	core_types "github.com/tendermint/tendermint/rpc/core/types"
 	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 	"gopkg.in/h2non/gock.v1"
 )
 
@@ -315,6 +323,182 @@ func TestRPC_QueryAccount(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryProof(t *testing.T) {
+	codec.UpgradeHeight = 7000
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	<-evtChan // Wait for block
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	query := func(handler httprouter.Handle, params interface{}) (res provedResponse) {
+		rec := httptest.NewRecorder()
+		handler(rec, newQueryRequest("", newBody(params)), httprouter.Params{})
+		assert.Nil(t, json.Unmarshal(getJSONResponse(rec), &res))
+		// verified against the header of the next height
+		meta := app.PCA.BlockStore().LoadBlockMeta(res.Proof.Height + 1)
+		assert.NotNil(t, meta)
+		assert.Nil(t, res.Proof.VerifyHeader(meta.Header))
+		return
+	}
+	params := HeightAndAddrParams{Address: cb.GetAddress().String(), Prove: true}
+	res := query(Node, params)
+	assert.Equal(t, app.PCA.LastBlockHeight()-1, res.Proof.Height)
+	assert.NotEmpty(t, res.Proof.Value)
+	assert.True(t, strings.Contains(string(res.Result), cb.GetAddress().String()))
+	res = query(Account, params)
+	assert.NotEmpty(t, res.Proof.Value)
+	assert.Regexp(t, "upokt", string(res.Result))
+	res = query(Balance, params)
+	// the balance is the one of the proved account
+	acc, err := accountFromProof(res.Proof)
+	assert.Nil(t, err)
+	var balance queryBalanceResponse
+	assert.Nil(t, json.Unmarshal(res.Result, &balance))
+	assert.Equal(t, acc.GetCoins().AmountOf(types.DefaultStakeDenom).BigInt(), balance.Balance)
+	// the proof of the absence of an app
+	res = query(App, params)
+	assert.Empty(t, res.Proof.Value)
+	assert.Equal(t, "null", string(res.Result))
+	// a tampered value is detected
+	params.Height = 1
+	res = query(Node, params)
+	assert.Equal(t, int64(1), res.Proof.Height)
+	res.Proof.Value = append(res.Proof.Value, 0)
+	assert.NotNil(t, res.Proof.Verify(app.PCA.BlockStore().LoadBlockMeta(2).Header.AppHash))
+	cleanup()
+	stopCli()
+}
+
+// localSignStatusClient provides the headers of the in memory node to the lite client
+type localSignStatusClient struct {
+	client.Client
+}
+
+func (localSignStatusClient) Remote() string { return "local" }
+
+func TestRPC_LightClient(t *testing.T) {
+	codec.UpgradeHeight = 7000
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	<-evtChan // Wait for block
+	<-evtChan // Wait for block
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	// the light client trusts the first header
+	trusted := app.PCA.BlockStore().LoadBlockMeta(1).Header
+	p := liteHTTP.NewWithClient(trusted.ChainID, localSignStatusClient{app.PCA.GetClient()})
+	liteClient, err := lite.NewClient(trusted.ChainID, lite.TrustOptions{Period: time.Hour, Height: 1, Hash: trusted.Hash()},
+		p, []provider.Provider{p}, dbs.New(dbm.NewMemDB(), trusted.ChainID))
+	assert.Nil(t, err)
+	upstream := httptest.NewServer(Router(GetRoutes()))
+	defer upstream.Close()
+	// an upstream tampering with the values
+	tampered := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := httptest.NewRecorder()
+		Router(GetRoutes()).ServeHTTP(rec, r)
+		var res provedResponse
+		assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		res.Proof.Value = append(res.Proof.Value, 0)
+		j, _ := json.Marshal(res)
+		_, _ = w.Write(j)
+	}))
+	defer tampered.Close()
+	// the headers are verified by the loop of the light client
+	newLightClient := func(upstreams ...string) *LightClient {
+		lc := NewLightClient(liteClient, upstreams, 5*time.Second, log.NewNopLogger())
+		assert.Nil(t, lc.Start(time.Second))
+		return lc
+	}
+	lc := newLightClient(upstream.URL)
+	query := func(lc *LightClient, path string, params interface{}) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		Router(lc.Routes()).ServeHTTP(rec, httptest.NewRequest("POST", "/v1/query/"+path, newBody(params)))
+		return rec
+	}
+	params := HeightAndAddrParams{Address: cb.GetAddress().String(), Height: 2}
+	rec := query(lc, "node", params)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, strings.Contains(rec.Body.String(), cb.GetAddress().String()))
+	// the responses of a full node
+	full := func(handler httprouter.Handle) string {
+		rec := httptest.NewRecorder()
+		handler(rec, newQueryRequest("", newBody(params)), httprouter.Params{})
+		return rec.Body.String()
+	}
+	assert.Equal(t, full(Node), rec.Body.String())
+	rec = query(lc, "account", params)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, full(Account), rec.Body.String())
+	rec = query(lc, "balance", params)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, full(Balance), rec.Body.String())
+	// the latest verified state
+	params.Height = 0
+	rec = query(lc, "balance", params)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Regexp(t, "balance", rec.Body.String())
+	// the absence of an app is verified
+	params.Height = 2
+	rec = query(lc, "app", params)
+	assert.Equal(t, 400, rec.Code)
+	assert.Equal(t, full(App), rec.Body.String())
+	// the tampered responses are refused
+	rec = query(newLightClient(tampered.URL), "node", params)
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+	assert.Regexp(t, "invalid proof", rec.Body.String())
+	// unless an upstream is verified
+	rec = query(newLightClient(tampered.URL, upstream.URL), "node", params)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	// the state that can not be proven is not served
+	rec = query(lc, "nodes", params)
+	assert.Equal(t, http.StatusNotImplemented, rec.Code)
+	// the headers not yet verified are not verified once stopped
+	lc.Stop()
+	params.Height = app.PCA.LastBlockHeight() + 10
+	rec = query(lc, "node", params)
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+	assert.Regexp(t, "stopped", rec.Body.String())
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_StreamWriteDeadline(t *testing.T) {
+	stream := func(extend bool) (string, error) {
+		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if extend {
+				extendWriteDeadline(r, time.Second)
+			}
+			for i := 0; i < 4; i++ {
+				_, _ = fmt.Fprintf(w, "chunk %d;", i)
+				w.(http.Flusher).Flush()
+				time.Sleep(100 * time.Millisecond)
+			}
+		}))
+		srv.Config.WriteTimeout = 150 * time.Millisecond
+		srv.Config.ConnContext = withConn
+		srv.Start()
+		defer srv.Close()
+		resp, err := http.Get(srv.URL)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		bz, err := ioutil.ReadAll(resp.Body)
+		return string(bz), err
+	}
+	// the write timeout cuts the stream
+	_, err := stream(false)
+	assert.NotNil(t, err)
+	// unless the write deadline is extended
+	body, err := stream(true)
+	assert.Nil(t, err)
+	assert.Equal(t, "chunk 0;chunk 1;chunk 2;chunk 3;", body)
+}
+
 func TestRPC_QueryNodes(t *testing.T) {
 	codec.UpgradeHeight = 7000
 	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
@@ -978,6 +1162,126 @@ func TestRPC_Relay(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_Relays(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+	codec.UpgradeHeight = 7000
+
+	kb := getInMemoryKeybase()
+	genBZ, _, validators, application := fiveValidatorsOneAppGenesis()
+	_, _, cleanup := NewInMemoryTendermintNode(t, genBZ)
+	// setup relay endpoint
+	expectedRequest := `"jsonrpc":"2.0","method":"web3_sha3","params":["0x68656c6c6f20776f726c64"],"id":64`
+	expectedResponse := "0x47173285a8d7341e5e972fc677286384f802f8ef42a5ec5f03bbfa254cb01fad"
+	gock.New(dummyChainsURL).
+		Post("").
+		BodyString(expectedRequest).
+		Reply(200).
+		BodyString(expectedResponse)
+	appPrivateKey, err := kb.ExportPrivateKeyObject(application.Address, "test")
+	assert.Nil(t, err)
+	// setup AAT
+	aat := pocketTypes.AAT{
+		Version:              "0.0.1",
+		ApplicationPublicKey: appPrivateKey.PublicKey().RawString(),
+		ClientPublicKey:      appPrivateKey.PublicKey().RawString(),
+		ApplicationSignature: "",
+	}
+	sig, err := appPrivateKey.Sign(aat.Hash())
+	if err != nil {
+		panic(err)
+	}
+	aat.ApplicationSignature = hex.EncodeToString(sig)
+	// setup relay
+	relay := pocketTypes.Relay{
+		Payload: pocketTypes.Payload{
+			Data:   expectedRequest,
+			Method: "POST",
+		},
+		Meta: pocketTypes.RelayMeta{BlockHeight: 5},
+		Proof: pocketTypes.RelayProof{
+			Entropy:            32598345349034529,
+			SessionBlockHeight: 1,
+			ServicerPubKey:     validators[0].PublicKey.RawString(),
+			Blockchain:         dummyChainsHash,
+			Token:              aat,
+			Signature:          "",
+		},
+	}
+	relay.Proof.RequestHash = relay.RequestHashString()
+	sig, err = appPrivateKey.Sign(relay.Proof.Hash())
+	if err != nil {
+		panic(err)
+	}
+	relay.Proof.Signature = hex.EncodeToString(sig)
+	// an invalid relay fails on its own without failing the batch
+	invalidRelay := relay
+	invalidRelay.Payload = pocketTypes.Payload{}
+	// setup the query
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	q := newClientRequest("relays", newBody([]pocketTypes.Relay{relay, invalidRelay}))
+	rec := httptest.NewRecorder()
+	Relays(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	var response []struct {
+		Signature string          `json:"signature"`
+		Response  string          `json:"response"`
+		Error     json.RawMessage `json:"error"`
+	}
+	err = json.Unmarshal(resp, &response)
+	assert.Nil(t, err)
+	assert.Len(t, response, 2)
+	assert.Equal(t, expectedResponse, response[0].Response)
+	assert.NotEmpty(t, response[0].Signature)
+	assert.Empty(t, response[0].Error)
+	assert.Empty(t, response[1].Response)
+	assert.NotEmpty(t, response[1].Error)
+	// at the deadline of the batch, the relays still running or not started answer with an error
+	slowRelay := relay
+	slowRelay.Proof.Entropy++
+	slowRelay.Proof.RequestHash = slowRelay.RequestHashString()
+	sig, err = appPrivateKey.Sign(slowRelay.Proof.Hash())
+	assert.Nil(t, err)
+	slowRelay.Proof.Signature = hex.EncodeToString(sig)
+	gock.New(dummyChainsURL).
+		Post("").
+		BodyString(expectedRequest).
+		Reply(200).
+		Delay(2 * time.Second).
+		BodyString(expectedResponse)
+	timeout, concurrency := app.GlobalConfig.PocketConfig.RPCTimeout, app.GlobalConfig.PocketConfig.BatchRelayConcurrency
+	app.GlobalConfig.PocketConfig.RPCTimeout, app.GlobalConfig.PocketConfig.BatchRelayConcurrency = 500, 1
+	defer func() {
+		app.GlobalConfig.PocketConfig.RPCTimeout, app.GlobalConfig.PocketConfig.BatchRelayConcurrency = timeout, concurrency
+	}()
+	q = newClientRequest("relays", newBody([]pocketTypes.Relay{slowRelay, invalidRelay}))
+	rec = httptest.NewRecorder()
+	start := time.Now()
+	Relays(rec, q, httprouter.Params{})
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	var deadlineResponse []struct {
+		Error struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	assert.Nil(t, json.Unmarshal(getJSONResponse(rec), &deadlineResponse))
+	assert.Len(t, deadlineResponse, 2)
+	for _, res := range deadlineResponse {
+		assert.Equal(t, pocketTypes.CodeBatchDeadlineError, res.Error.Code)
+	}
+	gock.Off()
+	// empty batch
+	q = newClientRequest("relays", newBody([]pocketTypes.Relay{}))
+	rec = httptest.NewRecorder()
+	Relays(rec, q, httprouter.Params{})
+	assert.Equal(t, 400, rec.Code)
+
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_Dispatch(t *testing.T) {
 	codec.UpgradeHeight = 7000
 	kb := getInMemoryKeybase()
@@ -1123,6 +1427,35 @@ func TestRPC_QueryNodeClaims(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryNodeSessions(t *testing.T) {
+	codec.UpgradeHeight = 7000
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	var params = PaginatedHeightAndAddrParams{
+		Height:  0,
+		Addr:    cb.GetAddress().String(),
+		Page:    1,
+		PerPage: 10,
+	}
+	q := newQueryRequest("nodesessions", newBody(params))
+	rec := httptest.NewRecorder()
+	NodeSessions(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	assert.NotNil(t, resp)
+	var sessions queryNodeSessionsResponse
+	err = json.Unmarshal(resp, &sessions)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, sessions.NodeSessions)
+	assert.Equal(t, 1, sessions.NodeSessions[0].Page)
+
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QueryNodeClaim(t *testing.T) {
 	codec.UpgradeHeight = 7000
 	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
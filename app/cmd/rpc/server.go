@@ -1,11 +1,13 @@
 package rpc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"net/http/pprof"
 	"runtime"
@@ -45,11 +47,34 @@ func StartRPC(port string, timeout int64, simulation bool, debug bool) {
 		ReadHeaderTimeout: 20 * time.Second,
 		WriteTimeout:      60 * time.Second,
 		Addr:              ":" + port,
-		Handler:           http.TimeoutHandler(Router(routes), time.Duration(timeout)*time.Millisecond, "Server Timeout Handling Request"),
+		Handler:           streamHandler(http.TimeoutHandler(Router(routes), time.Duration(timeout)*time.Millisecond, "Server Timeout Handling Request")),
+		ConnContext:       withConn,
 	}
 	log.Fatal(srv.ListenAndServe())
 }
 
+// streamed relays outlive the rpc timeout and need direct access to the connection (flush / hijack)
+func streamHandler(h http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/", h)
+	mux.Handle(RelayStreamPath, Router(GetStreamRoutes()))
+	return mux
+}
+
+type connKey struct{}
+
+// withConn keeps the connection of the requests in their context, so a streamed relay can extend its write deadline
+func withConn(ctx context.Context, c net.Conn) context.Context {
+	return context.WithValue(ctx, connKey{}, c)
+}
+
+// extendWriteDeadline lets the response of the request be written for d, past the write timeout of the server
+func extendWriteDeadline(r *http.Request, d time.Duration) {
+	if c, ok := r.Context().Value(connKey{}).(net.Conn); ok {
+		_ = c.SetWriteDeadline(time.Now().Add(d))
+	}
+}
+
 func Router(routes Routes) *httprouter.Router {
 	router := httprouter.New()
 	for _, route := range routes {
@@ -83,7 +108,14 @@ func GetRoutes() Routes {
 		Route{Name: "HandleDispatchCORS", Method: "OPTIONS", Path: "/v1/client/dispatch", HandlerFunc: Dispatch},
 		Route{Name: "SendRawTx", Method: "POST", Path: "/v1/client/rawtx", HandlerFunc: SendRawTx},
 		Route{Name: "Service", Method: "POST", Path: "/v1/client/relay", HandlerFunc: Relay},
+		Route{Name: "ServiceBatch", Method: "POST", Path: "/v1/client/relays", HandlerFunc: Relays},
+		Route{Name: "ServiceBatchCORS", Method: "OPTIONS", Path: "/v1/client/relays", HandlerFunc: Relays},
 		Route{Name: "Stop", Method: "POST", Path: "/v1/private/stop", HandlerFunc: Stop},
+		Route{Name: "ReloadChains", Method: "POST", Path: "/v1/private/chains/reload", HandlerFunc: ReloadChains},
+		Route{Name: "AccountingSessions", Method: "POST", Path: "/v1/private/accounting/sessions", HandlerFunc: AccountingSessions},
+		Route{Name: "AccountingSummary", Method: "POST", Path: "/v1/private/accounting/summary", HandlerFunc: AccountingSummary},
+		Route{Name: "Jobs", Method: "POST", Path: "/v1/private/jobs", HandlerFunc: Jobs},
+		Route{Name: "ClaimEstimates", Method: "POST", Path: "/v1/private/claims/estimate", HandlerFunc: ClaimEstimates},
 		Route{Name: "ServiceCORS", Method: "OPTIONS", Path: "/v1/client/relay", HandlerFunc: Relay},
 		Route{Name: "QueryAccount", Method: "POST", Path: "/v1/query/account", HandlerFunc: Account},
 		Route{Name: "QueryAccountTxs", Method: "POST", Path: "/v1/query/accounttxs", HandlerFunc: AccountTxs},
@@ -101,6 +133,7 @@ func GetRoutes() Routes {
 		Route{Name: "QueryNodeClaim", Method: "POST", Path: "/v1/query/nodeclaim", HandlerFunc: NodeClaim},
 		Route{Name: "QueryNodeClaims", Method: "POST", Path: "/v1/query/nodeclaims", HandlerFunc: NodeClaims},
 		Route{Name: "QueryNodeParams", Method: "POST", Path: "/v1/query/nodeparams", HandlerFunc: NodeParams},
+		Route{Name: "QueryNodeSessions", Method: "POST", Path: "/v1/query/nodesessions", HandlerFunc: NodeSessions},
 		Route{Name: "QueryNodes", Method: "POST", Path: "/v1/query/nodes", HandlerFunc: Nodes},
 		Route{Name: "QueryParam", Method: "POST", Path: "/v1/query/param", HandlerFunc: Param},
 		Route{Name: "QueryPocketParams", Method: "POST", Path: "/v1/query/pocketparams", HandlerFunc: PocketParams},
@@ -114,6 +147,15 @@ func GetRoutes() Routes {
 	return routes
 }
 
+func GetStreamRoutes() Routes {
+	routes := Routes{
+		Route{Name: "ServiceStream", Method: "POST", Path: RelayStreamPath, HandlerFunc: RelayStream},
+		Route{Name: "ServiceStreamWebSocket", Method: "GET", Path: RelayStreamPath, HandlerFunc: RelayStream},
+		Route{Name: "ServiceStreamCORS", Method: "OPTIONS", Path: RelayStreamPath, HandlerFunc: RelayStream},
+	}
+	return routes
+}
+
 func FreeMemory(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	debug.FreeOSMemory()
 	WriteResponse(w, "MemoryFreed", r.URL.Path, r.Host)
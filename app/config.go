@@ -61,6 +61,8 @@ var (
 	GlobalGenesisType GenesisType
 	// current authToken for secured rpc calls
 	AuthToken sdk.AuthToken
+	// stops the watcher of the chains file
+	chainsWatcherStop chan struct{}
 )
 
 type GenesisType int
@@ -85,6 +87,12 @@ func InitApp(datadir, tmNode, persistentPeers, seeds, remoteCLIURL string, keyba
 	logger := InitLogger()
 	// init cache
 	InitPocketCoreConfig(chains, logger)
+	// connect the remote signer of the servicer key (if any)
+	InitRemoteSigner(logger)
+	// start the hosted chains health checks
+	chains.StartHealthChecks(logger)
+	// watch the chains file for changes
+	WatchHostedChains(logger)
 	// init genesis
 	InitGenesis(genesisType)
 	// log the config and chains
@@ -309,8 +317,14 @@ func InitTendermint(keybase bool, chains *types.HostedBlockchains, logger log.Lo
 	default:
 		keys = MustGetKeybase()
 	}
+	pruning, err := PruningOptions()
+	if err != nil {
+		log2.Fatal(err)
+	}
 	appCreatorFunc := func(logger log.Logger, db dbm.DB, _ io.Writer) *PocketCoreApp {
-		return NewPocketCoreApp(nil, keys, getTMClient(), chains, logger, db, GlobalConfig.PocketConfig.Cache, baseapp.SetPruning(store.PruneNothing))
+		app := NewPocketCoreApp(nil, keys, getTMClient(), chains, logger, db, GlobalConfig.PocketConfig.Cache, baseapp.SetPruning(store.PruneNothing))
+		app.SetPruning(pruning)
+		return app
 	}
 	tmNode, app, err := NewClient(config(c), appCreatorFunc)
 	if err != nil {
@@ -378,8 +392,36 @@ func InitPocketCoreConfig(chains *types.HostedBlockchains, logger log.Logger) {
 	appsTypes.InitConfig(GlobalConfig.PocketConfig.ApplicationCacheSize)
 }
 
+// "InitRemoteSigner" - Waits for the remote signer of the servicer key if a listen address is configured;
+// the servicer key is read from the private validator key file otherwise
+func InitRemoteSigner(logger log.Logger) {
+	laddr := GlobalConfig.PocketConfig.RemoteSignerListenAddr
+	if laddr == "" {
+		return
+	}
+	timeout := time.Duration(GlobalConfig.PocketConfig.RemoteSignerTimeout) * time.Millisecond
+	logger.Info(fmt.Sprintf("waiting up to %v for the remote signer to connect to %s", timeout, laddr))
+	signer, err := types.NewRemoteSigner(laddr, timeout, logger)
+	if err != nil {
+		log2.Fatal(err)
+	}
+	if pvKey, err := types.GetPVKeyFile(); err == nil && !sdk.Address(signer.PublicKey().Address()).Equals(sdk.Address(pvKey.Address)) {
+		_ = signer.Close()
+		log2.Fatal(fmt.Sprintf("the remote signer key %s does not match the private validator key of %s",
+			signer.PublicKey().RawString(), pvKey.Address.String()))
+	}
+	types.InitSigner(signer)
+	logger.Info(fmt.Sprintf("the servicer key %s is signed by the remote signer", signer.PublicKey().RawString()))
+}
+
 func ShutdownPocketCore() {
+	StopWatchingHostedChains()
+	if PCA != nil && PCA.pocketKeeper.GetHostedBlockchains() != nil {
+		PCA.pocketKeeper.GetHostedBlockchains().StopHealthChecks()
+	}
+	types.StopSigner()
 	types.FlushSessionCache()
+	types.FlushAccounting()
 	types.StopServiceMetrics()
 }
 
@@ -489,8 +531,6 @@ func NewHostedChains(generate bool) *types.HostedBlockchains {
 	// create the chains path
 	var chainsPath = GlobalConfig.PocketConfig.DataDir + FS + sdk.ConfigDirName + FS + GlobalConfig.PocketConfig.ChainsName
 	// if file exists open, else create and open
-	var jsonFile *os.File
-	var bz []byte
 	if _, err := os.Stat(chainsPath); err != nil && os.IsNotExist(err) {
 		if !generate {
 			log2.Println(fmt.Sprintf("no chains.json found @ %s, defaulting to empty chains", chainsPath))
@@ -498,35 +538,104 @@ func NewHostedChains(generate bool) *types.HostedBlockchains {
 		}
 		return generateChainsJson(chainsPath)
 	}
+	m, err := readHostedChains(chainsPath)
+	if err != nil {
+		log2.Fatal(err)
+	}
+	// return the map
+	return &types.HostedBlockchains{M: m}
+}
+
+// "readHostedChains" - reads the chains file into a map
+func readHostedChains(chainsPath string) (map[string]types.HostedBlockchain, error) {
 	// reopen the file to read into the variable
-	jsonFile, err := os.OpenFile(chainsPath, os.O_RDWR|os.O_CREATE, os.ModePerm)
+	jsonFile, err := os.OpenFile(chainsPath, os.O_RDONLY, os.ModePerm)
 	if err != nil {
-		log2.Fatal(NewInvalidChainsError(err))
+		return nil, NewInvalidChainsError(err)
 	}
-	bz, err = ioutil.ReadAll(jsonFile)
+	// close the file on every path
+	defer jsonFile.Close()
+	bz, err := ioutil.ReadAll(jsonFile)
 	if err != nil {
-		log2.Fatal(NewInvalidChainsError(err))
+		return nil, NewInvalidChainsError(err)
 	}
 	// unmarshal into the structure
 	var hostedChainsSlice []types.HostedBlockchain
 	err = json.Unmarshal(bz, &hostedChainsSlice)
 	if err != nil {
-		log2.Fatal(NewInvalidChainsError(err))
-	}
-	// close the file
-	err = jsonFile.Close()
-	if err != nil {
-		log2.Fatal(NewInvalidChainsError(err))
+		return nil, NewInvalidChainsError(err)
 	}
 	m := make(map[string]types.HostedBlockchain)
 	for _, chain := range hostedChainsSlice {
 		if err := nodesTypes.ValidateNetworkIdentifier(chain.ID); err != nil {
-			log2.Fatal(fmt.Sprintf("invalid ID: %s in network identifier in %s file", chain.ID, GlobalConfig.PocketConfig.ChainsName))
+			return nil, fmt.Errorf("invalid ID: %s in network identifier in %s file", chain.ID, GlobalConfig.PocketConfig.ChainsName)
 		}
 		m[chain.ID] = chain
 	}
-	// return the map
-	return &types.HostedBlockchains{M: m}
+	return m, nil
+}
+
+// "ReloadHostedChains" - reads the chains file and atomically swaps the hosted chains of the running node
+// if the file is invalid, the current hosted chains are kept
+func ReloadHostedChains() (*types.HostedBlockchains, error) {
+	if PCA == nil || PCA.pocketKeeper.GetHostedBlockchains() == nil {
+		return nil, fmt.Errorf("the pocket core app is not running")
+	}
+	var chainsPath = GlobalConfig.PocketConfig.DataDir + FS + sdk.ConfigDirName + FS + GlobalConfig.PocketConfig.ChainsName
+	m, err := readHostedChains(chainsPath)
+	if err != nil {
+		return nil, err
+	}
+	chains := PCA.pocketKeeper.GetHostedBlockchains()
+	if err := chains.Swap(m, PCA.Logger()); err != nil {
+		return nil, NewInvalidChainsError(err)
+	}
+	return chains, nil
+}
+
+// "WatchHostedChains" - polls the chains file and reloads the hosted chains when it changes
+func WatchHostedChains(logger log.Logger) {
+	interval := GlobalConfig.PocketConfig.ChainsHotReloadInterval
+	if interval <= 0 {
+		return
+	}
+	var chainsPath = GlobalConfig.PocketConfig.DataDir + FS + sdk.ConfigDirName + FS + GlobalConfig.PocketConfig.ChainsName
+	var lastModified time.Time
+	if info, err := os.Stat(chainsPath); err == nil {
+		lastModified = info.ModTime()
+	}
+	StopWatchingHostedChains()
+	stop := make(chan struct{})
+	chainsWatcherStop = stop
+	ticker := time.NewTicker(time.Duration(interval) * time.Millisecond)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+			info, err := os.Stat(chainsPath)
+			if err != nil || !info.ModTime().After(lastModified) {
+				continue
+			}
+			lastModified = info.ModTime()
+			if _, err := ReloadHostedChains(); err != nil {
+				logger.Error(fmt.Sprintf("unable to reload %s, keeping the current hosted chains: %s", GlobalConfig.PocketConfig.ChainsName, err.Error()))
+				continue
+			}
+			logger.Info(fmt.Sprintf("reloaded hosted chains from %s", chainsPath))
+		}
+	}()
+}
+
+// "StopWatchingHostedChains" - stops polling the chains file
+func StopWatchingHostedChains() {
+	if chainsWatcherStop != nil {
+		close(chainsWatcherStop)
+		chainsWatcherStop = nil
+	}
 }
 
 func generateChainsJson(chainsPath string) *types.HostedBlockchains {
@@ -0,0 +1,127 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pokt-network/pocket-core/store/export"
+	"github.com/pokt-network/pocket-core/store/iavl"
+	"github.com/pokt-network/pocket-core/store/rootmulti"
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// "ExportStateRecords" - Streams the records (the iavl nodes) of the stores of the modules at the height to w,
+// with the app hash of the height (see store/export), returning the number of records exported
+func (app *PocketCoreApp) ExportStateRecords(height int64, w io.Writer) (uint64, error) {
+	rs, ok := app.Store().(*rootmulti.Store)
+	if !ok {
+		return 0, fmt.Errorf("unable to export the state: not a root multistore")
+	}
+	commitID, err := rs.CommitIDOf(height)
+	if err != nil {
+		return 0, err
+	}
+	ew, err := export.NewWriter(w, height, commitID.Hash)
+	if err != nil {
+		return 0, err
+	}
+	for _, name := range app.storeNames() {
+		err := rs.ExportStore(app.Keys[name], height, func(node iavl.ExportNode) error {
+			return ew.WriteRecord(name, export.Record{Key: node.Key, Value: node.Value, Version: node.Version, Height: node.Height})
+		})
+		if err != nil {
+			return ew.Records(), err
+		}
+	}
+	return ew.Records(), ew.Close()
+}
+
+// "ImportStateRecords" - Rebuilds the stores of the modules in the empty application db from the state export of r,
+// committed at the height (the height of the export if 0), writing the records in batches; the imported state is only
+// committed if it matches the app hash of the export, else the records written are removed. Returns the commit and
+// the number of records imported
+func (app *PocketCoreApp) ImportStateRecords(r io.Reader, height int64) (sdk.CommitID, uint64, error) {
+	rs, ok := app.Store().(*rootmulti.Store)
+	if !ok {
+		return sdk.CommitID{}, 0, fmt.Errorf("unable to import the state: not a root multistore")
+	}
+	if app.LastBlockHeight() != 0 {
+		return sdk.CommitID{}, 0, fmt.Errorf("the application db is not empty, latest height: %d", app.LastBlockHeight())
+	}
+	er, err := export.NewReader(r)
+	if err != nil {
+		return sdk.CommitID{}, 0, err
+	}
+	if height == 0 {
+		height = er.Header.Height
+	}
+	if err := rs.SetInitialVersion(height); err != nil {
+		return sdk.CommitID{}, 0, err
+	}
+	err = app.importStores(rs, er)
+	if err == nil {
+		if hash := rs.WorkingHash(); !bytes.Equal(hash, er.Header.AppHash) {
+			err = fmt.Errorf("the imported state does not match the app hash of the export: %X, expected %X", hash, er.Header.AppHash)
+		}
+	}
+	if err != nil {
+		if abortErr := rs.AbortImport(); abortErr != nil {
+			return sdk.CommitID{}, er.Records(), fmt.Errorf("%s (unable to remove the imported records: %s)", err.Error(), abortErr.Error())
+		}
+		return sdk.CommitID{}, er.Records(), err
+	}
+	return rs.Commit(), er.Records(), nil
+}
+
+// "importStores" - Imports the chunks of the state export to the stores of their modules, without committing them
+func (app *PocketCoreApp) importStores(rs *rootmulti.Store, er *export.Reader) error {
+	var importer *iavl.Importer
+	module := ""
+	for {
+		chunk, records, err := er.ReadChunk()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if chunk.Module != module {
+			if importer != nil {
+				if err := importer.Commit(); err != nil {
+					return fmt.Errorf("unable to import %s: %s", module, err.Error())
+				}
+			}
+			key, ok := app.Keys[chunk.Module]
+			if !ok {
+				return fmt.Errorf("unknown module in the state export: %s", chunk.Module)
+			}
+			if importer, err = rs.StoreImporter(key); err != nil {
+				return fmt.Errorf("unable to import %s: %s", chunk.Module, err.Error())
+			}
+			module = chunk.Module
+		}
+		for _, record := range records {
+			if err := importer.Add(iavl.ExportNode{Key: record.Key, Value: record.Value, Version: record.Version, Height: record.Height}); err != nil {
+				return fmt.Errorf("unable to import %s: %s", module, err.Error())
+			}
+		}
+	}
+	if importer != nil {
+		if err := importer.Commit(); err != nil {
+			return fmt.Errorf("unable to import %s: %s", module, err.Error())
+		}
+	}
+	return nil
+}
+
+// "storeNames" - Returns the names of the stores of the modules, sorted
+func (app *PocketCoreApp) storeNames() []string {
+	names := make([]string, 0, len(app.Keys))
+	for name := range app.Keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -7,6 +7,7 @@ import (
 
 	bam "github.com/pokt-network/pocket-core/baseapp"
 	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/store"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/types/module"
 	appsKeeper "github.com/pokt-network/pocket-core/x/apps/keeper"
@@ -44,6 +45,13 @@ type PocketCoreApp struct {
 	pocketKeeper  pocketKeeper.Keeper
 	// Module Manager
 	mm *module.Manager
+	// the state pruning set and the one applied (keeping the state history needed by pocketcore)
+	pruning      sdk.PruningOptions
+	statePruning sdk.PruningOptions
+	// set while a state snapshot is taken
+	snapshotting int32
+	// the snapshot of the last snapshot height, exported once the next block is committed
+	pendingSnapshot *pendingSnapshot
 }
 
 // new pocket core base
@@ -61,10 +69,12 @@ func NewPocketBaseApp(logger log.Logger, db db.DB, cache bool, options ...func(*
 	// add params Keys too
 	// Create the application
 	return &PocketCoreApp{
-		BaseApp: bApp,
-		cdc:     cdc,
-		Keys:    k,
-		Tkeys:   tkeys,
+		BaseApp:      bApp,
+		cdc:          cdc,
+		Keys:         k,
+		Tkeys:        tkeys,
+		pruning:      store.PruneNothing,
+		statePruning: store.PruneNothing,
 	}
 }
 
@@ -96,7 +106,9 @@ func (app *PocketCoreApp) BeginBlocker(ctx sdk.Ctx, req abci.RequestBeginBlock)
 
 // setups all of the end blockers for each module
 func (app *PocketCoreApp) EndBlocker(ctx sdk.Ctx, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	return app.mm.EndBlock(ctx, req)
+	res := app.mm.EndBlock(ctx, req)
+	app.updatePruning(ctx)
+	return res
 }
 
 // ModuleAccountAddrs returns all the pcInstance's module account addresses.
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/store"
+	"github.com/pokt-network/pocket-core/store/rootmulti"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// "PruningOptions" - Returns the state pruning of the pocket config (pruning_keep_recent, pruning_keep_every)
+func PruningOptions() (sdk.PruningOptions, error) {
+	keepRecent, keepEvery := GlobalConfig.PocketConfig.PruningKeepRecent, GlobalConfig.PocketConfig.PruningKeepEvery
+	if keepRecent < 0 || keepEvery < 0 {
+		return sdk.PruningOptions{}, fmt.Errorf("invalid pruning: keep recent %d, keep every %d", keepRecent, keepEvery)
+	}
+	return store.NewPruningOptions(keepRecent, keepEvery), nil
+}
+
+// "SetPruning" - Sets the state pruning of the app; the state is only pruned from the end of the next block,
+// once the state history needed by pocketcore is known (see StatePruning)
+func (app *PocketCoreApp) SetPruning(opts sdk.PruningOptions) {
+	app.pruning = opts
+}
+
+// "StatePruning" - Returns the state pruning of the app at the height of the context:
+// the pruning set, keeping at least the recent state history needed by pocketcore
+// (the claims and proofs of a session are validated against the state of the session)
+func (app *PocketCoreApp) StatePruning(ctx sdk.Ctx) sdk.PruningOptions {
+	keepRecent, keepEvery := app.pruning.KeepRecent(), app.pruning.KeepEvery()
+	if keepEvery == 1 {
+		// every version is kept
+		return app.pruning
+	}
+	if history := app.pocketKeeper.StateHistory(ctx); keepRecent < history {
+		keepRecent = history
+	}
+	return store.NewPruningOptions(keepRecent, keepEvery)
+}
+
+// "updatePruning" - Sets the state pruning of the app (at the height of the context) to the multistore
+func (app *PocketCoreApp) updatePruning(ctx sdk.Ctx) {
+	opts := app.StatePruning(ctx)
+	if opts == app.statePruning {
+		return
+	}
+	if opts.KeepRecent() != app.pruning.KeepRecent() {
+		ctx.Logger().Info(fmt.Sprintf("state pruning keeps the last %d versions (the state history needed by pocketcore) instead of %d",
+			opts.KeepRecent(), app.pruning.KeepRecent()))
+	}
+	app.statePruning = opts
+	app.Store().SetPruning(opts)
+}
+
+// "PruneState" - Deletes the versions of the application state that are not kept by the pruning options
+// (the app must not be running), returning the number of deleted versions
+func (app *PocketCoreApp) PruneState(opts sdk.PruningOptions) (int64, error) {
+	if opts.KeepRecent() < 0 || opts.KeepEvery() < 0 {
+		return 0, fmt.Errorf("invalid pruning: keep recent %d, keep every %d", opts.KeepRecent(), opts.KeepEvery())
+	}
+	if opts.KeepEvery() == 1 {
+		// every version is kept
+		return 0, nil
+	}
+	ctx, err := app.NewContext(app.LastBlockHeight())
+	if err != nil {
+		return 0, err
+	}
+	if history := app.pocketKeeper.StateHistory(ctx); opts.KeepRecent() < history {
+		return 0, fmt.Errorf("the versions kept must be at least %d (the state history needed by pocketcore)", history)
+	}
+	rs, ok := app.Store().(*rootmulti.Store)
+	if !ok {
+		return 0, fmt.Errorf("unable to prune the state: not a root multistore")
+	}
+	return rs.PruneVersions(opts)
+}
+
+// "CompactApplicationDB" - Compacts the application database, releasing the disk space of the deleted (pruned) state
+func CompactApplicationDB(db dbm.DB) error {
+	ldb, ok := db.(*dbm.GoLevelDB)
+	if !ok {
+		return fmt.Errorf("unable to compact the application db: not a goleveldb database")
+	}
+	return ldb.DB().CompactRange(util.Range{})
+}
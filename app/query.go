@@ -8,9 +8,11 @@ import (
 	"reflect"
 	"strconv"
 
+	"github.com/pokt-network/pocket-core/store/rootmulti"
 	sdk "github.com/pokt-network/pocket-core/types"
 	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	"github.com/pokt-network/pocket-core/x/auth/exported"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 	"github.com/pokt-network/pocket-core/x/auth/util"
 	"github.com/pokt-network/pocket-core/x/gov/types"
 	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
@@ -122,6 +124,28 @@ func (app PocketCoreApp) QueryAccount(addr string, height int64) (res *exported.
 	return &acc, nil
 }
 
+// "QueryStateProof" - Returns the value of the key of the store at the height with its merkle proof,
+// verified against the app hash of the header of the next height
+func (app PocketCoreApp) QueryStateProof(storeName string, key []byte, height int64) (res rootmulti.StateProof, err error) {
+	rs, ok := app.Store().(*rootmulti.Store)
+	if !ok {
+		return res, fmt.Errorf("unable to prove the state: not a root multistore")
+	}
+	ms, err := rs.LoadLazyVersion(height)
+	if err != nil {
+		return
+	}
+	return (*ms).(*rootmulti.Store).GetWithProof(storeName, key)
+}
+
+func (app PocketCoreApp) QueryAccountProof(addr string, height int64) (res rootmulti.StateProof, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	return app.QueryStateProof(authTypes.StoreKey, authTypes.AddressStoreKey(a), height)
+}
+
 func (app PocketCoreApp) QueryNodes(height int64, opts nodesTypes.QueryValidatorsParams) (res Page, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -148,6 +172,14 @@ func (app PocketCoreApp) QueryNode(addr string, height int64) (res nodesTypes.Va
 	return
 }
 
+func (app PocketCoreApp) QueryNodeProof(addr string, height int64) (res rootmulti.StateProof, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	return app.QueryStateProof(nodesTypes.StoreKey, nodesTypes.KeyForValByAllVals(a), height)
+}
+
 func (app PocketCoreApp) QueryNodeParams(height int64) (res nodesTypes.Params, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -351,6 +383,14 @@ func (app PocketCoreApp) QueryApp(addr string, height int64) (res appsTypes.Appl
 	return
 }
 
+func (app PocketCoreApp) QueryAppProof(addr string, height int64) (res rootmulti.StateProof, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	return app.QueryStateProof(appsTypes.StoreKey, appsTypes.KeyForAppByAllApps(a), height)
+}
+
 func (app PocketCoreApp) QueryTotalAppCoins(height int64) (staked sdk.BigInt, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -405,6 +445,31 @@ func (app PocketCoreApp) QueryClaim(address, appPubkey, chain, evidenceType stri
 	return &claim, nil
 }
 
+func (app PocketCoreApp) QueryClaimProof(address, appPubkey, chain, evidenceType string, sessionBlockHeight int64, height int64) (res rootmulti.StateProof, err error) {
+	a, err := sdk.AddressFromHex(address)
+	if err != nil {
+		return res, err
+	}
+	header := pocketTypes.SessionHeader{
+		ApplicationPubKey:  appPubkey,
+		Chain:              chain,
+		SessionBlockHeight: sessionBlockHeight,
+	}
+	et, err := pocketTypes.EvidenceTypeFromString(evidenceType)
+	if err != nil {
+		return res, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	key, err := pocketTypes.KeyForClaim(ctx, a, header, et)
+	if err != nil {
+		return res, err
+	}
+	return app.QueryStateProof(pocketTypes.StoreKey, key, height)
+}
+
 func (app PocketCoreApp) QueryClaims(address string, height int64, page, perPage int) (res Page, err error) {
 	var a sdk.Address
 	var claims []pocketTypes.MsgClaim
@@ -441,6 +506,57 @@ func (app PocketCoreApp) QueryPocketParams(height int64) (res pocketTypes.Params
 	return p, nil
 }
 
+func (app PocketCoreApp) QueryAccounting(filter pocketTypes.AccountingFilter, page, perPage int) (res Page, err error) {
+	page, perPage = checkPagination(page, perPage)
+	if filter.Outcome != "" && !filter.Outcome.IsValid() {
+		return Page{}, fmt.Errorf("unknown session outcome: %s", filter.Outcome)
+	}
+	records, err := pocketTypes.QueryAccounting(filter)
+	if err != nil {
+		return Page{}, err
+	}
+	return paginate(page, perPage, records, 10000)
+}
+
+func (app PocketCoreApp) QueryAccountingSummary(filter pocketTypes.AccountingFilter) (res pocketTypes.AccountingSummary, err error) {
+	if filter.Outcome != "" && !filter.Outcome.IsValid() {
+		return pocketTypes.AccountingSummary{}, fmt.Errorf("unknown session outcome: %s", filter.Outcome)
+	}
+	return pocketTypes.QueryAccountingSummary(filter)
+}
+
+func (app PocketCoreApp) QueryJobs(kind pocketTypes.JobKind, state pocketTypes.JobState) (res []pocketTypes.Job, err error) {
+	return pocketTypes.GetJobs(kind, state)
+}
+
+func (app PocketCoreApp) QueryClaimEstimates() (res pocketTypes.ClaimEstimates, err error) {
+	ctx, err := app.NewContext(app.LastBlockHeight())
+	if err != nil {
+		return
+	}
+	return app.pocketKeeper.EstimateClaims(ctx), nil
+}
+
+func (app PocketCoreApp) QueryNodeSessions(address string, height int64, page, perPage int) (res []pocketTypes.NodeSessions, err error) {
+	a, err := sdk.AddressFromHex(address)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return nil, err
+	}
+	return app.pocketKeeper.NodeSessions(ctx, a, page, perPage)
+}
+
+func (app PocketCoreApp) SimulateSessions(fromHeight, toHeight int64, chain, appPubKey string) (res pocketTypes.SessionSimulation, err error) {
+	ctx, err := app.NewContext(app.LastBlockHeight())
+	if err != nil {
+		return pocketTypes.SessionSimulation{}, err
+	}
+	return app.pocketKeeper.SimulateSessions(ctx, fromHeight, toHeight, chain, appPubKey)
+}
+
 func (app PocketCoreApp) HandleChallenge(c pocketTypes.ChallengeProofInvalidData) (res *pocketTypes.ChallengeResponse, err error) {
 	ctx, err := app.NewContext(app.LastBlockHeight())
 	if err != nil {
@@ -481,6 +597,29 @@ func (app PocketCoreApp) HandleRelay(r pocketTypes.Relay) (res *pocketTypes.Rela
 	return
 }
 
+func (app PocketCoreApp) HandleStreamRelay(r pocketTypes.Relay, streamType pocketTypes.StreamType, w pocketTypes.RelayStreamWriter) (res *pocketTypes.RelayResponse, dispatch *pocketTypes.DispatchResponse, err error) {
+	ctx, err := app.NewContext(app.LastBlockHeight())
+	if err != nil {
+		return nil, nil, err
+	}
+	status, err := app.pocketKeeper.TmNode.Status()
+	if err != nil {
+		return nil, nil, fmt.Errorf("pocket node is unable to retrieve status from tendermint node, cannot service in this state")
+	}
+	if status.SyncInfo.CatchingUp {
+		return nil, nil, fmt.Errorf("pocket node is currently syncing to the blockchain, cannot service in this state")
+	}
+	res, err = app.pocketKeeper.HandleStreamRelay(ctx, r, streamType, w)
+	var err1 error
+	if err != nil && pocketTypes.ErrorWarrantsDispatch(err) {
+		dispatch, err1 = app.HandleDispatch(r.Proof.SessionHeader())
+		if err1 != nil {
+			return
+		}
+	}
+	return
+}
+
 func checkPagination(page, limit int) (int, int) {
 	if page <= 0 {
 		page = 1
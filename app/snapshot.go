@@ -0,0 +1,628 @@
+package app
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/pokt-network/pocket-core/store/iavl"
+	"github.com/pokt-network/pocket-core/store/rootmulti"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	amino "github.com/tendermint/go-amino"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmState "github.com/tendermint/tendermint/state"
+	tmStore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	SnapshotFormat       = 2
+	SnapshotManifestName = "snapshot.json"
+	snapshotChunkSize    = 16 << 20 // the chunks are cut at the first record past 16 MB
+	snapshotBatchSize    = 10000    // the records of the application db written at once on restore
+	// the records of a snapshot, the history (header, commit, state and blocks) first
+	snapshotHeaderRecord byte = 'h' // the header of the block after the height of the snapshot (the trusted header)
+	snapshotCommitRecord byte = 'c' // the commit of the block of the height of the snapshot
+	snapshotStateRecord  byte = 's' // the tendermint state after the block of the height of the snapshot
+	snapshotBlockRecord  byte = 'b' // a block (from the first height of the history to the height of the snapshot)
+	snapshotAppRecord    byte = 'a' // a record of the application db
+)
+
+// returned by the functions reading the history of a snapshot at the first record of the application db
+var errSnapshotHistoryRead = errors.New("snapshot history read")
+
+// the codec of the tendermint blocks and state of the snapshots
+var snapshotCdc = amino.NewCodec()
+
+func init() {
+	tmtypes.RegisterBlockAmino(snapshotCdc)
+}
+
+// "SnapshotManifest" - Describes a state snapshot: the application state of the height and its history (from the
+// first height, the state history needed by pocketcore), the blocks of the history and the tendermint state,
+// as records split in chunks hashed with sha256. A snapshot is restored from the hash of the header of the block after
+// the height (from a trusted source), the whole snapshot is verified against it
+type SnapshotManifest struct {
+	Format        uint32   `json:"format"`
+	ChainID       string   `json:"chain_id"`
+	Height        int64    `json:"height"`
+	FromHeight    int64    `json:"from_height"`
+	AppHash       string   `json:"app_hash"`        // the hash of the commit info of the height
+	NextBlockHash string   `json:"next_block_hash"` // the hash of the header of the block after the height
+	Chunks        []string `json:"chunks"`
+}
+
+// "ReadSnapshotManifest" - Reads the manifest of the snapshot directory
+func ReadSnapshotManifest(dir string) (SnapshotManifest, error) {
+	var manifest SnapshotManifest
+	bz, err := ioutil.ReadFile(filepath.Join(dir, SnapshotManifestName))
+	if err != nil {
+		return manifest, err
+	}
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return manifest, err
+	}
+	if manifest.Format != SnapshotFormat {
+		return manifest, fmt.Errorf("unsupported snapshot format: %d", manifest.Format)
+	}
+	return manifest, nil
+}
+
+// "SnapshotDir" - Returns the directory of the snapshots (snapshot_dir, relative to the data dir)
+func SnapshotDir() string {
+	dir := GlobalConfig.PocketConfig.SnapshotDir
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(GlobalConfig.PocketConfig.DataDir, dir)
+	}
+	return dir
+}
+
+// "CreateSnapshot" - Writes the snapshot of the height to dir from the application db, the block store and the
+// state db (the node must be stopped); the block after the height is needed
+func (app *PocketCoreApp) CreateSnapshot(height int64, dir string, blockStore *tmStore.BlockStore, stateDB dbm.DB) (SnapshotManifest, error) {
+	rs, ok := app.Store().(*rootmulti.Store)
+	if !ok {
+		return SnapshotManifest{}, fmt.Errorf("unable to snapshot the state: not a root multistore")
+	}
+	from, err := app.snapshotFromHeight(height)
+	if err != nil {
+		return SnapshotManifest{}, err
+	}
+	return exportSnapshot(rs, rs.DB, from, height, dir, blockStore, stateDB)
+}
+
+// "RestoreSnapshot" - Restores the snapshot of dir to the empty application db, block store and state db of a node,
+// verified against the trusted hash (required) of the header of the block after the height: the blocks down to the
+// first height of the history are linked to the header, the commit, the tendermint state and its validator sets are
+// verified against the header and the last block, the commit infos of the history against the app hashes of the
+// headers and the stores against the commit infos. Nothing is written until the history is verified.
+// The node then block syncs from the height of the snapshot
+func (app *PocketCoreApp) RestoreSnapshot(dir string, blockStore *tmStore.BlockStore, stateDB dbm.DB, trustedHash []byte) (SnapshotManifest, error) {
+	manifest, err := ReadSnapshotManifest(dir)
+	if err != nil {
+		return manifest, err
+	}
+	if len(trustedHash) == 0 {
+		return manifest, fmt.Errorf("a trusted block hash is needed to restore a snapshot")
+	}
+	if app.LastBlockHeight() != 0 || blockStore.Height() != 0 || tmState.LoadState(stateDB).LastBlockHeight != 0 {
+		return manifest, fmt.Errorf("the node is not empty, a snapshot is restored to a new node")
+	}
+	rs, ok := app.Store().(*rootmulti.Store)
+	if !ok {
+		return manifest, fmt.Errorf("unable to restore the state: not a root multistore")
+	}
+	history, err := verifySnapshotHistory(dir, manifest, trustedHash)
+	if err != nil {
+		return manifest, err
+	}
+	batch, pending := rs.DB.NewBatch(), 0
+	err = readSnapshot(dir, manifest, func(kind byte, key, value []byte) error {
+		if kind != snapshotAppRecord {
+			return nil
+		}
+		if err := rs.RestoreSnapshotRecord(batch, key, value, manifest.FromHeight, manifest.Height); err != nil {
+			return err
+		}
+		if pending++; pending >= snapshotBatchSize {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch, pending = rs.DB.NewBatch(), 0
+		}
+		return nil
+	})
+	if err == nil {
+		err = batch.Write()
+	}
+	batch.Close()
+	if err != nil {
+		return manifest, err
+	}
+	if _, err := rs.LoadSnapshotVersion(manifest.FromHeight, manifest.Height, history.appHashes); err != nil {
+		return manifest, err
+	}
+	// the blocks, verified with the history
+	var last *tmtypes.Block
+	err = readSnapshot(dir, manifest, func(kind byte, key, value []byte) error {
+		switch kind {
+		case snapshotBlockRecord:
+			block := new(tmtypes.Block)
+			if err := snapshotCdc.UnmarshalBinaryBare(value, block); err != nil {
+				return err
+			}
+			if last != nil {
+				blockStore.SaveBlock(last, last.MakePartSet(tmtypes.BlockPartSizeBytes), block.LastCommit)
+			}
+			last = block
+		case snapshotAppRecord:
+			return errSnapshotHistoryRead
+		}
+		return nil
+	})
+	if err != nil && err != errSnapshotHistoryRead {
+		return manifest, err
+	}
+	if last == nil || !bytes.Equal(last.Hash(), history.header.LastBlockID.Hash) {
+		return manifest, fmt.Errorf("the blocks of the snapshot changed during the restore")
+	}
+	blockStore.SaveBlock(last, last.MakePartSet(tmtypes.BlockPartSizeBytes), history.commit)
+	tmState.BootstrapState(stateDB, *history.state)
+	return manifest, nil
+}
+
+// "snapshotHistory" - The history of a snapshot verified against the trusted header
+type snapshotHistory struct {
+	header    *tmtypes.Header
+	commit    *tmtypes.Commit
+	state     *tmState.State
+	appHashes map[int64][]byte // the app hashes of the versions [from, height] of the application db
+}
+
+// "verifySnapshotHistory" - Verifies the history records of the snapshot (read until the first application db record)
+// against the trusted hash of the header of the block after the height and returns the verified history
+func verifySnapshotHistory(dir string, manifest SnapshotManifest, trustedHash []byte) (snapshotHistory, error) {
+	history := snapshotHistory{appHashes: make(map[int64][]byte)}
+	var last *tmtypes.Block
+	err := readSnapshot(dir, manifest, func(kind byte, key, value []byte) error {
+		switch kind {
+		case snapshotHeaderRecord:
+			history.header = new(tmtypes.Header)
+			return snapshotCdc.UnmarshalBinaryBare(value, history.header)
+		case snapshotCommitRecord:
+			history.commit = new(tmtypes.Commit)
+			return snapshotCdc.UnmarshalBinaryBare(value, history.commit)
+		case snapshotStateRecord:
+			history.state = new(tmState.State)
+			return snapshotCdc.UnmarshalBinaryBare(value, history.state)
+		case snapshotBlockRecord:
+			block := new(tmtypes.Block)
+			if err := snapshotCdc.UnmarshalBinaryBare(value, block); err != nil {
+				return err
+			}
+			if err := block.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid block %d: %s", block.Height, err.Error())
+			}
+			switch {
+			case last == nil && block.Height != manifest.FromHeight:
+				return fmt.Errorf("the first block %d is not at the first height %d", block.Height, manifest.FromHeight)
+			case last != nil && (block.Height != last.Height+1 || !bytes.Equal(block.LastBlockID.Hash, last.Hash())):
+				return fmt.Errorf("block %d does not follow block %d", block.Height, last.Height)
+			case last != nil:
+				history.appHashes[last.Height] = block.AppHash
+			}
+			last = block
+		case snapshotAppRecord:
+			return errSnapshotHistoryRead
+		default:
+			return fmt.Errorf("unknown snapshot record: %c", kind)
+		}
+		return nil
+	})
+	if err != nil && err != errSnapshotHistoryRead {
+		return history, err
+	}
+	header, commit, state := history.header, history.commit, history.state
+	switch {
+	case last == nil || header == nil || commit == nil || state == nil:
+		return history, fmt.Errorf("incomplete snapshot")
+	case !bytes.Equal(header.Hash(), trustedHash):
+		return history, fmt.Errorf("the header of block %d %X does not match the trusted hash %X", header.Height, header.Hash(), trustedHash)
+	case header.Height != manifest.Height+1 || last.Height != manifest.Height || header.ChainID != manifest.ChainID:
+		return history, fmt.Errorf("the blocks do not match the snapshot of height %d", manifest.Height)
+	case !bytes.Equal(header.LastBlockID.Hash, last.Hash()):
+		return history, fmt.Errorf("block %d is not the last block of the trusted header", last.Height)
+	case !bytes.Equal(commit.Hash(), header.LastCommitHash):
+		return history, fmt.Errorf("the commit of block %d does not match the trusted header", last.Height)
+	}
+	history.appHashes[last.Height] = header.AppHash
+	if err := verifySnapshotState(state, last, header); err != nil {
+		return history, err
+	}
+	if err := state.LastValidators.VerifyCommit(state.ChainID, header.LastBlockID, last.Height, commit); err != nil {
+		return history, fmt.Errorf("invalid commit of block %d: %s", last.Height, err.Error())
+	}
+	return history, nil
+}
+
+// "verifySnapshotState" - Verifies the tendermint state of a snapshot against the last block and the trusted header
+// (of the block after it): the validator sets, the consensus params and the results of the block are in the headers
+func verifySnapshotState(state *tmState.State, last *tmtypes.Block, header *tmtypes.Header) error {
+	switch {
+	case state.ChainID != header.ChainID || state.LastBlockHeight != last.Height || !state.LastBlockID.Equals(header.LastBlockID) ||
+		state.LastBlockTotalTx != last.TotalTxs || !state.LastBlockTime.Equal(last.Time) || state.Version.Consensus != header.Version:
+		return fmt.Errorf("the state does not match the last block %d", last.Height)
+	case !bytes.Equal(state.AppHash, header.AppHash) || !bytes.Equal(state.LastResultsHash, header.LastResultsHash) ||
+		!bytes.Equal(state.ConsensusParams.Hash(), header.ConsensusHash):
+		return fmt.Errorf("the state does not match the trusted header")
+	case state.LastValidators == nil || !bytes.Equal(state.LastValidators.Hash(), last.ValidatorsHash):
+		return fmt.Errorf("the validators of block %d do not match the block", last.Height)
+	case state.Validators == nil || !bytes.Equal(state.Validators.Hash(), header.ValidatorsHash) ||
+		!bytes.Equal(state.Validators.Hash(), last.NextValidatorsHash):
+		return fmt.Errorf("the validators of block %d do not match the trusted header", header.Height)
+	case state.NextValidators == nil || !bytes.Equal(state.NextValidators.Hash(), header.NextValidatorsHash):
+		return fmt.Errorf("the next validators of block %d do not match the trusted header", header.Height)
+	}
+	return nil
+}
+
+// "Commit" - Commits the block and, at the snapshot heights (snapshot_interval), snapshots the state in the background
+func (app *PocketCoreApp) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	height := app.LastBlockHeight()
+	// the block after the height of a snapshot is stored before it is committed
+	if pending := app.pendingSnapshot; pending != nil {
+		app.pendingSnapshot = nil
+		if pending.height == height-1 {
+			app.exportPendingSnapshot(pending)
+		} else {
+			pending.release(app)
+		}
+	}
+	if interval := GlobalConfig.PocketConfig.SnapshotInterval; interval > 0 && height%interval == 0 {
+		app.takeSnapshot(height)
+	}
+	return res
+}
+
+// "pendingSnapshot" - The database snapshot of the application db at the height of a snapshot
+type pendingSnapshot struct {
+	height int64
+	from   int64
+	snap   *leveldb.Snapshot
+}
+
+func (p *pendingSnapshot) release(app *PocketCoreApp) {
+	p.snap.Release()
+	atomic.StoreInt32(&app.snapshotting, 0)
+}
+
+// "takeSnapshot" - Takes a database snapshot of the application db at the height (just committed),
+// exported once the next block is committed
+func (app *PocketCoreApp) takeSnapshot(height int64) {
+	logger := app.Logger().With("module", "snapshot")
+	if !atomic.CompareAndSwapInt32(&app.snapshotting, 0, 1) {
+		logger.Error(fmt.Sprintf("skipping the snapshot of height %d: a snapshot is in progress", height))
+		return
+	}
+	snap, from, err := app.snapshotDB(height)
+	if err != nil {
+		atomic.StoreInt32(&app.snapshotting, 0)
+		logger.Error(fmt.Sprintf("unable to snapshot height %d: %s", height, err.Error()))
+		return
+	}
+	app.pendingSnapshot = &pendingSnapshot{height: height, from: from, snap: snap}
+}
+
+// "exportPendingSnapshot" - Writes the pending snapshot in the background, with the blocks and tendermint state
+func (app *PocketCoreApp) exportPendingSnapshot(pending *pendingSnapshot) {
+	logger := app.Logger().With("module", "snapshot")
+	blockStore, tmNode := app.BlockStore(), app.TMNode()
+	if blockStore == nil || tmNode == nil {
+		pending.release(app)
+		logger.Error(fmt.Sprintf("unable to snapshot height %d: the node is not started", pending.height))
+		return
+	}
+	stateDB := tmNode.StateDB()
+	go func() {
+		defer pending.release(app)
+		dir := SnapshotDir()
+		manifest, err := exportSnapshot(app.Store().(*rootmulti.Store), levelDBSnapshot{pending.snap}, pending.from, pending.height,
+			filepath.Join(dir, strconv.FormatInt(pending.height, 10)), blockStore, stateDB)
+		if err != nil {
+			logger.Error(fmt.Sprintf("unable to snapshot height %d: %s", pending.height, err.Error()))
+			return
+		}
+		logger.Info(fmt.Sprintf("snapshot of height %d written (%d chunks)", pending.height, len(manifest.Chunks)))
+		if err := pruneSnapshots(dir, GlobalConfig.PocketConfig.SnapshotKeepRecent); err != nil {
+			logger.Error(fmt.Sprintf("unable to remove the old snapshots: %s", err.Error()))
+		}
+	}()
+}
+
+// "snapshotDB" - Returns a database snapshot of the application db and the first height of the history of the height
+func (app *PocketCoreApp) snapshotDB(height int64) (*leveldb.Snapshot, int64, error) {
+	rs, ok := app.Store().(*rootmulti.Store)
+	if !ok {
+		return nil, 0, fmt.Errorf("not a root multistore")
+	}
+	ldb, ok := rs.DB.(*dbm.GoLevelDB)
+	if !ok {
+		return nil, 0, fmt.Errorf("not a goleveldb database")
+	}
+	from, err := app.snapshotFromHeight(height)
+	if err != nil {
+		return nil, 0, err
+	}
+	snap, err := ldb.DB().GetSnapshot()
+	return snap, from, err
+}
+
+// "snapshotFromHeight" - Returns the first height of the state history of a snapshot (the history needed by pocketcore)
+func (app *PocketCoreApp) snapshotFromHeight(height int64) (int64, error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return 0, err
+	}
+	from := height - app.pocketKeeper.StateHistory(ctx) + 1
+	if from < 1 {
+		from = 1
+	}
+	return from, nil
+}
+
+// "exportSnapshot" - Writes the snapshot of the height to dir, reading the application state from db
+// (a consistent view of the application db) and the blocks [from, height+1] from the block store
+func exportSnapshot(rs *rootmulti.Store, db iavl.SnapshotReader, from, height int64, dir string, blockStore *tmStore.BlockStore, stateDB dbm.DB) (SnapshotManifest, error) {
+	block, next := blockStore.LoadBlock(height), blockStore.LoadBlock(height+1)
+	if block == nil || next == nil {
+		return SnapshotManifest{}, fmt.Errorf("the blocks %d and %d are needed for the snapshot of height %d", height, height+1, height)
+	}
+	state, err := snapshotTendermintState(stateDB, block, next)
+	if err != nil {
+		return SnapshotManifest{}, err
+	}
+	// written to a temporary directory, so an incomplete snapshot is never left in dir
+	tmp := dir + ".tmp"
+	if err := os.RemoveAll(tmp); err != nil {
+		return SnapshotManifest{}, err
+	}
+	if err := os.MkdirAll(tmp, os.ModePerm); err != nil {
+		return SnapshotManifest{}, err
+	}
+	defer os.RemoveAll(tmp)
+	w := &snapshotWriter{dir: tmp}
+	if err := w.write(snapshotHeaderRecord, nil, snapshotCdc.MustMarshalBinaryBare(next.Header)); err != nil {
+		return SnapshotManifest{}, err
+	}
+	if err := w.write(snapshotCommitRecord, nil, snapshotCdc.MustMarshalBinaryBare(next.LastCommit)); err != nil {
+		return SnapshotManifest{}, err
+	}
+	if err := w.write(snapshotStateRecord, nil, snapshotCdc.MustMarshalBinaryBare(state)); err != nil {
+		return SnapshotManifest{}, err
+	}
+	for h := from; h <= height; h++ {
+		b := blockStore.LoadBlock(h)
+		if b == nil {
+			return SnapshotManifest{}, fmt.Errorf("block %d not found", h)
+		}
+		if err := w.write(snapshotBlockRecord, nil, snapshotCdc.MustMarshalBinaryBare(b)); err != nil {
+			return SnapshotManifest{}, err
+		}
+	}
+	err = rs.ExportSnapshot(db, from, height, func(key, value []byte) error {
+		return w.write(snapshotAppRecord, key, value)
+	})
+	if err != nil {
+		return SnapshotManifest{}, err
+	}
+	if err := w.flush(); err != nil {
+		return SnapshotManifest{}, err
+	}
+	manifest := SnapshotManifest{
+		Format:        SnapshotFormat,
+		ChainID:       block.ChainID,
+		Height:        height,
+		FromHeight:    from,
+		AppHash:       hex.EncodeToString(next.AppHash),
+		NextBlockHash: hex.EncodeToString(next.Hash()),
+		Chunks:        w.hashes,
+	}
+	j, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return SnapshotManifest{}, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmp, SnapshotManifestName), j, 0644); err != nil {
+		return SnapshotManifest{}, err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return SnapshotManifest{}, err
+	}
+	return manifest, os.Rename(tmp, dir)
+}
+
+// "snapshotTendermintState" - Returns the tendermint state after the block (of the height of a snapshot);
+// the validators and consensus params are set as changed at the next height, the first height a restored node has them
+func snapshotTendermintState(stateDB dbm.DB, block, next *tmtypes.Block) (tmState.State, error) {
+	height := block.Height
+	lastValidators, err := tmState.LoadValidators(stateDB, height)
+	if err != nil {
+		return tmState.State{}, err
+	}
+	validators, err := tmState.LoadValidators(stateDB, height+1)
+	if err != nil {
+		return tmState.State{}, err
+	}
+	nextValidators, err := tmState.LoadValidators(stateDB, height+2)
+	if err != nil {
+		return tmState.State{}, err
+	}
+	consensusParams, err := tmState.LoadConsensusParams(stateDB, height+1)
+	if err != nil {
+		return tmState.State{}, err
+	}
+	return tmState.State{
+		Version:                          tmState.Version{Consensus: block.Version, Software: tmState.LoadSoftware(stateDB, height)},
+		ChainID:                          block.ChainID,
+		LastBlockHeight:                  height,
+		LastBlockTotalTx:                 block.TotalTxs,
+		LastBlockID:                      next.LastBlockID,
+		LastBlockTime:                    block.Time,
+		NextValidators:                   nextValidators,
+		Validators:                       validators,
+		LastValidators:                   lastValidators,
+		LastHeightValidatorsChanged:      height + 1,
+		ConsensusParams:                  consensusParams,
+		LastHeightConsensusParamsChanged: height + 1,
+		LastResultsHash:                  next.LastResultsHash,
+		AppHash:                          next.AppHash,
+	}, nil
+}
+
+// "pruneSnapshots" - Removes the oldest snapshots of the directory, keeping the most recent ones (0 keeps all)
+func pruneSnapshots(dir string, keepRecent int) error {
+	if keepRecent <= 0 {
+		return nil
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	heights := make([]int64, 0, len(entries))
+	for _, e := range entries {
+		if h, err := strconv.ParseInt(e.Name(), 10, 64); err == nil && e.IsDir() {
+			heights = append(heights, h)
+		}
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	for i := 0; i < len(heights)-keepRecent; i++ {
+		if err := os.RemoveAll(filepath.Join(dir, strconv.FormatInt(heights[i], 10))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// "snapshotWriter" - Writes the records of a snapshot to chunks (files) and hashes them
+type snapshotWriter struct {
+	dir    string
+	buf    bytes.Buffer
+	hashes []string
+}
+
+// "write" - Appends a record (kind, length prefixed key and value) to the chunk, starting a new chunk past the chunk size
+func (w *snapshotWriter) write(kind byte, key, value []byte) error {
+	var n [binary.MaxVarintLen64]byte
+	w.buf.WriteByte(kind)
+	w.buf.Write(n[:binary.PutUvarint(n[:], uint64(len(key)))])
+	w.buf.Write(key)
+	w.buf.Write(n[:binary.PutUvarint(n[:], uint64(len(value)))])
+	w.buf.Write(value)
+	if w.buf.Len() >= snapshotChunkSize {
+		return w.flush()
+	}
+	return nil
+}
+
+// "flush" - Writes the chunk
+func (w *snapshotWriter) flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	if err := ioutil.WriteFile(filepath.Join(w.dir, snapshotChunkName(len(w.hashes))), w.buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	hash := sha256.Sum256(w.buf.Bytes())
+	w.hashes = append(w.hashes, hex.EncodeToString(hash[:]))
+	w.buf.Reset()
+	return nil
+}
+
+// "readSnapshot" - Passes the records of the chunks of the snapshot to fn, verifying the hash of every chunk
+func readSnapshot(dir string, manifest SnapshotManifest, fn func(kind byte, key, value []byte) error) error {
+	for i, expected := range manifest.Chunks {
+		chunk, err := ioutil.ReadFile(filepath.Join(dir, snapshotChunkName(i)))
+		if err != nil {
+			return err
+		}
+		if hash := sha256.Sum256(chunk); hex.EncodeToString(hash[:]) != expected {
+			return fmt.Errorf("invalid chunk %d: hash %X, expected %s", i, hash, expected)
+		}
+		for len(chunk) > 0 {
+			kind := chunk[0]
+			key, rest, err := readSnapshotBytes(chunk[1:])
+			if err != nil {
+				return err
+			}
+			value, rest, err := readSnapshotBytes(rest)
+			if err != nil {
+				return err
+			}
+			if err := fn(kind, key, value); err != nil {
+				return err
+			}
+			chunk = rest
+		}
+	}
+	return nil
+}
+
+// "readSnapshotBytes" - reads length prefixed bytes
+func readSnapshotBytes(buf []byte) (bz []byte, rest []byte, err error) {
+	l, n := binary.Uvarint(buf)
+	if n <= 0 || uint64(len(buf)-n) < l {
+		return nil, nil, fmt.Errorf("invalid snapshot record")
+	}
+	return buf[n : n+int(l)], buf[n+int(l):], nil
+}
+
+func snapshotChunkName(i int) string {
+	return fmt.Sprintf("chunk-%06d", i)
+}
+
+// "levelDBSnapshot" - Reads a goleveldb database snapshot
+type levelDBSnapshot struct {
+	snap *leveldb.Snapshot
+}
+
+func (s levelDBSnapshot) Get(key []byte) ([]byte, error) {
+	value, err := s.snap.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	}
+	return value, err
+}
+
+func (s levelDBSnapshot) Iterator(start, end []byte) (dbm.Iterator, error) {
+	source := s.snap.NewIterator(&util.Range{Start: start, Limit: end}, nil)
+	source.First()
+	return &levelDBSnapshotIterator{source: source, start: start, end: end}, nil
+}
+
+// "levelDBSnapshotIterator" - Iterates a range of a goleveldb database snapshot
+type levelDBSnapshotIterator struct {
+	source     iterator.Iterator
+	start, end []byte
+}
+
+func (it *levelDBSnapshotIterator) Domain() ([]byte, []byte) { return it.start, it.end }
+func (it *levelDBSnapshotIterator) Valid() bool              { return it.source.Valid() }
+func (it *levelDBSnapshotIterator) Next()                    { it.source.Next() }
+func (it *levelDBSnapshotIterator) Key() []byte              { return it.source.Key() }
+func (it *levelDBSnapshotIterator) Value() []byte            { return it.source.Value() }
+func (it *levelDBSnapshotIterator) Error() error             { return it.source.Error() }
+func (it *levelDBSnapshotIterator) Close()                   { it.source.Release() }
@@ -76,6 +76,11 @@ func OpenTxIndexerDB(config sdk.Config) (dbm.DB, error) {
 	return sdk.NewLevelDB(sdk.TransactionIndexerDBName, dataDir, config.TendermintConfig.LevelDBOptions.ToGoLevelDBOpts())
 }
 
+func OpenLightClientDB(config sdk.Config) (dbm.DB, error) {
+	dataDir := filepath.Join(config.TendermintConfig.RootDir, GlobalConfig.TendermintConfig.DBPath)
+	return sdk.NewLevelDB(sdk.LightClientDBName, dataDir, config.TendermintConfig.LevelDBOptions.ToGoLevelDBOpts())
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile != "" {
 		w, err = os.OpenFile(
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"reflect"
 
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
@@ -23,20 +22,23 @@ func GenerateAAT(appPubKey, clientPubKey string, key crypto.PrivateKey) (aatjson
 	return json.MarshalIndent(aat, "", "  ")
 }
 
+func GenerateDelegatedAAT(appPubKey, clientPubKey string, gatewayKey crypto.PrivateKey) (aatjson []byte, err error) {
+	aat, er := pocketKeeper.DelegatedAATGeneration(appPubKey, clientPubKey, gatewayKey)
+	if er != nil {
+		return nil, er
+	}
+	return json.MarshalIndent(aat, "", "  ")
+}
+
 func BuildMultisig(fromAddr, jsonMessage, passphrase, chainID string, pk crypto.PublicKeyMultiSig, fees int64, legacyCodec bool) ([]byte, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	var m sdk.Msg
-	if err := Codec().UnmarshalJSON([]byte(jsonMessage), &m); err != nil {
+	protoMsg, err := jsonToProtoMsg(jsonMessage)
+	if err != nil {
 		return nil, err
 	}
-	// use reflection to convert to proto msg
-	val := reflect.ValueOf(m)
-	vp := reflect.New(val.Type())
-	vp.Elem().Set(val)
-	protoMsg := vp.Interface().(sdk.ProtoMsg)
 	kb, err := GetKeybase()
 	if err != nil {
 		return nil, err
@@ -91,6 +93,36 @@ func SignMultisigOutOfOrder(fromAddr, txHex, passphrase, chainID string, keys []
 	return txBuilder.SignMultisigTransaction(fa, keys, passphrase, bz, legacyCodec)
 }
 
+// BuildMultisigPartial builds a multisig transaction proposal without signatures
+func BuildMultisigPartial(jsonMessage, chainID string, pk crypto.PublicKeyMultiSignature, fees int64, memo string, legacyCodec bool) (types.MultisigPartialTx, error) {
+	protoMsg, err := jsonToProtoMsg(jsonMessage)
+	if err != nil {
+		return types.MultisigPartialTx{}, err
+	}
+	if err := protoMsg.ValidateBasic(); err != nil {
+		return types.MultisigPartialTx{}, err
+	}
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fees)))
+	return auth.NewMultisigPartialTx(Codec(), protoMsg, pk, chainID, fee, memo, legacyCodec)
+}
+
+// SignMultisigPartial adds the signature of the account to the multisig transaction proposal
+func SignMultisigPartial(fromAddr string, ptx *types.MultisigPartialTx, passphrase string) error {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return err
+	}
+	kb, err := GetKeybase()
+	if err != nil {
+		return err
+	}
+	return ptx.Sign(Codec(), kb, fa, passphrase)
+}
+
+func jsonToProtoMsg(jsonMessage string) (sdk.ProtoMsg, error) {
+	return types.UnmarshalJSONProtoMsg(Codec(), []byte(jsonMessage))
+}
+
 func SortJSON(toSortJSON []byte) string {
 	var c interface{}
 	err := json.Unmarshal(toSortJSON, &c)
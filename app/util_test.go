@@ -1,12 +1,15 @@
 package app
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/pokt-network/pocket-core/codec"
 	"testing"
 
 	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/pokt-network/pocket-core/crypto/keys"
 	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
 	"github.com/pokt-network/pocket-core/x/gov"
 	"github.com/pokt-network/pocket-core/x/nodes"
 	"github.com/pokt-network/pocket-core/x/nodes/types"
@@ -55,3 +58,71 @@ func TestBuildSignMultisig(t *testing.T) {
 	stopCli()
 }
 
+func TestMultisigPartialTx(t *testing.T) {
+	kb := keys.NewInMemory()
+	var kps []crypto.PublicKey
+	var addrs []sdk.Address
+	for i := 0; i < 3; i++ {
+		kp, err := kb.Create("test")
+		assert.Nil(t, err)
+		kps = append(kps, kp.PublicKey)
+		addrs = append(addrs, kp.GetAddress())
+	}
+	pms := crypto.PublicKeyMultiSignature{PublicKeys: kps}
+	msg := types.MsgSend{
+		FromAddress: sdk.Address(pms.Address()),
+		ToAddress:   addrs[1],
+		Amount:      sdk.NewInt(1),
+	}
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(10000)))
+	ptx, err := auth.NewMultisigPartialTx(Codec(), &msg, pms, "test", fee, "memo", false)
+	assert.Nil(t, err)
+	assert.Len(t, ptx.Missing(), 3)
+	// the signers sign independent copies, out of order
+	last := ptx
+	assert.Nil(t, last.Sign(Codec(), kb, addrs[2], "test"))
+	first := ptx
+	assert.Nil(t, first.Sign(Codec(), kb, addrs[0], "test"))
+	assert.False(t, first.IsComplete())
+	_, err = first.Encode(Codec())
+	assert.NotNil(t, err)
+	// the partial tx file round trips
+	bz, err := json.Marshal(last)
+	assert.Nil(t, err)
+	var fromFile auth.MultisigPartialTx
+	assert.Nil(t, json.Unmarshal(bz, &fromFile))
+	assert.Nil(t, fromFile.Verify(Codec()))
+	assert.Nil(t, first.Combine(Codec(), fromFile))
+	assert.Equal(t, []string{kps[1].RawString()}, first.Missing())
+	assert.Nil(t, first.Sign(Codec(), kb, addrs[1], "test"))
+	assert.True(t, first.IsComplete())
+	// the signatures are in the order of the keys
+	for i, s := range first.Signatures {
+		assert.Equal(t, kps[i].RawString(), s.PublicKey)
+	}
+	txBz, err := first.Encode(Codec())
+	assert.Nil(t, err)
+	tx, err := UnmarshalTx(txBz, -1)
+	assert.Nil(t, err)
+	signBz, err := first.SignBytes(Codec())
+	assert.Nil(t, err)
+	assert.True(t, tx.Signature.PublicKey.VerifyBytes(signBz, tx.Signature.Signature))
+	// a tampered or foreign partial tx is rejected
+	other := ptx
+	other.Memo = "other"
+	assert.NotNil(t, first.Combine(Codec(), other))
+	tampered := fromFile
+	tampered.Signatures[0].Signature = tampered.Signatures[0].Signature[2:] + "00"
+	assert.NotNil(t, tampered.Verify(Codec()))
+	outsider, err := kb.Create("test")
+	assert.Nil(t, err)
+	assert.NotNil(t, ptx.Sign(Codec(), kb, outsider.GetAddress(), "test"))
+	// a partial tx file without a msg is rejected
+	for _, file := range []string{`{"msg":null}`, `{}`} {
+		var malformed auth.MultisigPartialTx
+		assert.Nil(t, json.Unmarshal([]byte(file), &malformed))
+		assert.NotNil(t, malformed.Verify(Codec()))
+	}
+	_, err = jsonToProtoMsg("null")
+	assert.NotNil(t, err)
+}
@@ -31,6 +31,14 @@ var (
 
 const UpgradeCodecHeight = int64(30024)
 
+// the features activated by a governance upgrade (at their own height, independently of the upgrade height)
+var UpgradeFeatureMap = make(map[string]int64)
+
+const (
+	OutputAddressFeatureKey = "OADDR"  // the output address of the validators (non custodial staking)
+	AppDelegationFeatureKey = "ADELEG" // the delegation of the AATs of the applications to gateways
+)
+
 func GetCodecUpgradeHeight() int64 {
 	if UpgradeHeight >= UpgradeCodecHeight {
 		return UpgradeCodecHeight
@@ -221,6 +229,12 @@ func (cdc *Codec) IsAfterUpgrade(height int64) bool {
 	return GetCodecUpgradeHeight() <= height || height == -1
 }
 
+//Note: includes the actual feature height
+func (cdc *Codec) IsAfterFeatureUpgrade(key string, height int64) bool {
+	featureHeight, ok := UpgradeFeatureMap[key]
+	return ok && featureHeight <= height || height == -1
+}
+
 //Note: includes the actual upgrade height
 func (cdc *Codec) IsAfterSecondUpgrade(height int64) bool {
 	return height >= UpgradeHeight && UpgradeHeight > GetCodecUpgradeHeight()
@@ -41,6 +41,13 @@ type PrivateKey interface {
 	Size() int
 }
 
+// "Signer" - Signs messages on behalf of a public key; implemented by every PrivateKey
+// and by signers that keep the private key off the host (e.g. a remote signer)
+type Signer interface {
+	PublicKey() PublicKey
+	Sign(msg []byte) ([]byte, error)
+}
+
 type PublicKeyMultiSig interface {
 	Address() crypto.Address
 	String() string
@@ -0,0 +1,101 @@
+package keys
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	bip39 "github.com/cosmos/go-bip39"
+	"github.com/pokt-network/pocket-core/crypto"
+)
+
+const (
+	// DefaultMnemonicBits is the entropy of a new mnemonic (256 bits -> 24 words)
+	DefaultMnemonicBits = 256
+	// HDPathPrefix is the path of the accounts (635 is the SLIP-44 coin type of POKT); the account index is appended (hardened)
+	HDPathPrefix = "m/44'/635'/0'/0'"
+	// HardenedOffset is the first hardened index
+	HardenedOffset uint32 = 0x80000000
+	// the HMAC key of the SLIP-10 ed25519 master key
+	slip10Ed25519Curve = "ed25519 seed"
+)
+
+// NewMnemonic returns a new BIP-39 mnemonic with the entropy bit size (128 to 256, multiple of 32)
+func NewMnemonic(bitSize int) (string, error) {
+	entropy, err := bip39.NewEntropy(bitSize)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// HDPathForIndex returns the derivation path of the account index
+func HDPathForIndex(index uint32) string {
+	return fmt.Sprintf("%s/%d'", HDPathPrefix, index)
+}
+
+// ParseHDPath parses a derivation path (e.g. m/44'/635'/0'/0'/0') into its (hardened) indexes
+func ParseHDPath(path string) ([]uint32, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if len(parts) < 2 || parts[0] != "m" {
+		return nil, fmt.Errorf("invalid derivation path %q: must start with m/", path)
+	}
+	indexes := make([]uint32, 0, len(parts)-1)
+	for _, p := range parts[1:] {
+		if !strings.HasSuffix(p, "'") {
+			return nil, fmt.Errorf("invalid derivation path %q: ed25519 only supports hardened indexes (e.g. 0')", path)
+		}
+		i, err := strconv.ParseUint(strings.TrimSuffix(p, "'"), 10, 32)
+		if err != nil || uint32(i) >= HardenedOffset {
+			return nil, fmt.Errorf("invalid derivation path %q: invalid index %s", path, p)
+		}
+		indexes = append(indexes, uint32(i)+HardenedOffset)
+	}
+	return indexes, nil
+}
+
+// DeriveKey derives the ed25519 private key of the path (SLIP-10) from the BIP-39 mnemonic and passphrase
+func DeriveKey(mnemonic, bip39Passphrase, path string) (crypto.PrivateKey, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %s", err.Error())
+	}
+	indexes, err := ParseHDPath(path)
+	if err != nil {
+		return nil, err
+	}
+	key, chainCode := slip10MasterKey(seed)
+	for _, i := range indexes {
+		key, chainCode = slip10ChildKey(key, chainCode, i)
+	}
+	var pk crypto.Ed25519PrivateKey
+	copy(pk[:], ed25519.NewKeyFromSeed(key))
+	return pk, nil
+}
+
+// slip10MasterKey returns the master key and chain code of the seed
+func slip10MasterKey(seed []byte) (key, chainCode []byte) {
+	h := hmac.New(sha512.New, []byte(slip10Ed25519Curve))
+	_, _ = h.Write(seed)
+	sum := h.Sum(nil)
+	return sum[:32], sum[32:]
+}
+
+// slip10ChildKey returns the hardened child key and chain code of the index (SLIP-10 has no normal derivation for ed25519)
+func slip10ChildKey(key, chainCode []byte, index uint32) ([]byte, []byte) {
+	data := make([]byte, 0, 37)
+	data = append(data, 0x0)
+	data = append(data, key...)
+	var i [4]byte
+	binary.BigEndian.PutUint32(i[:], index)
+	data = append(data, i[:]...)
+	h := hmac.New(sha512.New, chainCode)
+	_, _ = h.Write(data)
+	sum := h.Sum(nil)
+	return sum[:32], sum[32:]
+}
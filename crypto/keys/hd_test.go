@@ -0,0 +1,58 @@
+package keys
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// SLIP-10 test vector 1 for ed25519
+func TestSLIP10Vector(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	key, chainCode := slip10MasterKey(seed)
+	assert.Equal(t, "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", hex.EncodeToString(key))
+	assert.Equal(t, "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb", hex.EncodeToString(chainCode))
+	// m/0'
+	key, chainCode = slip10ChildKey(key, chainCode, HardenedOffset)
+	assert.Equal(t, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", hex.EncodeToString(key))
+	assert.Equal(t, "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69", hex.EncodeToString(chainCode))
+}
+
+func TestParseHDPath(t *testing.T) {
+	indexes, err := ParseHDPath(HDPathForIndex(2))
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{44 + HardenedOffset, 635 + HardenedOffset, HardenedOffset, HardenedOffset, 2 + HardenedOffset}, indexes)
+	for _, path := range []string{"", "44'/635'", "m/44'/635'/0", "m/44'/x'", "m/2147483648'"} {
+		_, err := ParseHDPath(path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestDeriveKey(t *testing.T) {
+	mnemonic, err := NewMnemonic(DefaultMnemonicBits)
+	require.NoError(t, err)
+	k0, err := DeriveKey(mnemonic, "", HDPathForIndex(0))
+	require.NoError(t, err)
+	// deterministic
+	again, err := DeriveKey(mnemonic, "", HDPathForIndex(0))
+	require.NoError(t, err)
+	assert.Equal(t, k0.RawString(), again.RawString())
+	// the index and the bip39 passphrase change the key
+	k1, err := DeriveKey(mnemonic, "", HDPathForIndex(1))
+	require.NoError(t, err)
+	assert.NotEqual(t, k0.RawString(), k1.RawString())
+	withPass, err := DeriveKey(mnemonic, "passphrase", HDPathForIndex(0))
+	require.NoError(t, err)
+	assert.NotEqual(t, k0.RawString(), withPass.RawString())
+	// the key signs
+	sig, err := k0.Sign([]byte("msg"))
+	require.NoError(t, err)
+	assert.True(t, k0.PublicKey().VerifyBytes([]byte("msg"), sig))
+	// invalid mnemonics
+	_, err = DeriveKey("not a mnemonic", "", HDPathForIndex(0))
+	assert.Error(t, err)
+	_, err = DeriveKey(mnemonic, "", "m/44/635")
+	assert.Error(t, err)
+}
@@ -229,6 +229,37 @@ func (kb dbKeybase) ExportPrivateKeyObject(address types.Address, passphrase str
 	return priv, err
 }
 
+// CreateMnemonic generates a new BIP-39 mnemonic and stores the account of the index, encrypted using encryptPassphrase.
+// It returns the key pair and the mnemonic.
+func (kb dbKeybase) CreateMnemonic(bip39Passphrase, encryptPassphrase string, index uint32) (KeyPair, string, error) {
+	mnemonic, err := NewMnemonic(DefaultMnemonicBits)
+	if err != nil {
+		return KeyPair{}, "", err
+	}
+	kp, err := kb.Recover(mnemonic, bip39Passphrase, encryptPassphrase, index)
+	if err != nil {
+		return KeyPair{}, "", err
+	}
+	return kp, mnemonic, nil
+}
+
+// Recover derives the account of the index from the BIP-39 mnemonic and stores it, encrypted using encryptPassphrase.
+// It returns an error if a key with the same address exists.
+func (kb dbKeybase) Recover(mnemonic, bip39Passphrase, encryptPassphrase string, index uint32) (KeyPair, error) {
+	privKey, err := DeriveKey(mnemonic, bip39Passphrase, HDPathForIndex(index))
+	if err != nil {
+		return KeyPair{}, err
+	}
+	Address, err := types.AddressFromHex(privKey.PubKey().Address().String())
+	if err != nil {
+		return KeyPair{}, err
+	}
+	if _, err := kb.Get(Address); err == nil {
+		return KeyPair{}, errors.New("Cannot overwrite key with address: " + Address.String())
+	}
+	return kb.writeLocalKeyPair(privKey, encryptPassphrase, "")
+}
+
 // CloseDB releases the lock and closes the storage backend.
 func (kb dbKeybase) CloseDB() {
 	_ = kb.db.Close()
@@ -3,6 +3,7 @@ package keys
 import (
 	"crypto/rand"
 	"github.com/pokt-network/pocket-core/crypto"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -215,3 +216,35 @@ func TestCoinbase(t *testing.T) {
 	require.NotEmpty(t, coinbase)
 	require.Equal(t, coinbase, kp)
 }
+
+func TestMnemonicCreateRecover(t *testing.T) {
+	// make the storage with reasonable defaults
+	cstore := NewInMemory()
+
+	// Create an account from a new mnemonic
+	passphrase := "1234"
+	kp, mnemonic, err := cstore.CreateMnemonic("bip39", passphrase, 0)
+	require.NoError(t, err)
+	require.Len(t, strings.Fields(mnemonic), 24)
+
+	// The same account can't be recovered twice
+	_, err = cstore.Recover(mnemonic, "bip39", passphrase, 0)
+	require.Error(t, err)
+
+	// Recover the next account of the mnemonic
+	next, err := cstore.Recover(mnemonic, "bip39", passphrase, 1)
+	require.NoError(t, err)
+	require.NotEqual(t, kp.GetAddress(), next.GetAddress())
+
+	// Remove the account and recover it from the mnemonic
+	err = cstore.Delete(kp.GetAddress(), passphrase)
+	require.NoError(t, err)
+	recovered, err := cstore.Recover(mnemonic, "bip39", passphrase, 0)
+	require.NoError(t, err)
+	require.Equal(t, kp.GetAddress(), recovered.GetAddress())
+
+	// A wrong bip39 passphrase derives another account
+	other, err := cstore.Recover(mnemonic, "wrong", passphrase, 0)
+	require.NoError(t, err)
+	require.NotEqual(t, kp.GetAddress(), other.GetAddress())
+}
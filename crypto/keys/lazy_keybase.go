@@ -161,4 +161,24 @@ func (lkb lazyKeybase) ExportPrivateKeyObject(address types.Address, passphrase
 	return newDbKeybase(db).ExportPrivateKeyObject(address, passphrase)
 }
 
+func (lkb lazyKeybase) CreateMnemonic(bip39Passphrase, encryptPassphrase string, index uint32) (KeyPair, string, error) {
+	db, err := sdk.NewLevelDB(lkb.name, lkb.dir, config.DefaultLevelDBOpts().ToGoLevelDBOpts())
+	if err != nil {
+		return KeyPair{}, "", err
+	}
+	defer db.Close()
+
+	return newDbKeybase(db).CreateMnemonic(bip39Passphrase, encryptPassphrase, index)
+}
+
+func (lkb lazyKeybase) Recover(mnemonic, bip39Passphrase, encryptPassphrase string, index uint32) (KeyPair, error) {
+	db, err := sdk.NewLevelDB(lkb.name, lkb.dir, config.DefaultLevelDBOpts().ToGoLevelDBOpts())
+	if err != nil {
+		return KeyPair{}, err
+	}
+	defer db.Close()
+
+	return newDbKeybase(db).Recover(mnemonic, bip39Passphrase, encryptPassphrase, index)
+}
+
 func (lkb lazyKeybase) CloseDB() {}
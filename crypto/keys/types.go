@@ -38,6 +38,14 @@ type Keybase interface {
 	// ExportPrivateKeyObject exports raw PrivKey object.
 	ExportPrivateKeyObject(address types.Address, passphrase string) (crypto.PrivateKey, error)
 
+	// CreateMnemonic generates a new BIP-39 mnemonic and stores the account of the index (see HDPathForIndex),
+	// derived with the bip39Passphrase, using encryptPassphrase. Returns the key pair and the mnemonic
+	CreateMnemonic(bip39Passphrase, encryptPassphrase string, index uint32) (KeyPair, string, error)
+
+	// Recover derives the account of the index (see HDPathForIndex) from the BIP-39 mnemonic and bip39Passphrase
+	// and stores it using encryptPassphrase
+	Recover(mnemonic, bip39Passphrase, encryptPassphrase string, index uint32) (KeyPair, error)
+
 	// CloseDB closes the database.
 	CloseDB()
 }
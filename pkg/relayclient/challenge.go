@@ -0,0 +1,217 @@
+package relayclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// "ChallengeReport" - The outcome of a relay sent to every session node: the majority response and the challenges
+// of the servicers that disagreed with it
+type ChallengeReport struct {
+	Header     pc.SessionHeader     `json:"header"`
+	Responses  []*Response          `json:"responses"`
+	Failed     map[string]string    `json:"failed"`   // servicer public key -> relay error
+	Majority   string               `json:"majority"` // the normalized majority response
+	Challenges []SubmittedChallenge `json:"challenges"`
+}
+
+// "SubmittedChallenge" - A challenge of a minority response and its submission to the majority servicers
+type SubmittedChallenge struct {
+	Challenge   pc.ChallengeProofInvalidData `json:"challenge"`
+	Submissions []ChallengeSubmission        `json:"submissions"`
+}
+
+// "ChallengeSubmission" - The response of a servicer to the submission of a challenge
+type ChallengeSubmission struct {
+	ServicerPubKey string `json:"servicer_pub_key"`
+	Response       string `json:"response,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// "RelayAll" - Sends the same relay request (payload and meta) to every node of the session of the chain,
+// returning the verified responses and the errors by servicer public key
+func (c *Client) RelayAll(chain string, payload pc.Payload) (*Session, []*Response, map[string]error, error) {
+	if err := payload.Validate(); err != nil {
+		return nil, nil, nil, err
+	}
+	s, err := c.Session(chain)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// every relay is signed before any is sent, so they share the block height of the session (the request hash)
+	relays := make([]pc.Relay, len(s.Nodes))
+	for i, n := range s.Nodes {
+		if relays[i], err = c.NewRelay(s, n.PublicKey.RawString(), payload); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	results := make([]*Response, len(s.Nodes))
+	errs := make([]error, len(s.Nodes))
+	var wg sync.WaitGroup
+	for i, n := range s.Nodes {
+		wg.Add(1)
+		go func(i int, serviceURL string) {
+			defer wg.Done()
+			results[i], errs[i] = c.send(relays[i], serviceURL)
+		}(i, n.ServiceURL)
+	}
+	wg.Wait()
+	responses := make([]*Response, 0, len(s.Nodes))
+	failed := make(map[string]error)
+	for i, n := range s.Nodes {
+		if errs[i] != nil {
+			failed[n.PublicKey.RawString()] = errs[i]
+			continue
+		}
+		responses = append(responses, results[i])
+	}
+	return s, responses, failed, nil
+}
+
+// "DetectChallenges" - Compares the json responses (of the same relay request) and returns the normalized majority
+// response and a challenge for every response that disagrees with it; no challenges if every servicer agreed.
+// The responses are grouped by the response comparison rules of the chain (optional, else the sorted json), the ones
+// the challenge validation considers equal are in the same group. A majority needs at least two servicers and strictly
+// more servicers than any other group
+func DetectChallenges(responses []*Response, reporter sdk.Address, comparison *pc.ResponseComparison) (majority string, challenges []pc.ChallengeProofInvalidData, err error) {
+	majority, _, challenges, err = detectChallenges(responses, reporter, comparison)
+	return
+}
+
+// "detectChallenges" - see DetectChallenges, also returns the responses of the majority
+func detectChallenges(responses []*Response, reporter sdk.Address, comparison *pc.ResponseComparison) (majority string, majorityResponses []*Response, challenges []pc.ChallengeProofInvalidData, err error) {
+	groups := groupResponses(responses, comparison)
+	tie, m := false, -1
+	for i, group := range groups {
+		switch {
+		case m < 0 || len(group) > len(groups[m]):
+			m, tie = i, false
+		case len(group) == len(groups[m]):
+			tie = true
+		}
+	}
+	if m < 0 || tie || len(groups[m]) < 2 {
+		return "", nil, nil, fmt.Errorf("no majority response among the %d responses", len(responses))
+	}
+	majorityResponses = groups[m]
+	majority = pc.NormalizeResponse(comparison, majorityResponses[0].Response)
+	challenges = make([]pc.ChallengeProofInvalidData, 0)
+	if len(groups) == 1 {
+		return majority, majorityResponses, challenges, nil
+	}
+	// the chain needs two majority responses equal once sorted, whatever the rules
+	var pair []*Response
+	for i := 0; i < len(majorityResponses) && pair == nil; i++ {
+		for j := i + 1; j < len(majorityResponses); j++ {
+			if pc.ResponsesEqual(nil, majorityResponses[i].Response, majorityResponses[j].Response) {
+				pair = []*Response{majorityResponses[i], majorityResponses[j]}
+				break
+			}
+		}
+	}
+	if pair == nil {
+		return "", nil, nil, fmt.Errorf("no two servicers of the majority sent the same response, the challenges can not be proven")
+	}
+	for i, group := range groups {
+		if i == m {
+			continue
+		}
+		for _, r := range group {
+			// the challenge validation rejects a minority equal to the majority
+			if pc.ResponsesEqual(nil, pair[0].Response, r.Response) || (comparison != nil && pc.ResponsesEqual(comparison, pair[0].Response, r.Response)) {
+				continue
+			}
+			challenge := pc.ChallengeProofInvalidData{
+				MajorityResponses: []pc.RelayResponse{pair[0].relayResponse(), pair[1].relayResponse()},
+				MinorityResponse:  r.relayResponse(),
+				ReporterAddress:   reporter,
+			}
+			if err := challenge.ValidateBasic(); err != nil {
+				return "", nil, nil, err
+			}
+			challenges = append(challenges, challenge)
+		}
+	}
+	return majority, majorityResponses, challenges, nil
+}
+
+// "groupResponses" - groups the responses equal (under the comparison rules) to the first response of a group, in order
+func groupResponses(responses []*Response, comparison *pc.ResponseComparison) [][]*Response {
+	groups := make([][]*Response, 0)
+	for _, r := range responses {
+		found := false
+		for i, group := range groups {
+			if pc.ResponsesEqual(comparison, group[0].Response, r.Response) {
+				groups[i], found = append(group, r), true
+				break
+			}
+		}
+		if !found {
+			groups = append(groups, []*Response{r})
+		}
+	}
+	return groups
+}
+
+// "SubmitChallenge" - Submits the challenge to the servicer (which must be a node of the session)
+func (c *Client) SubmitChallenge(serviceURL string, challenge pc.ChallengeProofInvalidData) (*pc.ChallengeResponse, error) {
+	body, err := json.Marshal(challenge)
+	if err != nil {
+		return nil, err
+	}
+	var res pc.ChallengeResponse
+	if err := c.post(strings.TrimSuffix(serviceURL, "/")+ChallengePath, body, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// "Challenge" - Sends the relay request to every node of the session, detects the servicers that disagree with the
+// majority and, unless dry run, submits the challenges to the servicers of the majority
+func (c *Client) Challenge(chain string, payload pc.Payload, dryRun bool) (*ChallengeReport, error) {
+	s, responses, failed, err := c.RelayAll(chain, payload)
+	if err != nil {
+		return nil, err
+	}
+	report := &ChallengeReport{Header: s.Header, Responses: responses, Failed: make(map[string]string)}
+	for pk, e := range failed {
+		report.Failed[pk] = e.Error()
+	}
+	majority, majorityResponses, challenges, err := detectChallenges(responses, sdk.Address(c.clientKey.PublicKey().Address()), c.comparison[chain])
+	if err != nil {
+		return report, err
+	}
+	report.Majority = majority
+	report.Challenges = make([]SubmittedChallenge, 0, len(challenges))
+	for _, challenge := range challenges {
+		submitted := SubmittedChallenge{Challenge: challenge, Submissions: make([]ChallengeSubmission, 0)}
+		if !dryRun {
+			for _, r := range majorityResponses {
+				submission := ChallengeSubmission{ServicerPubKey: r.ServicerPubKey}
+				res, err := c.SubmitChallenge(s.serviceURL(r.ServicerPubKey), challenge)
+				if err != nil {
+					submission.Error = err.Error()
+				} else {
+					submission.Response = res.Response
+				}
+				submitted.Submissions = append(submitted.Submissions, submission)
+			}
+		}
+		report.Challenges = append(report.Challenges, submitted)
+	}
+	return report, nil
+}
+
+// "relayResponse" - converts the response to the relay response of a challenge
+func (r *Response) relayResponse() pc.RelayResponse {
+	return pc.RelayResponse{
+		Signature: r.Signature,
+		Response:  r.Response,
+		Proof:     r.Proof,
+	}
+}
@@ -0,0 +1,145 @@
+package relayclient
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// respondWith signs the response like the servicer keeper does
+func respondWith(response string) func(s *testServicer, w http.ResponseWriter, relay pc.Relay) {
+	return func(s *testServicer, w http.ResponseWriter, relay pc.Relay) {
+		rr := pc.RelayResponse{Response: response, Proof: relay.Proof}
+		sig, _ := s.key.Sign(rr.Hash())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"signature":"` + hex.EncodeToString(sig) + `","response":` + jsonString(response) + `}`))
+	}
+}
+
+func jsonString(s string) string {
+	bz, _ := json.Marshal(s)
+	return string(bz)
+}
+
+func TestDetectChallenges(t *testing.T) {
+	c, clientKey := newTestClient(t, "http://localhost")
+	reporter := sdk.Address(clientKey.PublicKey().Address())
+	session := &Session{Header: pc.SessionHeader{ApplicationPubKey: c.aat.ApplicationPublicKey, Chain: ethereum, SessionBlockHeight: 1}, BlockHeight: 2}
+	payload := pc.Payload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`, Method: "POST"}
+	servicers := make(map[string]crypto.PrivateKey)
+	resp := func(response, servicer string) *Response {
+		key, found := servicers[servicer]
+		if !found {
+			key = crypto.GenerateEd25519PrivKey()
+			servicers[servicer] = key
+		}
+		relay, err := c.NewRelay(session, key.PublicKey().RawString(), payload)
+		assert.Nil(t, err)
+		rr := pc.RelayResponse{Response: response, Proof: relay.Proof}
+		sig, _ := key.Sign(rr.Hash())
+		return &Response{Response: response, Signature: hex.EncodeToString(sig), ServicerPubKey: servicer, Proof: relay.Proof}
+	}
+	pubKey := func(servicer string) string {
+		return servicers[servicer].PublicKey().RawString()
+	}
+	// the key order does not matter
+	responses := []*Response{resp(`{"a":1,"b":2}`, "01"), resp(`{"b":2,"a":1}`, "02"), resp(`{"a":3}`, "03")}
+	majority, challenges, err := DetectChallenges(responses, reporter, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"a":1,"b":2}`, majority)
+	assert.Len(t, challenges, 1)
+	assert.Equal(t, pubKey("03"), challenges[0].MinorityResponse.Proof.ServicerPubKey)
+	assert.Equal(t, pubKey("01"), challenges[0].MajorityResponses[0].Proof.ServicerPubKey)
+	assert.Equal(t, pubKey("02"), challenges[0].MajorityResponses[1].Proof.ServicerPubKey)
+	assert.Nil(t, challenges[0].Validate([]string{ethereum}, nil, 3, 1))
+	assert.Equal(t, reporter, challenges[0].ReporterAddress)
+	// the minority only differs by an ignored field
+	responses = append(responses[:2], resp(`{"a":1,"b":2,"id":7}`, "04"))
+	_, challenges, err = DetectChallenges(responses, reporter, &pc.ResponseComparison{IgnoredFields: []string{"id"}})
+	assert.Nil(t, err)
+	assert.Empty(t, challenges)
+	// the responses equal under the rules make the majority: without the rules, the hex case splits them in a tie
+	rules := &pc.ResponseComparison{CanonicalJSON: true}
+	responses = []*Response{resp(`{"a":"0xAB"}`, "05"), resp(`{"a":"0xAB"}`, "06"), resp(`{"a":"0xab"}`, "07"), resp(`{"a":"0x01"}`, "08"), resp(`{"a":"0x01"}`, "09")}
+	_, _, err = DetectChallenges(responses, reporter, nil)
+	assert.NotNil(t, err)
+	majority, challenges, err = DetectChallenges(responses, reporter, rules)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"a":"0xab"}`, majority)
+	assert.Len(t, challenges, 2)
+	for i, challenge := range challenges {
+		assert.Equal(t, pubKey(fmt.Sprintf("%02d", 8+i)), challenge.MinorityResponse.Proof.ServicerPubKey)
+		// the majority responses are the same for the chain
+		assert.Nil(t, challenge.Validate([]string{ethereum}, nil, 5, 1))
+		assert.Nil(t, challenge.ValidateBasic())
+	}
+	// the majority needs two servicers with the same response for the chain
+	responses = []*Response{resp(`{"a":"0xAB"}`, "05"), resp(`{"a":"0xab"}`, "07"), resp(`{"a":"0x01"}`, "08")}
+	_, _, err = DetectChallenges(responses, reporter, rules)
+	assert.NotNil(t, err)
+	// every servicer agreed
+	responses = []*Response{resp(`{"a":1,"b":2}`, "01"), resp(`{"b":2,"a":1}`, "02")}
+	_, challenges, err = DetectChallenges(responses, reporter, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, challenges)
+	// no majority
+	_, _, err = DetectChallenges([]*Response{resp("1", "01"), resp("2", "02")}, reporter, nil)
+	assert.NotNil(t, err)
+	_, _, err = DetectChallenges([]*Response{resp("1", "01"), resp("1", "02"), resp("2", "03"), resp("2", "04")}, reporter, nil)
+	assert.NotNil(t, err)
+}
+
+func TestClient_Challenge(t *testing.T) {
+	servicers := []*testServicer{
+		newTestServicer(t, respondWith(`{"id":1,"result":"0x1"}`)),
+		newTestServicer(t, respondWith(`{"result":"0x1","id":1}`)),
+		newTestServicer(t, respondWith(`{"id":1,"result":"0x2"}`)),
+		newTestServicer(t, fail),
+	}
+	for _, s := range servicers {
+		defer s.server.Close()
+	}
+	height := int64(1)
+	dispatcher, _ := newTestDispatcher(t, &height, func() []nodesTypes.Validator {
+		nodes := make([]nodesTypes.Validator, len(servicers))
+		for i, s := range servicers {
+			nodes[i] = s.validator()
+		}
+		return nodes
+	})
+	defer dispatcher.Close()
+	c, clientKey := newTestClient(t, dispatcher.URL)
+	payload := pc.Payload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`, Method: "POST"}
+	// dry run
+	report, err := c.Challenge(ethereum, payload, true)
+	assert.Nil(t, err)
+	assert.Len(t, report.Responses, 3)
+	assert.Len(t, report.Failed, 1)
+	assert.Len(t, report.Challenges, 1)
+	assert.Empty(t, report.Challenges[0].Submissions)
+	challenge := report.Challenges[0].Challenge
+	assert.Equal(t, servicers[2].key.PublicKey().RawString(), challenge.MinorityResponse.Proof.ServicerPubKey)
+	assert.Equal(t, sdk.Address(clientKey.PublicKey().Address()), challenge.ReporterAddress)
+	// the challenge is valid for the chain
+	assert.Nil(t, challenge.Validate([]string{ethereum}, nil, 4, 1))
+	// submitted to the majority servicers
+	report, err = c.Challenge(ethereum, payload, false)
+	assert.Nil(t, err)
+	assert.Len(t, report.Challenges[0].Submissions, 2)
+	for _, s := range report.Challenges[0].Submissions {
+		assert.Equal(t, "stored", s.Response)
+		assert.Empty(t, s.Error)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&servicers[0].challenges))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&servicers[1].challenges))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&servicers[2].challenges))
+}
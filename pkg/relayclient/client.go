@@ -0,0 +1,349 @@
+// Package relayclient is the client side of the pocket relay protocol: it dispatches the sessions of an application,
+// selects the session nodes, signs the relay proofs with the client key of an application authentication token (AAT)
+// and verifies the servicer signatures of the responses.
+package relayclient
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/keeper"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+const (
+	DispatchPath  = "/v1/client/dispatch"
+	RelayPath     = "/v1/client/relay"
+	ChallengePath = "/v1/client/challenge"
+	// the default number of servicers tried per relay
+	DefaultMaxAttempts = 3
+	// the default timeout of the dispatch and relay requests
+	DefaultTimeout = 10 * time.Second
+)
+
+// "Config" - The configuration of a relay client
+type Config struct {
+	Dispatchers []string      // the pocket nodes (urls) used to dispatch the sessions, tried in order
+	MaxAttempts int           // the maximum number of servicers tried per relay
+	Timeout     time.Duration // the timeout of a single dispatch or relay request
+	// the response comparison rules of the challenges by chain (optional)
+	ResponseComparison map[string]*pc.ResponseComparison
+}
+
+// "Client" - Sends the relays of an application through its session nodes
+type Client struct {
+	aat         pc.AAT
+	clientKey   crypto.PrivateKey
+	dispatchers []string
+	maxAttempts int
+	httpClient  *http.Client
+	sessions    *sessionCache
+	dispatchL   sync.Mutex // a single dispatch at a time, so concurrent relays share the dispatched session
+	comparison  map[string]*pc.ResponseComparison
+}
+
+// "Response" - A relay response, signed by the servicer
+type Response struct {
+	Response       string        `json:"response"`
+	Signature      string        `json:"signature"`
+	ServicerPubKey string        `json:"servicer_pub_key"`
+	Proof          pc.RelayProof `json:"proof"`
+}
+
+// "RelayError" - The error returned by a servicer, and the new session if the relay warrants a dispatch
+type RelayError struct {
+	Codespace  string
+	Code       uint32
+	Message    string
+	StatusCode int
+	Dispatch   *Session
+}
+
+func (e *RelayError) Error() string {
+	return fmt.Sprintf("relay failed with status %d (codespace: %s, code: %d): %s", e.StatusCode, e.Codespace, e.Code, e.Message)
+}
+
+// "relayResponse" - the wire format of a successful relay (see rpc.RPCRelayResponse)
+type relayResponse struct {
+	Signature string `json:"signature"`
+	Response  string `json:"response"`
+}
+
+// "relayErrorResponse" - the wire format of a failed relay (see rpc.RPCRelayErrorResponse)
+type relayErrorResponse struct {
+	Error struct {
+		Codespace string `json:"codespace"`
+		Code      uint32 `json:"code"`
+		Message   string `json:"message"`
+	} `json:"error"`
+	Dispatch *dispatchResponse `json:"dispatch"`
+}
+
+// "NewAAT" - Generates an application authentication token for the client public key, signed by the application key
+func NewAAT(appKey crypto.PrivateKey, clientPubKey string) (pc.AAT, error) {
+	aat, err := keeper.AATGeneration(appKey.PublicKey().RawString(), clientPubKey, appKey)
+	if err != nil {
+		return pc.AAT{}, err
+	}
+	return aat, nil
+}
+
+// "NewDelegatedAAT" - Generates an application authentication token for the client public key, signed by a gateway
+// key the application delegated on chain
+func NewDelegatedAAT(gatewayKey crypto.PrivateKey, appPubKey, clientPubKey string) (pc.AAT, error) {
+	aat, err := keeper.DelegatedAATGeneration(appPubKey, clientPubKey, gatewayKey)
+	if err != nil {
+		return pc.AAT{}, err
+	}
+	return aat, nil
+}
+
+// "NewClient" - Returns a relay client for the AAT; the client key must match the client public key of the AAT
+func NewClient(aat pc.AAT, clientKey crypto.PrivateKey, config Config) (*Client, error) {
+	if err := aat.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid aat: %s", err.Error())
+	}
+	if clientKey.PublicKey().RawString() != aat.ClientPublicKey {
+		return nil, fmt.Errorf("the client key does not match the client public key of the aat")
+	}
+	if len(config.Dispatchers) == 0 {
+		return nil, fmt.Errorf("at least one dispatcher is needed")
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = DefaultMaxAttempts
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultTimeout
+	}
+	dispatchers := make([]string, len(config.Dispatchers))
+	for i, d := range config.Dispatchers {
+		dispatchers[i] = strings.TrimSuffix(d, "/")
+	}
+	return &Client{
+		aat:         aat,
+		clientKey:   clientKey,
+		dispatchers: dispatchers,
+		maxAttempts: config.MaxAttempts,
+		httpClient:  &http.Client{Timeout: config.Timeout},
+		sessions:    newSessionCache(),
+		comparison:  config.ResponseComparison,
+	}, nil
+}
+
+// "Session" - Returns the latest session of the chain, dispatched if not cached
+func (c *Client) Session(chain string) (*Session, error) {
+	if s, ok := c.sessions.Latest(chain); ok {
+		return s, nil
+	}
+	c.dispatchL.Lock()
+	defer c.dispatchL.Unlock()
+	// dispatched while waiting for the lock
+	if s, ok := c.sessions.Latest(chain); ok {
+		return s, nil
+	}
+	return c.dispatch(chain)
+}
+
+// "Dispatch" - Dispatches (and caches) the latest session of the chain, regardless of the cache
+func (c *Client) Dispatch(chain string) (*Session, error) {
+	c.dispatchL.Lock()
+	defer c.dispatchL.Unlock()
+	return c.dispatch(chain)
+}
+
+func (c *Client) dispatch(chain string) (*Session, error) {
+	header := pc.SessionHeader{
+		ApplicationPubKey: c.aat.ApplicationPublicKey,
+		Chain:             chain,
+	}
+	body, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	var errs []string
+	for _, d := range c.dispatchers {
+		var res dispatchResponse
+		if err := c.post(d+DispatchPath, body, &res); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", d, err.Error()))
+			continue
+		}
+		if len(res.Session.Nodes) == 0 {
+			errs = append(errs, fmt.Sprintf("%s: no session nodes", d))
+			continue
+		}
+		s := res.toSession()
+		c.sessions.Set(s)
+		// the cache keeps the node selection of an already known session
+		if cached, ok := c.sessions.Get(s.Header); ok {
+			return cached, nil
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("unable to dispatch a session for chain %s: %s", chain, strings.Join(errs, "; "))
+}
+
+// "Relay" - Sends the payload to the session nodes of the chain until one of them responds (up to the max attempts);
+// redispatches when a servicer returns a new session
+func (c *Client) Relay(chain string, payload pc.Payload) (*Response, error) {
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+	var lastErr error
+	for attempt := 0; attempt < c.maxAttempts; attempt++ {
+		s, err := c.Session(chain)
+		if err != nil {
+			return nil, err
+		}
+		node, ok := s.NextNode()
+		if !ok {
+			// every servicer of the session failed, try a fresh dispatch
+			c.sessions.Delete(s.Header)
+			if lastErr == nil {
+				lastErr = fmt.Errorf("no servicer available in the session")
+			}
+			continue
+		}
+		res, err := c.relay(s, node.PublicKey.RawString(), node.ServiceURL, payload)
+		if err == nil {
+			return res, nil
+		}
+		lastErr = err
+		if re, ok := err.(*RelayError); ok && re.Dispatch != nil {
+			// the servicer returned the current session (e.g. a new session started)
+			if re.Dispatch.Header.SessionBlockHeight != s.Header.SessionBlockHeight {
+				c.sessions.Delete(s.Header)
+			}
+			c.sessions.Set(re.Dispatch)
+			continue
+		}
+		s.MarkFailed(node.PublicKey.RawString())
+	}
+	return nil, fmt.Errorf("the relay failed after %d attempts: %s", c.maxAttempts, lastErr.Error())
+}
+
+// "NewRelay" - Returns the relay of the payload for the servicer, with the proof signed by the client key
+func (c *Client) NewRelay(s *Session, servicerPubKey string, payload pc.Payload) (pc.Relay, error) {
+	entropy, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		return pc.Relay{}, err
+	}
+	s.l.Lock()
+	blockHeight := s.BlockHeight
+	s.l.Unlock()
+	relay := pc.Relay{
+		Payload: payload,
+		Meta:    pc.RelayMeta{BlockHeight: blockHeight},
+		Proof: pc.RelayProof{
+			Entropy:            entropy.Int64(),
+			SessionBlockHeight: s.Header.SessionBlockHeight,
+			ServicerPubKey:     servicerPubKey,
+			Blockchain:         s.Header.Chain,
+			Token:              c.aat,
+		},
+	}
+	relay.Proof.RequestHash = relay.RequestHashString()
+	sig, err := c.clientKey.Sign(relay.Proof.Hash())
+	if err != nil {
+		return pc.Relay{}, err
+	}
+	relay.Proof.Signature = hex.EncodeToString(sig)
+	return relay, nil
+}
+
+// "relay" - sends the relay to a single servicer and verifies its signature
+func (c *Client) relay(s *Session, servicerPubKey, serviceURL string, payload pc.Payload) (*Response, error) {
+	relay, err := c.NewRelay(s, servicerPubKey, payload)
+	if err != nil {
+		return nil, err
+	}
+	return c.send(relay, serviceURL)
+}
+
+// "send" - sends the signed relay to the servicer and verifies its signature
+func (c *Client) send(relay pc.Relay, serviceURL string) (*Response, error) {
+	body, err := json.Marshal(relay)
+	if err != nil {
+		return nil, err
+	}
+	var res relayResponse
+	if err := c.post(strings.TrimSuffix(serviceURL, "/")+RelayPath, body, &res); err != nil {
+		return nil, err
+	}
+	if err := VerifyResponse(relay.Proof, res.Response, res.Signature); err != nil {
+		return nil, err
+	}
+	return &Response{
+		Response:       res.Response,
+		Signature:      res.Signature,
+		ServicerPubKey: relay.Proof.ServicerPubKey,
+		Proof:          relay.Proof,
+	}, nil
+}
+
+// "VerifyResponse" - Verifies the signature of the response by the servicer of the proof
+func VerifyResponse(proof pc.RelayProof, response, signature string) error {
+	rr := pc.RelayResponse{
+		Signature: signature,
+		Response:  response,
+		Proof:     proof,
+	}
+	if err := rr.Validate(); err != nil {
+		return err
+	}
+	if err := pc.SignatureVerification(proof.ServicerPubKey, rr.HashString(), signature); err != nil {
+		return fmt.Errorf("invalid servicer signature: %s", err.Error())
+	}
+	return nil
+}
+
+// "post" - posts the json body and decodes the response into the result; relay errors are returned as a *RelayError
+func (c *Client) post(url string, body []byte, result interface{}) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	bz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var er relayErrorResponse
+		if err := json.Unmarshal(bz, &er); err != nil || (er.Error.Message == "" && er.Dispatch == nil) {
+			return &RelayError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(bz))}
+		}
+		re := &RelayError{
+			Codespace:  er.Error.Codespace,
+			Code:       er.Error.Code,
+			Message:    er.Error.Message,
+			StatusCode: resp.StatusCode,
+		}
+		if er.Dispatch != nil && len(er.Dispatch.Session.Nodes) != 0 {
+			re.Dispatch = er.Dispatch.toSession()
+		}
+		return re
+	}
+	return json.Unmarshal(bz, result)
+}
+
+// "IsCodeOf" - Returns true if the relay error has the code of the sdk error (e.g. pc.NewOverServiceError)
+func (e *RelayError) IsCodeOf(err sdk.Error) bool {
+	return e.Codespace == string(err.Codespace()) && e.Code == uint32(err.Code())
+}
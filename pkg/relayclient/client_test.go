@@ -0,0 +1,207 @@
+package relayclient
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var ethereum = hex.EncodeToString([]byte{01})
+
+type testServicer struct {
+	key        crypto.PrivateKey
+	server     *httptest.Server
+	relays     int32
+	challenges int32
+	handler    func(s *testServicer, w http.ResponseWriter, relay pc.Relay)
+}
+
+func newTestServicer(t *testing.T, handler func(s *testServicer, w http.ResponseWriter, relay pc.Relay)) *testServicer {
+	s := &testServicer{key: crypto.GenerateEd25519PrivKey(), handler: handler}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == ChallengePath {
+			var challenge pc.ChallengeProofInvalidData
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&challenge))
+			atomic.AddInt32(&s.challenges, 1)
+			_ = json.NewEncoder(w).Encode(pc.ChallengeResponse{Response: "stored"})
+			return
+		}
+		assert.Equal(t, RelayPath, r.URL.Path)
+		var relay pc.Relay
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&relay))
+		atomic.AddInt32(&s.relays, 1)
+		s.handler(s, w, relay)
+	}))
+	return s
+}
+
+func (s *testServicer) validator() nodesTypes.Validator {
+	return nodesTypes.NewValidator(sdk.Address(s.key.PublicKey().Address()), s.key.PublicKey(), []string{ethereum}, s.server.URL, sdk.NewInt(1000000))
+}
+
+// respond signs the response like the servicer keeper does
+func respond(s *testServicer, w http.ResponseWriter, relay pc.Relay) {
+	rr := pc.RelayResponse{Response: "0x1", Proof: relay.Proof}
+	sig, _ := s.key.Sign(rr.Hash())
+	_ = json.NewEncoder(w).Encode(relayResponse{Signature: hex.EncodeToString(sig), Response: rr.Response})
+}
+
+func fail(s *testServicer, w http.ResponseWriter, relay pc.Relay) {
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"error": pc.NewHTTPExecutionError(pc.ModuleName, fmt.Errorf("connection refused"))})
+}
+
+func newTestDispatcher(t *testing.T, sessionHeight *int64, nodes func() []nodesTypes.Validator) (*httptest.Server, *int32) {
+	var dispatches int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, DispatchPath, r.URL.Path)
+		var header pc.SessionHeader
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&header))
+		atomic.AddInt32(&dispatches, 1)
+		_ = json.NewEncoder(w).Encode(dispatchFor(header, atomic.LoadInt64(sessionHeight), nodes()))
+	})), &dispatches
+}
+
+func dispatchFor(header pc.SessionHeader, sessionHeight int64, nodes []nodesTypes.Validator) dispatchResponse {
+	var res dispatchResponse
+	header.SessionBlockHeight = sessionHeight
+	res.Session.Header = header
+	res.Session.Nodes = nodes
+	res.BlockHeight = sessionHeight + 1
+	return res
+}
+
+func newTestClient(t *testing.T, dispatcher string) (*Client, crypto.PrivateKey) {
+	appKey := crypto.GenerateEd25519PrivKey()
+	clientKey := crypto.GenerateEd25519PrivKey()
+	aat, err := NewAAT(appKey, clientKey.PublicKey().RawString())
+	assert.Nil(t, err)
+	c, err := NewClient(aat, clientKey, Config{Dispatchers: []string{dispatcher}})
+	assert.Nil(t, err)
+	return c, clientKey
+}
+
+func TestNewClient(t *testing.T) {
+	appKey := crypto.GenerateEd25519PrivKey()
+	clientKey := crypto.GenerateEd25519PrivKey()
+	aat, err := NewAAT(appKey, clientKey.PublicKey().RawString())
+	assert.Nil(t, err)
+	_, err = NewClient(aat, crypto.GenerateEd25519PrivKey(), Config{Dispatchers: []string{"http://localhost"}})
+	assert.NotNil(t, err)
+	_, err = NewClient(aat, clientKey, Config{})
+	assert.NotNil(t, err)
+	c, err := NewClient(aat, clientKey, Config{Dispatchers: []string{"http://localhost/"}})
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultMaxAttempts, c.maxAttempts)
+	assert.Equal(t, "http://localhost", c.dispatchers[0])
+}
+
+func TestClient_Relay(t *testing.T) {
+	servicer := newTestServicer(t, respond)
+	defer servicer.server.Close()
+	height := int64(1)
+	dispatcher, dispatches := newTestDispatcher(t, &height, func() []nodesTypes.Validator {
+		return []nodesTypes.Validator{servicer.validator()}
+	})
+	defer dispatcher.Close()
+	c, clientKey := newTestClient(t, dispatcher.URL)
+	payload := pc.Payload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`, Method: "POST"}
+	res, err := c.Relay(ethereum, payload)
+	assert.Nil(t, err)
+	assert.Equal(t, "0x1", res.Response)
+	assert.Equal(t, servicer.key.PublicKey().RawString(), res.ServicerPubKey)
+	// the proof is signed by the client and bound to the request
+	assert.Nil(t, pc.SignatureVerification(clientKey.PublicKey().RawString(), res.Proof.HashString(), res.Proof.Signature))
+	assert.Equal(t, pc.Relay{Payload: payload, Meta: pc.RelayMeta{BlockHeight: 2}}.RequestHashString(), res.Proof.RequestHash)
+	assert.Equal(t, int64(1), res.Proof.SessionBlockHeight)
+	// the session is cached
+	res2, err := c.Relay(ethereum, payload)
+	assert.Nil(t, err)
+	assert.NotEqual(t, res.Proof.Entropy, res2.Proof.Entropy)
+	assert.Equal(t, int32(1), atomic.LoadInt32(dispatches))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&servicer.relays))
+}
+
+func TestClient_RelayFailover(t *testing.T) {
+	bad := newTestServicer(t, fail)
+	defer bad.server.Close()
+	good := newTestServicer(t, respond)
+	defer good.server.Close()
+	height := int64(1)
+	dispatcher, _ := newTestDispatcher(t, &height, func() []nodesTypes.Validator {
+		return []nodesTypes.Validator{bad.validator(), good.validator()}
+	})
+	defer dispatcher.Close()
+	c, _ := newTestClient(t, dispatcher.URL)
+	payload := pc.Payload{Data: "{}", Method: "POST"}
+	for i := 0; i < 3; i++ {
+		res, err := c.Relay(ethereum, payload)
+		assert.Nil(t, err)
+		assert.Equal(t, good.key.PublicKey().RawString(), res.ServicerPubKey)
+	}
+	// the failed servicer is skipped for the rest of the session
+	assert.Equal(t, int32(1), atomic.LoadInt32(&bad.relays))
+}
+
+func TestClient_RelayInvalidSignature(t *testing.T) {
+	impostor := crypto.GenerateEd25519PrivKey()
+	servicer := newTestServicer(t, func(s *testServicer, w http.ResponseWriter, relay pc.Relay) {
+		rr := pc.RelayResponse{Response: "0x1", Proof: relay.Proof}
+		sig, _ := impostor.Sign(rr.Hash())
+		_ = json.NewEncoder(w).Encode(relayResponse{Signature: hex.EncodeToString(sig), Response: rr.Response})
+	})
+	defer servicer.server.Close()
+	height := int64(1)
+	dispatcher, _ := newTestDispatcher(t, &height, func() []nodesTypes.Validator {
+		return []nodesTypes.Validator{servicer.validator()}
+	})
+	defer dispatcher.Close()
+	c, _ := newTestClient(t, dispatcher.URL)
+	_, err := c.Relay(ethereum, pc.Payload{Data: "{}", Method: "POST"})
+	assert.NotNil(t, err)
+}
+
+func TestClient_RelayRedispatch(t *testing.T) {
+	var next *testServicer
+	height := int64(1)
+	// the servicer of the old session returns the new session
+	old := newTestServicer(t, func(s *testServicer, w http.ResponseWriter, relay pc.Relay) {
+		w.WriteHeader(http.StatusBadRequest)
+		d := dispatchFor(relay.Proof.SessionHeader(), 5, []nodesTypes.Validator{next.validator()})
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"error": pc.NewInvalidSessionError(pc.ModuleName), "dispatch": d})
+	})
+	defer old.server.Close()
+	next = newTestServicer(t, respond)
+	defer next.server.Close()
+	dispatcher, dispatches := newTestDispatcher(t, &height, func() []nodesTypes.Validator {
+		return []nodesTypes.Validator{old.validator()}
+	})
+	defer dispatcher.Close()
+	c, _ := newTestClient(t, dispatcher.URL)
+	res, err := c.Relay(ethereum, pc.Payload{Data: "{}", Method: "POST"})
+	assert.Nil(t, err)
+	assert.Equal(t, next.key.PublicKey().RawString(), res.ServicerPubKey)
+	assert.Equal(t, int64(5), res.Proof.SessionBlockHeight)
+	assert.Equal(t, int32(1), atomic.LoadInt32(dispatches))
+	s, err := c.Session(ethereum)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), s.Header.SessionBlockHeight)
+	assert.True(t, s.Contains(next.key.PublicKey().RawString()))
+}
+
+func TestRelayError_IsCodeOf(t *testing.T) {
+	err := pc.NewInvalidSessionError(pc.ModuleName)
+	re := &RelayError{Codespace: string(err.Codespace()), Code: uint32(err.Code())}
+	assert.True(t, re.IsCodeOf(err))
+	assert.False(t, re.IsCodeOf(pc.NewOverServiceError(pc.ModuleName)))
+}
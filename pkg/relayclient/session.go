@@ -0,0 +1,151 @@
+package relayclient
+
+import (
+	"sync"
+
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// "Session" - A dispatched session: the session nodes that may service the relays of the app for the chain
+type Session struct {
+	Header      pc.SessionHeader       `json:"header"`
+	Key         pc.SessionKey          `json:"key"`
+	Nodes       []nodesTypes.Validator `json:"nodes"`
+	BlockHeight int64                  `json:"block_height"` // the latest height known by the dispatcher
+	failed      map[string]bool        // the servicers that failed in this session
+	next        int                    // round robin index of the next servicer
+	l           sync.Mutex
+}
+
+// "dispatchResponse" - The wire format of the dispatch response (the session nodes are concrete validators)
+type dispatchResponse struct {
+	Session struct {
+		Header pc.SessionHeader       `json:"header"`
+		Key    pc.SessionKey          `json:"key"`
+		Nodes  []nodesTypes.Validator `json:"nodes"`
+	} `json:"session"`
+	BlockHeight int64 `json:"block_height"`
+}
+
+// "toSession" - converts the dispatch response to a session
+func (d dispatchResponse) toSession() *Session {
+	return &Session{
+		Header:      d.Session.Header,
+		Key:         d.Session.Key,
+		Nodes:       d.Session.Nodes,
+		BlockHeight: d.BlockHeight,
+		failed:      make(map[string]bool),
+	}
+}
+
+// "NextNode" - Returns the next servicer of the session (round robin), skipping the ones that failed;
+// returns false if every servicer failed
+func (s *Session) NextNode() (nodesTypes.Validator, bool) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	for i := 0; i < len(s.Nodes); i++ {
+		n := s.Nodes[(s.next+i)%len(s.Nodes)]
+		if !s.failed[n.PublicKey.RawString()] {
+			s.next = (s.next + i + 1) % len(s.Nodes)
+			return n, true
+		}
+	}
+	return nodesTypes.Validator{}, false
+}
+
+// "MarkFailed" - Excludes the servicer from the node selection of this session
+func (s *Session) MarkFailed(servicerPubKey string) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.failed[servicerPubKey] = true
+}
+
+// "Contains" - Returns true if the servicer is a node of this session
+func (s *Session) Contains(servicerPubKey string) bool {
+	for _, n := range s.Nodes {
+		if n.PublicKey.RawString() == servicerPubKey {
+			return true
+		}
+	}
+	return false
+}
+
+// "serviceURL" - Returns the service url of the servicer of this session
+func (s *Session) serviceURL(servicerPubKey string) string {
+	for _, n := range s.Nodes {
+		if n.PublicKey.RawString() == servicerPubKey {
+			return n.ServiceURL
+		}
+	}
+	return ""
+}
+
+// "sessionCache" - The dispatched sessions by session header, and the latest session header per chain
+type sessionCache struct {
+	sessions map[string]*Session         // session header hash -> session
+	latest   map[string]pc.SessionHeader // chain -> latest session header
+	l        sync.Mutex
+}
+
+func newSessionCache() *sessionCache {
+	return &sessionCache{
+		sessions: make(map[string]*Session),
+		latest:   make(map[string]pc.SessionHeader),
+	}
+}
+
+// "Get" - Returns the session of the header
+func (c *sessionCache) Get(header pc.SessionHeader) (*Session, bool) {
+	c.l.Lock()
+	defer c.l.Unlock()
+	s, ok := c.sessions[header.HashString()]
+	return s, ok
+}
+
+// "Latest" - Returns the latest session dispatched for the chain
+func (c *sessionCache) Latest(chain string) (*Session, bool) {
+	c.l.Lock()
+	defer c.l.Unlock()
+	header, ok := c.latest[chain]
+	if !ok {
+		return nil, false
+	}
+	s, ok := c.sessions[header.HashString()]
+	return s, ok
+}
+
+// "Set" - Caches the session; older sessions of the chain are evicted
+func (c *sessionCache) Set(s *Session) {
+	c.l.Lock()
+	defer c.l.Unlock()
+	chain := s.Header.Chain
+	if latest, ok := c.latest[chain]; ok {
+		if latest.SessionBlockHeight > s.Header.SessionBlockHeight {
+			// a stale dispatch, keep the newer session
+			return
+		}
+		if latest.SessionBlockHeight < s.Header.SessionBlockHeight {
+			delete(c.sessions, latest.HashString())
+		}
+	}
+	if existing, ok := c.sessions[s.Header.HashString()]; ok {
+		// same session (e.g. redispatched): keep the node selection state, refresh the height
+		existing.l.Lock()
+		existing.BlockHeight = s.BlockHeight
+		existing.l.Unlock()
+		return
+	}
+	c.sessions[s.Header.HashString()] = s
+	c.latest[chain] = s.Header
+}
+
+// "Delete" - Evicts the session
+func (c *sessionCache) Delete(header pc.SessionHeader) {
+	c.l.Lock()
+	defer c.l.Unlock()
+	delete(c.sessions, header.HashString())
+	if latest, ok := c.latest[header.Chain]; ok && latest.HashString() == header.HashString() {
+		delete(c.latest, header.Chain)
+	}
+}
@@ -1043,6 +1043,11 @@ func (n *Node) BlockStore() *store.BlockStore {
 	return n.blockStore
 }
 
+// StateDB returns the Node's state database.
+func (n *Node) StateDB() dbm.DB {
+	return n.stateDB
+}
+
 // ConsensusState returns the Node's ConsensusState.
 func (n *Node) ConsensusState() *cs.State {
 	return n.consensusState
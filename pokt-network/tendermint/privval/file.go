@@ -259,6 +259,15 @@ func (pv *FilePV) SignProposal(chainID string, proposal *types.Proposal) error {
 	return nil
 }
 
+// SignBytes signs bytes with the private key, refusing the sign bytes of votes and proposals
+// (they must go through SignVote and SignProposal). Implements BytesSigner.
+func (pv *FilePV) SignBytes(bz []byte) ([]byte, error) {
+	if isConsensusSignBytes(bz) {
+		return nil, errors.New("refusing to sign a vote or a proposal as bytes")
+	}
+	return pv.Key.PrivKey.Sign(bz)
+}
+
 // Save persists the FilePV to disk.
 func (pv *FilePV) Save() {
 	pv.Key.Save()
@@ -199,6 +199,25 @@ func TestSignVote(t *testing.T) {
 	assert.Equal(sig, vote.Signature)
 }
 
+func TestSignBytes(t *testing.T) {
+	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	privVal := GenFilePV(tempKeyFile.Name(), tempStateFile.Name())
+	block := types.BlockID{Hash: []byte{1, 2, 3}, PartsHeader: types.PartSetHeader{}}
+
+	sig, err := privVal.SignBytes([]byte("relay response"))
+	require.NoError(t, err)
+	assert.True(t, privVal.Key.PubKey.VerifyBytes([]byte("relay response"), sig))
+	// votes and proposals are only signed with the double sign protection
+	_, err = privVal.SignBytes(newVote(privVal.Key.Address, 0, 10, 1, byte(types.PrecommitType), block).SignBytes("mychainid"))
+	assert.Error(t, err)
+	_, err = privVal.SignBytes(newProposal(10, 1, block).SignBytes("mychainid"))
+	assert.Error(t, err)
+}
+
 func TestSignProposal(t *testing.T) {
 	assert := assert.New(t)
 
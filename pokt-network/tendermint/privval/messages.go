@@ -18,6 +18,8 @@ func RegisterRemoteSignerMsg(cdc *amino.Codec) {
 	cdc.RegisterConcrete(&SignedVoteResponse{}, "tendermint/remotesigner/SignedVoteResponse", nil)
 	cdc.RegisterConcrete(&SignProposalRequest{}, "tendermint/remotesigner/SignProposalRequest", nil)
 	cdc.RegisterConcrete(&SignedProposalResponse{}, "tendermint/remotesigner/SignedProposalResponse", nil)
+	cdc.RegisterConcrete(&SignBytesRequest{}, "tendermint/remotesigner/SignBytesRequest", nil)
+	cdc.RegisterConcrete(&SignedBytesResponse{}, "tendermint/remotesigner/SignedBytesResponse", nil)
 
 	cdc.RegisterConcrete(&PingRequest{}, "tendermint/remotesigner/PingRequest", nil)
 	cdc.RegisterConcrete(&PingResponse{}, "tendermint/remotesigner/PingResponse", nil)
@@ -56,6 +58,19 @@ type SignedProposalResponse struct {
 	Error    *RemoteSignerError
 }
 
+// SignBytesRequest is a request to sign bytes of the domain (pocket relay responses and transactions);
+// votes and proposals are refused
+type SignBytesRequest struct {
+	Domain string
+	Bytes  []byte
+}
+
+// SignedBytesResponse is a response containing the signature of the bytes or an error
+type SignedBytesResponse struct {
+	Signature []byte
+	Error     *RemoteSignerError
+}
+
 // PingRequest is a request to confirm that the connection is alive.
 type PingRequest struct {
 }
@@ -120,3 +120,21 @@ func (sc *SignerClient) SignProposal(chainID string, proposal *types.Proposal) e
 
 	return nil
 }
+
+// SignBytes requests a remote signer to sign the bytes of the pocket servicer (see SignBytesDomain)
+func (sc *SignerClient) SignBytes(bz []byte) ([]byte, error) {
+	response, err := sc.endpoint.SendRequest(&SignBytesRequest{Domain: SignBytesDomain, Bytes: bz})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := response.(*SignedBytesResponse)
+	if !ok {
+		return nil, ErrUnexpectedResponse
+	}
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+
+	return resp.Signature, nil
+}
@@ -123,6 +123,42 @@ func TestSignerVote(t *testing.T) {
 	}
 }
 
+func TestSignerSignBytes(t *testing.T) {
+	for _, tc := range getSignerTestCases(t) {
+		bz := []byte("relay response")
+
+		defer tc.signerServer.Stop()
+		defer tc.signerClient.Close()
+
+		want, err := tc.mockPV.(BytesSigner).SignBytes(bz)
+		require.NoError(t, err)
+		have, err := tc.signerClient.SignBytes(bz)
+		require.NoError(t, err)
+
+		assert.Equal(t, want, have)
+	}
+}
+
+func TestSignerSignBytesRefusesConsensusBytes(t *testing.T) {
+	for _, tc := range getSignerTestCases(t) {
+		ts := time.Now()
+		vote := &types.Vote{Height: 1, Timestamp: ts, Type: types.PrecommitType}
+		proposal := &types.Proposal{Height: 1, Timestamp: ts, Type: types.ProposalType}
+
+		defer tc.signerServer.Stop()
+		defer tc.signerClient.Close()
+
+		_, err := tc.signerClient.SignBytes(vote.SignBytes(tc.chainID))
+		assert.Error(t, err)
+		_, err = tc.signerClient.SignBytes(proposal.SignBytes(tc.chainID))
+		assert.Error(t, err)
+		// the bytes of another domain are refused
+		res, err := tc.signerClient.endpoint.SendRequest(&SignBytesRequest{Domain: "other", Bytes: []byte("relay response")})
+		require.NoError(t, err)
+		assert.NotNil(t, res.(*SignedBytesResponse).Error)
+	}
+}
+
 func TestSignerVoteResetDeadline(t *testing.T) {
 	for _, tc := range getSignerTestCases(t) {
 		ts := time.Now()
@@ -7,6 +7,29 @@ import (
 	"github.com/tendermint/tendermint/types"
 )
 
+// SignBytesDomain is the only domain a remote signer signs bytes for: the relay responses and the transactions
+// of the pocket servicer. The signed bytes themselves can not carry it, the chain verifies plain signatures.
+const SignBytesDomain = "pocket/servicer"
+
+// BytesSigner is implemented by the private validators that sign bytes outside of consensus
+type BytesSigner interface {
+	SignBytes(bz []byte) ([]byte, error)
+}
+
+// isConsensusSignBytes returns true if the bytes decode as the sign bytes of a vote or a proposal;
+// those are only signed by SignVote and SignProposal, which protect against double signing
+func isConsensusSignBytes(bz []byte) bool {
+	var vote types.CanonicalVote
+	if err := cdc.UnmarshalBinaryLengthPrefixed(bz, &vote); err == nil && types.IsVoteTypeValid(vote.Type) {
+		return true
+	}
+	var proposal types.CanonicalProposal
+	if err := cdc.UnmarshalBinaryLengthPrefixed(bz, &proposal); err == nil && proposal.Type == types.ProposalType {
+		return true
+	}
+	return false
+}
+
 func DefaultValidationRequestHandler(
 	privVal types.PrivValidator,
 	req SignerMessage,
@@ -41,6 +64,28 @@ func DefaultValidationRequestHandler(
 			res = &SignedProposalResponse{r.Proposal, nil}
 		}
 
+	case *SignBytesRequest:
+		bs, ok := privVal.(BytesSigner)
+		if !ok {
+			res = &SignedBytesResponse{nil, &RemoteSignerError{0, "the private validator does not sign bytes"}}
+			break
+		}
+		if r.Domain != SignBytesDomain {
+			res = &SignedBytesResponse{nil, &RemoteSignerError{0, fmt.Sprintf("unknown sign bytes domain: %q", r.Domain)}}
+			break
+		}
+		if isConsensusSignBytes(r.Bytes) {
+			res = &SignedBytesResponse{nil, &RemoteSignerError{0, "refusing to sign a vote or a proposal as bytes"}}
+			break
+		}
+		var sig []byte
+		sig, err = bs.SignBytes(r.Bytes)
+		if err != nil {
+			res = &SignedBytesResponse{nil, &RemoteSignerError{0, err.Error()}}
+		} else {
+			res = &SignedBytesResponse{sig, nil}
+		}
+
 	case *PingRequest:
 		err, res = nil, &PingResponse{}
 
@@ -483,3 +483,21 @@ func LoadSoftware(db dbm.DB, height int64) string {
 	softwareByte, _ := db.Get(calSoftwareHeight(height))
 	return string(softwareByte)
 }
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Added for the state snapshot restore
+
+// BootstrapState saves the state of a node starting from a height other than genesis (e.g. restored from a snapshot):
+// the validators of the last, current and next heights, the consensus params and the state itself.
+// The state must be the state after the block LastBlockHeight, with the validators and consensus params changed at
+// LastBlockHeight+1 (the first height the node has them for).
+func BootstrapState(db dbm.DB, state State) {
+	height := state.LastBlockHeight + 1
+	if height > 1 && state.LastValidators != nil {
+		saveValidatorsInfo(db, height-1, height-1, state.LastValidators)
+	}
+	saveValidatorsInfo(db, height, height, state.Validators)
+	saveValidatorsInfo(db, height+1, height+1, state.NextValidators)
+	saveConsensusParamsInfo(db, height, height, state.ConsensusParams)
+	db.SetSync(stateKey, state.Bytes())
+}
@@ -73,6 +73,11 @@ func (pv MockPV) GetPubKey() (crypto.PubKey, error) {
 	return pv.PrivKey.PubKey(), nil
 }
 
+// SignBytes signs arbitrary bytes (see privval.BytesSigner)
+func (pv MockPV) SignBytes(bz []byte) ([]byte, error) {
+	return pv.PrivKey.Sign(bz)
+}
+
 // Implements PrivValidator.
 func (pv MockPV) SignVote(chainID string, vote *Vote) error {
 	useChainID := chainID
@@ -0,0 +1,330 @@
+// Package export implements a streaming, versioned binary format for the records of the stores of the application
+// state, so a state of any size is exported and imported without being held in memory. The records are the nodes of
+// the iavl trees of the stores (in the order of iavl.ImmutableTree.Export), so an import rebuilds the same trees and
+// is verified against the app hash of the height.
+//
+// A state export is a header followed by chunks and ends with an end chunk:
+//
+//	header: the magic "POKTSTATE", the format version (uvarint), the height of the state (varint) and its app hash
+//	        (length prefixed)
+//	chunk:  the module (store) name, the record type (the first byte of the keys), the number of records and the
+//	        payload length (uvarints), the sha256 checksum of all of the above and the payload, then the payload:
+//	        the records as length prefixed keys and values, the node versions (varints) and heights (bytes)
+//	end:    a chunk with no module name, no payload and the number of records of the export
+//
+// The chunks are runs of consecutive nodes, in the order of the tree an import needs: the record type is the first
+// byte of the raw keys of the nodes (the key prefix of the module, e.g. the validators of the staking store), not a
+// module level record type. The inner nodes (keyed by the smallest key of their right subtree) are in the chunks with
+// the leaves, and the nodes of a record type may be split in several chunks. A chunk is only a checksummed unit of
+// the stream, the records of a module are the leaves of all of its chunks.
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// Version is the version of the format written
+	Version = 1
+	// ChunkSize is the payload size after which a chunk is cut
+	ChunkSize = 4 << 20
+	// maxPayloadSize is the largest chunk payload read (a chunk ends with the record past the chunk size)
+	maxPayloadSize = 64 << 20
+)
+
+// Magic starts every state export
+var Magic = []byte("POKTSTATE")
+
+// Header is the header of a state export
+type Header struct {
+	Version uint64
+	Height  int64
+	AppHash []byte // the app hash of the height (the hash of its commit info)
+}
+
+// ChunkHeader describes a chunk: consecutive records of a module with the same record type (the first key byte)
+type ChunkHeader struct {
+	Module     string
+	RecordType byte
+	Records    uint64
+	Checksum   []byte
+}
+
+// Record is a node of the iavl tree of a store: a key/value pair (a leaf, height 0) or an inner node (no value)
+type Record struct {
+	Key     []byte
+	Value   []byte
+	Version int64 // the version the node was created at
+	Height  int8
+}
+
+// Writer writes the records of the stores to a state export, in chunks of a module and record type
+type Writer struct {
+	w          *bufio.Writer
+	module     string
+	recordType byte
+	records    uint64
+	total      uint64
+	payload    bytes.Buffer
+}
+
+// NewWriter writes the header of the state export of the height (and its app hash) to w
+func NewWriter(w io.Writer, height int64, appHash []byte) (*Writer, error) {
+	ew := &Writer{w: bufio.NewWriter(w)}
+	var buf bytes.Buffer
+	buf.Write(Magic)
+	putUvarint(&buf, Version)
+	putVarint(&buf, height)
+	putBytes(&buf, appHash)
+	if _, err := ew.w.Write(buf.Bytes()); err != nil {
+		return nil, err
+	}
+	return ew, nil
+}
+
+// WriteRecord adds the record of the module to the chunk, writing the chunk once its module or record type changes or
+// its payload reaches the chunk size
+func (w *Writer) WriteRecord(module string, record Record) error {
+	if module == "" || len(record.Key) == 0 {
+		return errors.New("a record must have a module and a key")
+	}
+	if w.records != 0 && (module != w.module || record.Key[0] != w.recordType) {
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+	w.module, w.recordType = module, record.Key[0]
+	putBytes(&w.payload, record.Key)
+	putBytes(&w.payload, record.Value)
+	putVarint(&w.payload, record.Version)
+	w.payload.WriteByte(byte(record.Height))
+	w.records++
+	w.total++
+	if w.payload.Len() >= ChunkSize {
+		return w.flush()
+	}
+	return nil
+}
+
+// Records returns the number of records written
+func (w *Writer) Records() uint64 {
+	return w.total
+}
+
+// Close writes the last chunk and the end chunk
+func (w *Writer) Close() error {
+	if err := w.flush(); err != nil {
+		return err
+	}
+	if err := writeChunk(w.w, "", 0, w.total, nil); err != nil {
+		return err
+	}
+	return w.w.Flush()
+}
+
+func (w *Writer) flush() error {
+	if w.records == 0 {
+		return nil
+	}
+	if err := writeChunk(w.w, w.module, w.recordType, w.records, w.payload.Bytes()); err != nil {
+		return err
+	}
+	w.records = 0
+	w.payload.Reset()
+	return nil
+}
+
+func writeChunk(w io.Writer, module string, recordType byte, records uint64, payload []byte) error {
+	var buf bytes.Buffer
+	putBytes(&buf, []byte(module))
+	buf.WriteByte(recordType)
+	putUvarint(&buf, records)
+	putUvarint(&buf, uint64(len(payload)))
+	checksum := chunkChecksum(buf.Bytes(), payload)
+	buf.Write(checksum)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+// Reader reads the chunks of a state export, verifying their checksums
+type Reader struct {
+	r       *bufio.Reader
+	Header  Header
+	records uint64
+	done    bool
+}
+
+// NewReader reads the header of the state export of r
+func NewReader(r io.Reader) (*Reader, error) {
+	er := &Reader{r: bufio.NewReader(r)}
+	magic := make([]byte, len(Magic))
+	if _, err := io.ReadFull(er.r, magic); err != nil || !bytes.Equal(magic, Magic) {
+		return nil, errors.New("not a state export")
+	}
+	version, err := binary.ReadUvarint(er.r)
+	if err != nil {
+		return nil, err
+	}
+	if version != Version {
+		return nil, fmt.Errorf("unsupported state export version: %d", version)
+	}
+	height, err := binary.ReadVarint(er.r)
+	if err != nil {
+		return nil, err
+	}
+	appHash, err := readBytes(er.r, 1<<10)
+	if err != nil {
+		return nil, err
+	}
+	er.Header = Header{Version: version, Height: height, AppHash: appHash}
+	return er, nil
+}
+
+// ReadChunk returns the next chunk and its records; io.EOF once the end chunk is read and the number of records verified,
+// io.ErrUnexpectedEOF if the export ends before
+func (r *Reader) ReadChunk() (ChunkHeader, []Record, error) {
+	if r.done {
+		return ChunkHeader{}, nil, io.EOF
+	}
+	head := &headerReader{r: r.r}
+	module, err := readBytes(head, 1<<10)
+	if err != nil {
+		return ChunkHeader{}, nil, unexpected(err)
+	}
+	recordType, err := head.ReadByte()
+	if err != nil {
+		return ChunkHeader{}, nil, unexpected(err)
+	}
+	records, err := binary.ReadUvarint(head)
+	if err != nil {
+		return ChunkHeader{}, nil, unexpected(err)
+	}
+	size, err := binary.ReadUvarint(head)
+	if err != nil {
+		return ChunkHeader{}, nil, unexpected(err)
+	}
+	if size > maxPayloadSize || (len(module) != 0 && records > size) {
+		return ChunkHeader{}, nil, fmt.Errorf("invalid chunk of %s: %d records, payload of %d bytes", module, records, size)
+	}
+	checksum := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(r.r, checksum); err != nil {
+		return ChunkHeader{}, nil, unexpected(err)
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r.r, payload); err != nil {
+		return ChunkHeader{}, nil, unexpected(err)
+	}
+	chunk := ChunkHeader{Module: string(module), RecordType: recordType, Records: records, Checksum: checksum}
+	if !bytes.Equal(chunkChecksum(head.buf.Bytes(), payload), checksum) {
+		return chunk, nil, fmt.Errorf("invalid checksum of a chunk of %s", chunk.Module)
+	}
+	if chunk.Module == "" {
+		if records != r.records || size != 0 {
+			return chunk, nil, fmt.Errorf("the export has %d records, expected %d", r.records, records)
+		}
+		r.done = true
+		return ChunkHeader{}, nil, io.EOF
+	}
+	recs := make([]Record, 0, records)
+	for p := bytes.NewReader(payload); p.Len() > 0; {
+		key, err := readBytes(p, size)
+		if err != nil {
+			return chunk, nil, err
+		}
+		value, err := readBytes(p, size)
+		if err != nil {
+			return chunk, nil, err
+		}
+		version, err := binary.ReadVarint(p)
+		if err != nil {
+			return chunk, nil, err
+		}
+		height, err := p.ReadByte()
+		if err != nil {
+			return chunk, nil, unexpected(err)
+		}
+		if len(key) == 0 || key[0] != recordType {
+			return chunk, nil, fmt.Errorf("invalid record of %s: key %X", chunk.Module, key)
+		}
+		recs = append(recs, Record{Key: key, Value: value, Version: version, Height: int8(height)})
+	}
+	if uint64(len(recs)) != records {
+		return chunk, nil, fmt.Errorf("invalid chunk of %s: %d records, expected %d", chunk.Module, len(recs), records)
+	}
+	r.records += records
+	return chunk, recs, nil
+}
+
+// Records returns the number of records read
+func (r *Reader) Records() uint64 {
+	return r.records
+}
+
+// headerReader reads the header of a chunk, keeping its bytes for the checksum
+type headerReader struct {
+	r   *bufio.Reader
+	buf bytes.Buffer
+}
+
+func (h *headerReader) ReadByte() (byte, error) {
+	b, err := h.r.ReadByte()
+	if err == nil {
+		h.buf.WriteByte(b)
+	}
+	return b, err
+}
+
+func chunkChecksum(header, payload []byte) []byte {
+	h := sha256.New()
+	h.Write(header)
+	h.Write(payload)
+	return h.Sum(nil)
+}
+
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func putUvarint(buf *bytes.Buffer, x uint64) {
+	var n [binary.MaxVarintLen64]byte
+	buf.Write(n[:binary.PutUvarint(n[:], x)])
+}
+
+func putVarint(buf *bytes.Buffer, x int64) {
+	var n [binary.MaxVarintLen64]byte
+	buf.Write(n[:binary.PutVarint(n[:], x)])
+}
+
+func putBytes(buf *bytes.Buffer, bz []byte) {
+	putUvarint(buf, uint64(len(bz)))
+	buf.Write(bz)
+}
+
+func readBytes(r io.ByteReader, max uint64) ([]byte, error) {
+	l, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if l > max {
+		return nil, fmt.Errorf("invalid length: %d", l)
+	}
+	bz := make([]byte, l)
+	for i := range bz {
+		if bz[i], err = r.ReadByte(); err != nil {
+			return nil, unexpected(err)
+		}
+	}
+	return bz, nil
+}
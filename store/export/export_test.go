@@ -0,0 +1,83 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeExport(t *testing.T, height int64, records map[string][]Record, modules ...string) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, height, []byte("app hash"))
+	require.NoError(t, err)
+	for _, module := range modules {
+		for _, r := range records[module] {
+			require.NoError(t, w.WriteRecord(module, r))
+		}
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func readExport(bz []byte) (Header, []ChunkHeader, map[string][]Record, error) {
+	r, err := NewReader(bytes.NewReader(bz))
+	if err != nil {
+		return Header{}, nil, nil, err
+	}
+	chunks, records := make([]ChunkHeader, 0), make(map[string][]Record)
+	for {
+		chunk, recs, err := r.ReadChunk()
+		if err == io.EOF {
+			return r.Header, chunks, records, nil
+		}
+		if err != nil {
+			return r.Header, chunks, records, err
+		}
+		chunks = append(chunks, chunk)
+		records[chunk.Module] = append(records[chunk.Module], recs...)
+	}
+}
+
+func TestExport(t *testing.T) {
+	records := map[string][]Record{
+		"acc": {{Key: []byte{0x01, 1}, Value: []byte("account 1"), Version: 3}, {Key: []byte{0x01, 2}, Value: []byte("account 2"), Version: 42},
+			{Key: []byte{0x01, 2}, Value: []byte{}, Version: 42, Height: 1}},
+		"pos": {{Key: []byte{0x11, 1}, Value: []byte("signing info"), Version: 1}, {Key: []byte{0x21, 1}, Value: []byte{}, Version: 1}, {Key: []byte{0x21, 2}, Value: []byte("validator"), Version: 1}},
+	}
+	// a chunk is cut past the chunk size
+	for i := 0; i < 3; i++ {
+		records["pocketcore"] = append(records["pocketcore"], Record{Key: []byte(fmt.Sprintf("claim %d", i)), Value: make([]byte, ChunkSize/2), Version: 40})
+	}
+	bz := writeExport(t, 42, records, "acc", "pos", "pocketcore")
+	header, chunks, read, err := readExport(bz)
+	require.NoError(t, err)
+	require.Equal(t, Header{Version: Version, Height: 42, AppHash: []byte("app hash")}, header)
+	require.Equal(t, records, read)
+	require.Len(t, chunks, 5)
+	require.Equal(t, "pos", chunks[2].Module)
+	require.Equal(t, byte(0x21), chunks[2].RecordType)
+	require.Equal(t, uint64(2), chunks[2].Records)
+	require.Equal(t, uint64(2), chunks[3].Records)
+	require.Equal(t, uint64(1), chunks[4].Records)
+	// a corrupted chunk is detected
+	for _, i := range []int{len(Magic) + 14, len(bz) / 2, len(bz) - 1} {
+		corrupted := append([]byte{}, bz...)
+		corrupted[i]++
+		_, _, _, err = readExport(corrupted)
+		require.Error(t, err)
+	}
+	// a truncated export is detected
+	_, _, _, err = readExport(bz[:len(bz)-40])
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+	// not an export
+	_, _, _, err = readExport([]byte("{\"app_state\": {}}"))
+	require.Error(t, err)
+	// an empty export
+	_, chunks, read, err = readExport(writeExport(t, 1, nil))
+	require.NoError(t, err)
+	require.Empty(t, chunks)
+	require.Empty(t, read)
+}
@@ -0,0 +1,112 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// the nodes written to the database at once by an import
+const importBatchSize = 10000
+
+// ExportNode is a node of an exported tree: the leaves have a value and a height of 0, the inner nodes the
+// key of their node (the smallest key of their right subtree). The version is the one the node was created at.
+type ExportNode struct {
+	Key     []byte
+	Value   []byte
+	Version int64
+	Height  int8
+}
+
+// Export passes the nodes of the tree to fn in post-order (from the smallest key), so an Importer rebuilds
+// the same tree (the same nodes, hence the same hash). The tree is read node by node, never held in memory.
+func (t *ImmutableTree) Export(fn func(ExportNode) error) error {
+	if t.root == nil {
+		return nil
+	}
+	var err error
+	t.root.traversePost(t, true, func(node *Node) bool {
+		err = fn(ExportNode{Key: node.key, Value: node.value, Version: node.version, Height: node.height})
+		return err != nil
+	})
+	return err
+}
+
+// Importer rebuilds an exported tree in an empty tree from its nodes (in the order of Export), writing the
+// nodes to the database in batches; the tree is saved at its next version by SaveVersion once committed
+type Importer struct {
+	tree    *MutableTree
+	stack   []importedNode // the subtrees waiting for their parent
+	pending int
+	done    bool
+}
+
+type importedNode struct {
+	hash     []byte
+	smallest []byte // the smallest key of the subtree
+	height   int8
+	size     int64
+}
+
+// NewImporter returns the importer of the empty tree (its initial version may be set)
+func NewImporter(tree *MutableTree) (*Importer, error) {
+	if tree.root != nil || len(tree.versions) != 0 {
+		return nil, fmt.Errorf("the tree is not empty, version: %d", tree.version)
+	}
+	return &Importer{tree: tree}, nil
+}
+
+// Add adds the next node of the export: a leaf, or the inner node of the last two subtrees added
+func (i *Importer) Add(n ExportNode) error {
+	if i.done {
+		return fmt.Errorf("the import is committed")
+	}
+	if n.Version < 1 || n.Version > i.tree.version+1 {
+		return fmt.Errorf("invalid version of node %X: %d, the tree is saved at %d", n.Key, n.Version, i.tree.version+1)
+	}
+	node := &Node{key: n.Key, version: n.Version, height: n.Height, size: 1}
+	smallest := n.Key
+	switch {
+	case n.Height == 0:
+		if n.Value == nil {
+			return fmt.Errorf("leaf %X has no value", n.Key)
+		}
+		node.value = n.Value
+	case len(i.stack) < 2:
+		return fmt.Errorf("inner node %X has no children", n.Key)
+	default:
+		left, right := i.stack[len(i.stack)-2], i.stack[len(i.stack)-1]
+		i.stack = i.stack[:len(i.stack)-2]
+		if n.Height != maxInt8(left.height, right.height)+1 || !bytes.Equal(n.Key, right.smallest) {
+			return fmt.Errorf("inner node %X does not match its children", n.Key)
+		}
+		node.leftHash, node.rightHash = left.hash, right.hash
+		node.size = left.size + right.size
+		smallest = left.smallest
+	}
+	node._hash()
+	i.tree.ndb.SaveNode(node)
+	i.stack = append(i.stack, importedNode{hash: node.hash, smallest: smallest, height: node.height, size: node.size})
+	if i.pending++; i.pending >= importBatchSize {
+		i.pending = 0
+		return i.tree.ndb.Commit()
+	}
+	return nil
+}
+
+// Commit writes the last nodes and sets the imported tree as the working tree of the tree
+func (i *Importer) Commit() error {
+	if i.done {
+		return fmt.Errorf("the import is committed")
+	}
+	if len(i.stack) > 1 {
+		return fmt.Errorf("incomplete import: %d subtrees", len(i.stack))
+	}
+	if err := i.tree.ndb.Commit(); err != nil {
+		return err
+	}
+	if len(i.stack) == 1 {
+		i.tree.root = i.tree.ndb.GetNode(i.stack[0].hash)
+	}
+	i.done = true
+	return nil
+}
@@ -0,0 +1,84 @@
+package iavl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestExportImport(t *testing.T) {
+	tree, err := NewMutableTree(dbm.NewMemDB(), cacheSize)
+	require.NoError(t, err)
+	hashes := make(map[int64][]byte)
+	for v := 1; v <= 10; v++ {
+		for i := 0; i < 20; i++ {
+			tree.Set([]byte(fmt.Sprintf("key %d", (v*7+i)%50)), []byte(fmt.Sprintf("value %d %d", v, i)))
+		}
+		tree.Remove([]byte(fmt.Sprintf("key %d", v)))
+		hash, version, err := tree.SaveVersion()
+		require.NoError(t, err)
+		hashes[version] = hash
+	}
+	export := func(version int64) []ExportNode {
+		immutable, err := tree.GetImmutable(version)
+		require.NoError(t, err)
+		nodes := make([]ExportNode, 0)
+		require.NoError(t, immutable.Export(func(node ExportNode) error {
+			nodes = append(nodes, node)
+			return nil
+		}))
+		return nodes
+	}
+	importAt := func(version int64, nodes []ExportNode) (*MutableTree, error) {
+		imported, err := NewMutableTree(dbm.NewMemDB(), cacheSize)
+		require.NoError(t, err)
+		require.NoError(t, imported.SetInitialVersion(version))
+		importer, err := NewImporter(imported)
+		require.NoError(t, err)
+		for _, node := range nodes {
+			if err := importer.Add(node); err != nil {
+				return nil, err
+			}
+		}
+		return imported, importer.Commit()
+	}
+	nodes := export(7)
+	// the same tree (hash) is rebuilt, at the version or later
+	for _, version := range []int64{7, 100} {
+		imported, err := importAt(version, nodes)
+		require.NoError(t, err)
+		hash, saved, err := imported.SaveVersion()
+		require.NoError(t, err)
+		require.Equal(t, version, saved)
+		require.Equal(t, hashes[7], hash)
+		original, err := tree.GetImmutable(7)
+		require.NoError(t, err)
+		original.Iterate(func(key, value []byte) bool {
+			_, v := imported.Get(key)
+			require.Equal(t, value, v)
+			return false
+		})
+		require.Equal(t, original.Size(), imported.Size())
+	}
+	// the nodes of later versions are rejected
+	_, err = importAt(5, nodes)
+	require.Error(t, err)
+	// an incomplete or tampered export is rejected
+	_, err = importAt(7, nodes[:len(nodes)-1])
+	require.Error(t, err)
+	tampered := append([]ExportNode{}, nodes...)
+	tampered[len(tampered)-1].Height++
+	_, err = importAt(7, tampered)
+	require.Error(t, err)
+	// an empty tree
+	empty, err := importAt(1, nil)
+	require.NoError(t, err)
+	_, _, err = empty.SaveVersion()
+	require.NoError(t, err)
+	require.Zero(t, empty.Size())
+	// the tree must be empty
+	_, err = NewImporter(tree)
+	require.Error(t, err)
+}
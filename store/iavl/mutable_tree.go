@@ -400,6 +400,21 @@ func (tree *MutableTree) GetImmutable(version int64) (*ImmutableTree, error) {
 	}, nil
 }
 
+// SetInitialVersion sets the version of the first save of a tree with no versions, so the tree
+// starts at a version other than 1 (e.g. a state imported at a height).
+func (tree *MutableTree) SetInitialVersion(version int64) error {
+	if latest := tree.ndb.getLatestVersion(); tree.version != 0 || latest != 0 {
+		return fmt.Errorf("the tree has versions, latest: %d", latest)
+	}
+	if version < 1 {
+		return fmt.Errorf("invalid initial version: %d", version)
+	}
+	tree.version = version - 1
+	tree.lastSaved.version = version - 1
+	tree.ndb.resetLatestVersion(version - 1)
+	return nil
+}
+
 // Rollback resets the working tree to the latest saved version, discarding
 // any unsaved modifications.
 func (tree *MutableTree) Rollback() {
@@ -0,0 +1,262 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// SnapshotReader is a consistent read only view of a database (e.g. a database snapshot)
+type SnapshotReader interface {
+	Get(key []byte) ([]byte, error)
+	Iterator(start, end []byte) (dbm.Iterator, error)
+}
+
+// ExportSnapshot passes to fn the database records of the versions [from, to] of the tree stored
+// under the prefix of db: the roots, the nodes and the orphans of the nodes, keyed as in the database.
+// Every node is exported once (in pre-order, from the root of the first version that has it), so the
+// records are in a deterministic order. The versions missing in the range are skipped, the last one must exist.
+// The orphans are derived from the exported trees (see snapshotOrphans), not read from db where they depend on
+// the versions after the range and the pruning of the node, so the snapshots of a version are the same on every node
+func ExportSnapshot(db SnapshotReader, prefix []byte, from, to int64, fn func(key, value []byte) error) error {
+	roots, err := snapshotRoots(db, prefix, from, to)
+	if err != nil {
+		return err
+	}
+	if len(roots) == 0 || roots[len(roots)-1].version != to {
+		return fmt.Errorf("version %d of the tree does not exist", to)
+	}
+	previous := int64(0)
+	for _, r := range roots {
+		if err := fn(prefixed(prefix, rootKeyFormat.Key(r.version)), r.hash); err != nil {
+			return err
+		}
+		if len(r.hash) != 0 {
+			if err := exportNodes(db, prefix, r.hash, previous, fn); err != nil {
+				return err
+			}
+		}
+		previous = r.version
+	}
+	orphans, err := snapshotOrphans(db, prefix, roots)
+	if err != nil {
+		return err
+	}
+	for _, key := range orphans {
+		if err := fn(prefixed(prefix, key), key[1+2*int64Size:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifySnapshotVersions verifies the versions [from, to] of a tree restored from a snapshot under the prefix of db:
+// the root of every version (in hashes, the root hashes of the trusted versions) and the orphans, which must be the
+// orphans derived from the trees
+func VerifySnapshotVersions(db SnapshotReader, prefix []byte, from, to int64, hashes map[int64][]byte) error {
+	roots, err := snapshotRoots(db, prefix, from, to)
+	if err != nil {
+		return err
+	}
+	if len(roots) != len(hashes) {
+		return fmt.Errorf("%d versions of the tree restored, expected %d", len(roots), len(hashes))
+	}
+	for _, r := range roots {
+		hash, ok := hashes[r.version]
+		if !ok || !bytes.Equal(r.hash, hash) {
+			return fmt.Errorf("root of version %d: %X, expected %X", r.version, r.hash, hash)
+		}
+	}
+	orphans, err := snapshotOrphans(db, prefix, roots)
+	if err != nil {
+		return err
+	}
+	it, err := db.Iterator(prefixed(prefix, []byte{orphanKeyFormat.prefix}), prefixed(prefix, []byte{orphanKeyFormat.prefix + 1}))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	i := 0
+	for ; it.Valid(); it.Next() {
+		if i >= len(orphans) || !bytes.Equal(it.Key()[len(prefix):], orphans[i]) {
+			return fmt.Errorf("unexpected orphan %X", it.Key()[len(prefix):])
+		}
+		i++
+	}
+	if i != len(orphans) {
+		return fmt.Errorf("%d orphans restored, expected %d", i, len(orphans))
+	}
+	return nil
+}
+
+type snapshotRoot struct {
+	version int64
+	hash    []byte
+}
+
+// snapshotRoots reads the roots of the versions [from, to] of the tree
+func snapshotRoots(db SnapshotReader, prefix []byte, from, to int64) ([]snapshotRoot, error) {
+	// read the roots first, the nodes are read while the iterator is closed
+	roots := make([]snapshotRoot, 0)
+	it, err := db.Iterator(prefixed(prefix, rootKeyFormat.Key(from)), prefixed(prefix, rootKeyFormat.Key(to+1)))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var version int64
+		rootKeyFormat.Scan(it.Key()[len(prefix):], &version)
+		roots = append(roots, snapshotRoot{version: version, hash: append([]byte{}, it.Value()...)})
+	}
+	return roots, nil
+}
+
+// snapshotOrphans returns the sorted orphan keys (without the prefix) of the trees of the roots: the nodes of a version
+// missing in the next version, orphaned at the version as the tree does on save. The nodes of the next version
+// up to the version are the subtrees shared by both versions (the nodes are never shared again once orphaned),
+// so only the nodes of the version outside of those subtrees are read
+func snapshotOrphans(db SnapshotReader, prefix []byte, roots []snapshotRoot) ([][]byte, error) {
+	orphans := make([][]byte, 0)
+	for i := 1; i < len(roots); i++ {
+		version, shared := roots[i-1].version, make(map[string]bool)
+		var share func(hash []byte) error
+		share = func(hash []byte) error {
+			node, err := snapshotNode(db, prefix, hash)
+			if err != nil {
+				return err
+			}
+			if node.version <= version {
+				shared[string(hash)] = true
+				return nil
+			}
+			if node.isLeaf() {
+				return nil
+			}
+			if err := share(node.leftHash); err != nil {
+				return err
+			}
+			return share(node.rightHash)
+		}
+		var orphan func(hash []byte) error
+		orphan = func(hash []byte) error {
+			if shared[string(hash)] {
+				return nil
+			}
+			node, err := snapshotNode(db, prefix, hash)
+			if err != nil {
+				return err
+			}
+			orphans = append(orphans, orphanKeyFormat.Key(version, node.version, hash))
+			if node.isLeaf() {
+				return nil
+			}
+			if err := orphan(node.leftHash); err != nil {
+				return err
+			}
+			return orphan(node.rightHash)
+		}
+		if len(roots[i].hash) != 0 {
+			if err := share(roots[i].hash); err != nil {
+				return nil, err
+			}
+		}
+		if len(roots[i-1].hash) != 0 {
+			if err := orphan(roots[i-1].hash); err != nil {
+				return nil, err
+			}
+		}
+	}
+	sort.Slice(orphans, func(i, j int) bool { return bytes.Compare(orphans[i], orphans[j]) < 0 })
+	return orphans, nil
+}
+
+// snapshotNode reads a node of the tree
+func snapshotNode(db SnapshotReader, prefix []byte, hash []byte) (*Node, error) {
+	buf, err := db.Get(prefixed(prefix, nodeKeyFormat.Key(hash)))
+	if err != nil {
+		return nil, err
+	}
+	if buf == nil {
+		return nil, fmt.Errorf("node %X does not exist", hash)
+	}
+	return MakeNode(buf)
+}
+
+// exportNodes exports the node and its children (pre-order), skipping the nodes of the versions up to previous
+// as they were exported with the tree of the previous version
+func exportNodes(db SnapshotReader, prefix []byte, hash []byte, previous int64, fn func(key, value []byte) error) error {
+	key := prefixed(prefix, nodeKeyFormat.Key(hash))
+	buf, err := db.Get(key)
+	if err != nil {
+		return err
+	}
+	if buf == nil {
+		return fmt.Errorf("node %X does not exist", hash)
+	}
+	node, err := MakeNode(buf)
+	if err != nil {
+		return err
+	}
+	if node.version <= previous {
+		return nil
+	}
+	if err := fn(key, buf); err != nil {
+		return err
+	}
+	if node.isLeaf() {
+		return nil
+	}
+	if err := exportNodes(db, prefix, node.leftHash, previous, fn); err != nil {
+		return err
+	}
+	return exportNodes(db, prefix, node.rightHash, previous, fn)
+}
+
+// VerifySnapshotRecord verifies a database record of a tree snapshot of the versions [from, to] (key without the
+// prefix): a node must be stored under its hash, a root must be a hash of a version of the range and an orphan
+// the hash of its key, orphaned in the range
+func VerifySnapshotRecord(key, value []byte, from, to int64) error {
+	if len(key) == 0 {
+		return fmt.Errorf("empty snapshot record key")
+	}
+	switch key[0] {
+	case nodeKeyFormat.prefix:
+		if len(key) != nodeKeyFormat.length {
+			return fmt.Errorf("invalid node key %X", key)
+		}
+		node, err := MakeNode(value)
+		if err != nil {
+			return err
+		}
+		if hash := node._hash(); !bytes.Equal(hash, key[1:]) {
+			return fmt.Errorf("invalid node %X: hash %X", key[1:], hash)
+		}
+	case rootKeyFormat.prefix:
+		if len(key) != rootKeyFormat.length || (len(value) != 0 && len(value) != hashSize) {
+			return fmt.Errorf("invalid root %X", key)
+		}
+		var version int64
+		rootKeyFormat.Scan(key, &version)
+		if version < from || version > to {
+			return fmt.Errorf("root of version %d out of the snapshot", version)
+		}
+	case orphanKeyFormat.prefix:
+		if len(key) != orphanKeyFormat.length || !bytes.Equal(key[1+2*int64Size:], value) {
+			return fmt.Errorf("invalid orphan %X", key)
+		}
+		var toVersion, fromVersion int64
+		orphanKeyFormat.Scan(key, &toVersion, &fromVersion)
+		if toVersion < from || toVersion >= to || fromVersion > toVersion {
+			return fmt.Errorf("orphan %X out of the snapshot", key)
+		}
+	default:
+		return fmt.Errorf("unknown snapshot record %X", key)
+	}
+	return nil
+}
+
+func prefixed(prefix, key []byte) []byte {
+	return append(append(make([]byte, 0, len(prefix)+len(key)), prefix...), key...)
+}
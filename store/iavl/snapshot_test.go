@@ -0,0 +1,156 @@
+package iavl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestExportSnapshot(t *testing.T) {
+	prefix := []byte("s/k:test/")
+	db := dbm.NewMemDB()
+	tree, hashes := newSnapshotTree(t, db, prefix, 10)
+	keys, values, err := exportSnapshot(db, prefix, 4, 10)
+	require.NoError(t, err)
+	// deterministic
+	keys2, values2, err := exportSnapshot(db, prefix, 4, 10)
+	require.NoError(t, err)
+	require.Equal(t, keys, keys2)
+	require.Equal(t, values, values2)
+	// restore the records to another db
+	restored := dbm.NewMemDB()
+	for i, key := range keys {
+		require.Equal(t, prefix, key[:len(prefix)])
+		require.NoError(t, VerifySnapshotRecord(key[len(prefix):], values[i], 4, 10))
+		restored.Set(key, values[i])
+	}
+	trusted := make(map[int64][]byte)
+	for v := int64(4); v <= 10; v++ {
+		trusted[v] = hashes[v]
+	}
+	require.NoError(t, VerifySnapshotVersions(restored, prefix, 4, 10, trusted))
+	restoredTree, err := NewMutableTree(dbm.NewPrefixDB(restored, prefix), cacheSize)
+	require.NoError(t, err)
+	version, err := restoredTree.LoadVersion(10)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), version)
+	require.Equal(t, []int{4, 5, 6, 7, 8, 9, 10}, restoredTree.AvailableVersions())
+	for v := int64(4); v <= 10; v++ {
+		original, err := tree.GetImmutable(v)
+		require.NoError(t, err)
+		it, err := restoredTree.GetImmutable(v)
+		require.NoError(t, err)
+		require.Equal(t, hashes[v], it.Hash())
+		require.Equal(t, original.Size(), it.Size())
+		original.Iterate(func(key, value []byte) bool {
+			_, v := it.Get(key)
+			require.Equal(t, value, v)
+			return false
+		})
+	}
+	// the restored versions can be pruned
+	require.NoError(t, restoredTree.DeleteVersion(4))
+	// a tampered node is detected
+	for i, key := range keys {
+		if key[len(prefix)] == nodeKeyFormat.prefix {
+			tampered := append([]byte{}, values[i]...)
+			tampered[len(tampered)-1]++
+			require.Error(t, VerifySnapshotRecord(key[len(prefix):], tampered, 4, 10))
+			break
+		}
+	}
+	// the records out of the versions are rejected
+	for i, key := range keys {
+		if key[len(prefix)] == rootKeyFormat.prefix || key[len(prefix)] == orphanKeyFormat.prefix {
+			require.Error(t, VerifySnapshotRecord(key[len(prefix):], values[i], 11, 20))
+		}
+	}
+	// the last version must exist
+	_, _, err = exportSnapshot(db, prefix, 4, 11)
+	require.Error(t, err)
+}
+
+func TestExportSnapshot_SameOnEveryNode(t *testing.T) {
+	prefix := []byte("s/k:test/")
+	// the same versions, on a node past them and on a node pruning its versions
+	db, pastDB, prunedDB := dbm.NewMemDB(), dbm.NewMemDB(), dbm.NewMemDB()
+	_, hashes := newSnapshotTree(t, db, prefix, 8)
+	newSnapshotTree(t, pastDB, prefix, 12)
+	pruned, _ := newSnapshotTree(t, prunedDB, prefix, 8)
+	require.NoError(t, pruned.DeleteVersion(1))
+	require.NoError(t, pruned.DeleteVersion(2))
+	keys, values, err := exportSnapshot(db, prefix, 4, 8)
+	require.NoError(t, err)
+	for _, other := range []dbm.DB{pastDB, prunedDB} {
+		otherKeys, otherValues, err := exportSnapshot(other, prefix, 4, 8)
+		require.NoError(t, err)
+		require.Equal(t, keys, otherKeys)
+		require.Equal(t, values, otherValues)
+	}
+	// the orphans of the tree
+	orphans := 0
+	it, err := db.Iterator(prefixed(prefix, orphanKeyFormat.Key(4)), prefixed(prefix, orphanKeyFormat.Key(8)))
+	require.NoError(t, err)
+	for ; it.Valid(); it.Next() {
+		require.Contains(t, keys, it.Key())
+		orphans++
+	}
+	it.Close()
+	require.NotZero(t, orphans)
+	// a restore with other orphans or roots is rejected
+	trusted := make(map[int64][]byte)
+	for v := int64(4); v <= 8; v++ {
+		trusted[v] = hashes[v]
+	}
+	restore := func(skip func(key []byte) bool) dbm.DB {
+		restored := dbm.NewMemDB()
+		for i, key := range keys {
+			if !skip(key) {
+				restored.Set(key, values[i])
+			}
+		}
+		return restored
+	}
+	restored := restore(func([]byte) bool { return false })
+	require.NoError(t, VerifySnapshotVersions(restored, prefix, 4, 8, trusted))
+	restored.Set(prefixed(prefix, orphanKeyFormat.Key(5, 5, hashes[8])), hashes[8])
+	require.Error(t, VerifySnapshotVersions(restored, prefix, 4, 8, trusted))
+	skipped := false
+	restored = restore(func(key []byte) bool {
+		if !skipped && key[len(prefix)] == orphanKeyFormat.prefix {
+			skipped = true
+			return true
+		}
+		return false
+	})
+	require.Error(t, VerifySnapshotVersions(restored, prefix, 4, 8, trusted))
+	trusted[6] = hashes[7]
+	require.Error(t, VerifySnapshotVersions(restore(func([]byte) bool { return false }), prefix, 4, 8, trusted))
+}
+
+// newSnapshotTree saves the versions of a tree to db under the prefix and returns the tree and the root hashes
+func newSnapshotTree(t *testing.T, db dbm.DB, prefix []byte, versions int) (*MutableTree, map[int64][]byte) {
+	tree, err := NewMutableTree(dbm.NewPrefixDB(db, prefix), cacheSize)
+	require.NoError(t, err)
+	hashes := make(map[int64][]byte)
+	for v := 1; v <= versions; v++ {
+		for i := 0; i < 20; i++ {
+			tree.Set([]byte(fmt.Sprintf("key %d", (v*7+i)%50)), []byte(fmt.Sprintf("value %d %d", v, i)))
+		}
+		tree.Remove([]byte(fmt.Sprintf("key %d", v)))
+		hash, version, err := tree.SaveVersion()
+		require.NoError(t, err)
+		hashes[version] = hash
+	}
+	return tree, hashes
+}
+
+func exportSnapshot(db dbm.DB, prefix []byte, from, to int64) (keys, values [][]byte, err error) {
+	err = ExportSnapshot(db, prefix, from, to, func(key, value []byte) error {
+		keys, values = append(keys, key), append(values, value)
+		return nil
+	})
+	return
+}
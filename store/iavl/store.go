@@ -6,6 +6,7 @@ import (
 	"log"
 	"sync"
 
+	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/libs/kv"
 
 	"github.com/pokt-network/pocket-core/store/cachekv"
@@ -20,6 +21,7 @@ import (
 
 const (
 	defaultIAVLCacheSize = 10000
+	pruningBatchSize     = 100
 )
 
 // LoadStore loads the iavl store
@@ -126,6 +128,46 @@ func (st *Store) Rollback(version int64) error {
 	return nil
 }
 
+// SetInitialVersion sets the version of the first commit of a store with no versions
+func (st *Store) SetInitialVersion(version int64) error {
+	tree, ok := st.tree.(*MutableTree)
+	if !ok {
+		return fmt.Errorf("cant turn st.Tree into mutable tree to set the initial version")
+	}
+	return tree.SetInitialVersion(version)
+}
+
+// Export passes the nodes of the tree of the version to fn (see ImmutableTree.Export)
+func (st *Store) Export(version int64, fn func(ExportNode) error) error {
+	tree, ok := st.tree.(*MutableTree)
+	if !ok {
+		return fmt.Errorf("cant turn st.Tree into mutable tree to export")
+	}
+	immutable, err := tree.GetImmutable(version)
+	if err != nil {
+		return err
+	}
+	return immutable.Export(fn)
+}
+
+// Importer returns the importer of the tree of an empty store, saved by the next commit
+func (st *Store) Importer() (*Importer, error) {
+	tree, ok := st.tree.(*MutableTree)
+	if !ok {
+		return nil, fmt.Errorf("cant turn st.Tree into mutable tree to import")
+	}
+	return NewImporter(tree)
+}
+
+// WorkingHash returns the hash of the working tree, saved by the next commit
+func (st *Store) WorkingHash() []byte {
+	tree, ok := st.tree.(*MutableTree)
+	if !ok {
+		return st.tree.Hash()
+	}
+	return tree.WorkingHash()
+}
+
 // Implements Committer.
 func (st *Store) Commit() types.CommitID {
 	// Save a new version.
@@ -137,16 +179,16 @@ func (st *Store) Commit() types.CommitID {
 	}
 
 	// Release an old version of history, if not a sync waypoint.
-	//previous := version - 1 TODO removed for testing
-	//if st.numRecent < previous {
-	//	toRelease := previous - st.numRecent
-	//	if st.storeEvery == 0 || toRelease%st.storeEvery != 0 {
-	//		err := st.tree.DeleteVersion(toRelease)
-	//		if errCause := errors.Cause(err); errCause != nil && errCause != iavl.ErrVersionDoesNotExist {
-	//			panic(err)
-	//		}
-	//	}
-	//}
+	previous := version - 1
+	if st.numRecent < previous {
+		toRelease := previous - st.numRecent
+		if st.storeEvery == 0 || toRelease%st.storeEvery != 0 {
+			err := st.tree.DeleteVersion(toRelease)
+			if errCause := errors.Cause(err); errCause != nil && errCause != ErrVersionDoesNotExist {
+				panic(err)
+			}
+		}
+	}
 
 	return types.CommitID{
 		Version: version,
@@ -168,6 +210,39 @@ func (st *Store) SetPruning(opt types.PruningOptions) {
 	st.storeEvery = opt.KeepEvery()
 }
 
+// PruneVersions deletes the old versions that are not kept by the pruning options
+// (the versions Commit would have released), returning the number of deleted versions.
+// Used to prune a store that was committed with a different pruning.
+func (st *Store) PruneVersions(opt types.PruningOptions) (int64, error) {
+	tree, ok := st.tree.(*MutableTree)
+	if !ok {
+		return 0, fmt.Errorf("cannot prune the versions of an immutable tree")
+	}
+	keepRecent, keepEvery := opt.KeepRecent(), opt.KeepEvery()
+	toDelete := make([]int64, 0)
+	for _, v := range tree.AvailableVersions() {
+		version := int64(v)
+		if version >= tree.Version()-keepRecent {
+			break
+		}
+		if keepEvery == 0 || version%keepEvery != 0 {
+			toDelete = append(toDelete, version)
+		}
+	}
+	// delete in batches, every batch is written at once
+	for i := 0; i < len(toDelete); i += pruningBatchSize {
+		end := i + pruningBatchSize
+		if end > len(toDelete) {
+			end = len(toDelete)
+		}
+		if err := tree.DeleteVersions(toDelete[i:end]...); err != nil {
+			return int64(i), err
+		}
+	}
+	st.SetPruning(opt)
+	return int64(len(toDelete)), nil
+}
+
 // VersionExists returns whether or not a given version is stored.
 func (st *Store) VersionExists(version int64) bool {
 	return st.tree.VersionExists(version)
@@ -302,21 +377,11 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 				res.Log = err.Error()
 				break
 			}
-			if proof == nil {
-				// Proof == nil implies that the store is empty.
-				if value != nil {
-					panic("unexpected value for an empty proof")
-				}
-			}
-			if value != nil {
-				// value was found
-				res.Value = value
-				res.Proof = &merkle.Proof{Ops: []merkle.ProofOp{NewValueOp(key, proof).ProofOp()}}
-			} else {
-				// value wasn't found
-				res.Value = nil
-				res.Proof = &merkle.Proof{Ops: []merkle.ProofOp{NewAbsenceOp(key, proof).ProofOp()}}
+			if res.Proof, err = proofOps(key, value, proof); err != nil {
+				res.Log = err.Error()
+				break
 			}
+			res.Value = value
 		} else {
 			_, res.Value = tree.GetVersioned(key, res.Height)
 		}
@@ -343,6 +408,39 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	return
 }
 
+// GetWithProof returns the value of the key at the version of the store (e.g. lazy loaded at a height) with the proof
+// of the value, or of its absence, against the root hash of the version
+func (st *Store) GetWithProof(key []byte) (value []byte, proof *merkle.Proof, version int64, err error) {
+	version = st.tree.Version()
+	tree, err := st.tree.GetImmutable(version)
+	if err != nil {
+		return nil, nil, version, err
+	}
+	value, rangeProof, err := tree.GetWithProof(key)
+	if err != nil {
+		return nil, nil, version, err
+	}
+	ops, err := proofOps(key, value, rangeProof)
+	if err != nil {
+		return nil, nil, version, err
+	}
+	return value, ops, version, nil
+}
+
+// proofOps returns the proof of the value of the key, or of its absence if the value is nil
+func proofOps(key, value []byte, proof *RangeProof) (*merkle.Proof, error) {
+	if proof == nil && value != nil {
+		// Proof == nil implies that the store is empty.
+		return nil, errors.New("unexpected value for an empty proof")
+	}
+	if value != nil {
+		// value was found
+		return &merkle.Proof{Ops: []merkle.ProofOp{NewValueOp(key, proof).ProofOp()}}, nil
+	}
+	// value wasn't found
+	return &merkle.Proof{Ops: []merkle.ProofOp{NewAbsenceOp(key, proof).ProofOp()}}, nil
+}
+
 //----------------------------------------
 
 // Implements types.Iterator.
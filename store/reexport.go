@@ -37,4 +37,6 @@ var (
 	PruneNothing    = types.PruneNothing
 	PruneEverything = types.PruneEverything
 	PruneSyncable   = types.PruneSyncable
+
+	NewPruningOptions = types.NewPruningOptions
 )
@@ -2,11 +2,13 @@ package rootmulti
 
 import (
 	"bytes"
+	"fmt"
 
 	"github.com/pkg/errors"
 	"github.com/pokt-network/pocket-core/store/iavl"
 
 	"github.com/tendermint/tendermint/crypto/merkle"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 // MultiStoreProof defines a collection of store proofs in a multi-store
@@ -136,3 +138,52 @@ func DefaultProofRuntime() (prt *merkle.ProofRuntime) {
 	prt.RegisterOpDecoder(ProofOpMultiStore, MultiStoreProofOpDecoder)
 	return
 }
+
+//-----------------------------------------------------------------------------
+
+// StateProof is the merkle proof of the value of a key of a store at a height, or of its absence (no value),
+// against the app hash of the version: the iavl proof of the key followed by the multi-store proof of the store
+type StateProof struct {
+	Height int64        `json:"height"`
+	Store  string       `json:"store"`
+	Key    []byte       `json:"key"`
+	Value  []byte       `json:"value"`
+	Proof  merkle.Proof `json:"proof"`
+}
+
+// GetWithProof returns the value of the key of the iavl store at the version of the store (e.g. lazy loaded at a height)
+// with its proof against the app hash of the version
+func (rs *Store) GetWithProof(storeName string, key []byte) (StateProof, error) {
+	store, ok := rs.getStoreByName(storeName).(*iavl.Store)
+	if !ok {
+		return StateProof{}, fmt.Errorf("no such iavl store: %s", storeName)
+	}
+	value, proof, version, err := store.GetWithProof(key)
+	if err != nil {
+		return StateProof{}, err
+	}
+	commitInfo, err := getCommitInfo(rs.DB, version)
+	if err != nil {
+		return StateProof{}, err
+	}
+	proof.Ops = append(proof.Ops, NewMultiStoreProofOp([]byte(storeName), NewMultiStoreProof(commitInfo.StoreInfos)).ProofOp())
+	return StateProof{Height: version, Store: storeName, Key: key, Value: value, Proof: *proof}, nil
+}
+
+// Verify verifies the proof of the value (or of the absence of the key if there is no value) against the app hash
+func (p StateProof) Verify(appHash []byte) error {
+	keyPath := merkle.KeyPath{}.AppendKey([]byte(p.Store), merkle.KeyEncodingURL).AppendKey(p.Key, merkle.KeyEncodingHex).String()
+	if len(p.Value) == 0 {
+		return DefaultProofRuntime().VerifyAbsence(&p.Proof, appHash, keyPath)
+	}
+	return DefaultProofRuntime().VerifyValue(&p.Proof, appHash, keyPath, p.Value)
+}
+
+// VerifyHeader verifies the proof against the app hash of the header of the block after the height of the proof
+// (the app hash of a block is the one of the state after the previous block)
+func (p StateProof) VerifyHeader(header tmtypes.Header) error {
+	if header.Height != p.Height+1 {
+		return fmt.Errorf("the proof of height %d is verified against the header of height %d, got %d", p.Height, p.Height+1, header.Height)
+	}
+	return p.Verify(header.AppHash)
+}
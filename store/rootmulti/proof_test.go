@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/pokt-network/pocket-core/store/iavl"
@@ -177,3 +178,47 @@ func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	err = prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYABSENTKEY", []byte(""))
 	require.NotNil(t, err)
 }
+
+func TestStateProof(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	require.Nil(t, store.LoadLatestVersion())
+	commitIDs := make(map[int64]types.CommitID)
+	for v := int64(1); v <= 3; v++ {
+		kv := store.getStoreByName("store1").(types.KVStore)
+		require.NoError(t, kv.Set([]byte("key"), []byte{byte(v)}))
+		commitIDs[v] = store.Commit()
+	}
+	lazy, err := store.LoadLazyVersion(2)
+	require.NoError(t, err)
+	proof, err := (*lazy).(*Store).GetWithProof("store1", []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), proof.Height)
+	require.Equal(t, []byte{2}, proof.Value)
+	require.NoError(t, proof.Verify(commitIDs[2].Hash))
+	require.Error(t, proof.Verify(commitIDs[3].Hash))
+	require.NoError(t, proof.VerifyHeader(tmtypes.Header{Height: 3, AppHash: commitIDs[2].Hash}))
+	require.Error(t, proof.VerifyHeader(tmtypes.Header{Height: 2, AppHash: commitIDs[2].Hash}))
+	// a tampered value, key or store
+	tampered := proof
+	tampered.Value = []byte{3}
+	require.Error(t, tampered.Verify(commitIDs[2].Hash))
+	tampered = proof
+	tampered.Key = []byte("other")
+	require.Error(t, tampered.Verify(commitIDs[2].Hash))
+	tampered = proof
+	tampered.Store = "store2"
+	require.Error(t, tampered.Verify(commitIDs[2].Hash))
+	// the absence of a key
+	absence, err := (*lazy).(*Store).GetWithProof("store1", []byte("missing"))
+	require.NoError(t, err)
+	require.Nil(t, absence.Value)
+	require.NoError(t, absence.Verify(commitIDs[2].Hash))
+	absence.Value = []byte{2}
+	require.Error(t, absence.Verify(commitIDs[2].Hash))
+	// the absence of an existing key can't be proven
+	proof.Value = nil
+	require.Error(t, proof.Verify(commitIDs[2].Hash))
+	_, err = store.GetWithProof("store77", []byte("key"))
+	require.Error(t, err)
+}
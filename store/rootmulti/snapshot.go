@@ -0,0 +1,138 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/pokt-network/pocket-core/store/iavl"
+	"github.com/pokt-network/pocket-core/store/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const storeKeyPrefixFmt = "s/k:%s/" // s/k:<name>/
+
+// ExportSnapshot passes to fn the database records of the versions [from, to] of the store read from db
+// (a consistent view of the database of the store, e.g. a database snapshot): the commit infos of the versions
+// (every version must exist) and the records of the iavl stores sorted by name (see iavl.ExportSnapshot), keyed as in the database
+func (rs *Store) ExportSnapshot(db iavl.SnapshotReader, from, to int64, fn func(key, value []byte) error) error {
+	for version := from; version <= to; version++ {
+		key := []byte(fmt.Sprintf(commitInfoKeyFmt, version))
+		value, err := db.Get(key)
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			return fmt.Errorf("version %d of the store does not exist", version)
+		}
+		if err := fn(key, value); err != nil {
+			return err
+		}
+	}
+	names := make([]string, 0, len(rs.storesParams))
+	for key, params := range rs.storesParams {
+		if params.typ == types.StoreTypeIAVL && params.db == nil {
+			names = append(names, key.Name())
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := iavl.ExportSnapshot(db, []byte(fmt.Sprintf(storeKeyPrefixFmt, name)), from, to, fn); err != nil {
+			return fmt.Errorf("error exporting store: %s: %s", name, err.Error())
+		}
+	}
+	return nil
+}
+
+// RestoreSnapshotRecord verifies a database record of a snapshot of the versions [from, to] (see ExportSnapshot)
+// and adds it to the batch
+func (rs *Store) RestoreSnapshotRecord(batch dbm.Batch, key, value []byte, from, to int64) error {
+	if bytes.HasPrefix(key, []byte("s/k:")) {
+		end := bytes.IndexByte(key[len("s/k:"):], '/')
+		if end < 0 {
+			return fmt.Errorf("invalid snapshot record %X", key)
+		}
+		name := string(key[len("s/k:") : len("s/k:")+end])
+		storeKey, ok := rs.keysByName[name]
+		if !ok || rs.storesParams[storeKey].typ != types.StoreTypeIAVL {
+			return fmt.Errorf("unknown store in snapshot: %s", name)
+		}
+		if err := iavl.VerifySnapshotRecord(key[len(fmt.Sprintf(storeKeyPrefixFmt, name)):], value, from, to); err != nil {
+			return fmt.Errorf("invalid record of store %s: %s", name, err.Error())
+		}
+	} else {
+		var version int64
+		if _, err := fmt.Sscanf(string(key), commitInfoKeyFmt, &version); err != nil || string(key) != fmt.Sprintf(commitInfoKeyFmt, version) {
+			return fmt.Errorf("invalid snapshot record %X", key)
+		}
+		if version < from || version > to {
+			return fmt.Errorf("commit info of version %d out of the snapshot", version)
+		}
+		var cInfo CommitInfo
+		if err := cdc.LegacyUnmarshalBinaryLengthPrefixed(value, &cInfo); err != nil || cInfo.Version != version {
+			return fmt.Errorf("invalid commit info of version %d", version)
+		}
+	}
+	batch.Set(key, value)
+	return nil
+}
+
+// LoadSnapshotVersion loads the last version of the snapshot of the versions [from, to] restored to the (empty)
+// database of the store and sets it as the latest version, once the commit info of every version is verified against
+// its app hash (appHashes, from a trusted source) and the iavl stores of every version against the commit infos
+func (rs *Store) LoadSnapshotVersion(from, to int64, appHashes map[int64][]byte) (types.CommitID, error) {
+	if latest := getLatestVersion(rs.DB); latest != 0 {
+		return types.CommitID{}, fmt.Errorf("the store is not empty, latest version: %d", latest)
+	}
+	// the root hashes of the versions of every iavl store
+	roots := make(map[string]map[int64][]byte)
+	for key, params := range rs.storesParams {
+		if params.typ == types.StoreTypeIAVL && params.db == nil {
+			roots[key.Name()] = make(map[int64][]byte)
+		}
+	}
+	var cInfo CommitInfo
+	for version := from; version <= to; version++ {
+		appHash, ok := appHashes[version]
+		if !ok {
+			return types.CommitID{}, fmt.Errorf("no app hash for version %d", version)
+		}
+		var err error
+		if cInfo, err = getCommitInfo(rs.DB, version); err != nil {
+			return types.CommitID{}, err
+		}
+		if hash := cInfo.Hash(); !bytes.Equal(hash, appHash) {
+			return types.CommitID{}, fmt.Errorf("the commit info of version %d does not match the app hash: %X, expected %X", version, hash, appHash)
+		}
+		for _, info := range cInfo.StoreInfos {
+			if _, ok := rs.keysByName[info.Name]; !ok {
+				return types.CommitID{}, fmt.Errorf("unknown store in commit info: %s", info.Name)
+			}
+			if hashes, ok := roots[info.Name]; ok {
+				hashes[version] = info.Core.CommitID.Hash
+			}
+		}
+	}
+	for name, hashes := range roots {
+		if err := iavl.VerifySnapshotVersions(rs.DB, []byte(fmt.Sprintf(storeKeyPrefixFmt, name)), from, to, hashes); err != nil {
+			return types.CommitID{}, fmt.Errorf("store %s does not match the commit infos: %s", name, err.Error())
+		}
+	}
+	if err := rs.LoadVersion(to); err != nil {
+		return types.CommitID{}, err
+	}
+	for _, info := range cInfo.StoreInfos {
+		got := rs.stores[rs.keysByName[info.Name]].LastCommitID()
+		if got.Version != to || !bytes.Equal(got.Hash, info.Core.CommitID.Hash) {
+			return types.CommitID{}, fmt.Errorf("store %s does not match the commit info of version %d: version %d, hash %X, expected %X",
+				info.Name, to, got.Version, got.Hash, info.Core.CommitID.Hash)
+		}
+	}
+	batch := rs.DB.NewBatch()
+	defer batch.Close()
+	setLatestVersion(batch, to)
+	if err := batch.WriteSync(); err != nil {
+		return types.CommitID{}, err
+	}
+	return rs.lastCommitID, nil
+}
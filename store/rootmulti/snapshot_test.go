@@ -0,0 +1,98 @@
+package rootmulti
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pokt-network/pocket-core/store/types"
+)
+
+func TestMultistoreSnapshot(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	require.Nil(t, store.LoadLatestVersion())
+	commitIDs := make(map[int64]types.CommitID)
+	for v := int64(1); v <= 10; v++ {
+		for i, name := range []string{"store1", "store2", "store3"} {
+			kv := store.getStoreByName(name).(types.KVStore)
+			kv.Set([]byte(fmt.Sprintf("key %d", v%4)), []byte(fmt.Sprintf("value %d %d", v, i)))
+		}
+		commitIDs[v] = store.Commit()
+	}
+	keys, values := make([][]byte, 0), make([][]byte, 0)
+	err := store.ExportSnapshot(db, 6, 10, func(key, value []byte) error {
+		keys, values = append(keys, key), append(values, value)
+		return nil
+	})
+	require.NoError(t, err)
+	// restore to an empty store
+	restoredDB := dbm.NewMemDB()
+	restored := newMultiStoreWithMounts(restoredDB)
+	require.Nil(t, restored.LoadLatestVersion())
+	batch := restoredDB.NewBatch()
+	for i, key := range keys {
+		require.NoError(t, restored.RestoreSnapshotRecord(batch, key, values[i], 6, 10))
+	}
+	require.NoError(t, batch.Write())
+	appHashes := make(map[int64][]byte)
+	for v := int64(6); v <= 10; v++ {
+		appHashes[v] = commitIDs[v].Hash
+	}
+	// every version is verified
+	for _, v := range []int64{6, 8, 10} {
+		wrong := make(map[int64][]byte)
+		for version, hash := range appHashes {
+			wrong[version] = hash
+		}
+		wrong[v] = commitIDs[v-1].Hash
+		_, err = restored.LoadSnapshotVersion(6, 10, wrong)
+		require.Error(t, err)
+	}
+	_, err = restored.LoadSnapshotVersion(5, 10, appHashes)
+	require.Error(t, err)
+	commitID, err := restored.LoadSnapshotVersion(6, 10, appHashes)
+	require.NoError(t, err)
+	require.Equal(t, commitIDs[10], commitID)
+	// the restored store loads and continues from the version
+	restored = newMultiStoreWithMounts(restoredDB)
+	require.Nil(t, restored.LoadLatestVersion())
+	checkStore(t, restored, commitIDs[10], restored.LastCommitID())
+	for v := int64(6); v <= 10; v++ {
+		_, err := restored.LoadLazyVersion(v)
+		require.NoError(t, err)
+	}
+	_, err = restored.LoadLazyVersion(5)
+	require.Error(t, err)
+	require.Equal(t, store.Commit(), restored.Commit())
+	// a store that does not match its commit info is rejected
+	tamperedDB := dbm.NewMemDB()
+	tampered := newMultiStoreWithMounts(tamperedDB)
+	require.Nil(t, tampered.LoadLatestVersion())
+	batch = tamperedDB.NewBatch()
+	for i, key := range keys {
+		if string(key) == fmt.Sprintf(commitInfoKeyFmt, 10) {
+			// the commit info of another version
+			cInfo, err := getCommitInfo(db, 9)
+			require.NoError(t, err)
+			cInfo.Version = 10
+			setCommitInfo(batch, 10, cInfo)
+			continue
+		}
+		require.NoError(t, tampered.RestoreSnapshotRecord(batch, key, values[i], 6, 10))
+	}
+	require.NoError(t, batch.Write())
+	appHashes[10] = commitIDs[9].Hash
+	_, err = tampered.LoadSnapshotVersion(6, 10, appHashes)
+	require.Error(t, err)
+	// unknown records and the records of other versions are rejected
+	require.Error(t, restored.RestoreSnapshotRecord(restoredDB.NewBatch(), []byte("s/k:store77/r"), nil, 6, 10))
+	require.Error(t, restored.RestoreSnapshotRecord(restoredDB.NewBatch(), []byte("s/latest"), nil, 6, 10))
+	for i, key := range keys {
+		if string(key) == fmt.Sprintf(commitInfoKeyFmt, 6) {
+			require.Error(t, restored.RestoreSnapshotRecord(restoredDB.NewBatch(), key, values[i], 7, 10))
+		}
+	}
+}
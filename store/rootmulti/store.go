@@ -24,6 +24,7 @@ import (
 const (
 	latestVersionKey = "s/latest"
 	commitInfoKeyFmt = "s/%d" // s/<version>
+	deleteBatchSize  = 10000  // the records deleted at once by an aborted import
 )
 
 // Store is composed of many CommitStores. Name contrasts with
@@ -91,6 +92,7 @@ func NewStore(db dbm.DB, cache bool) *Store {
 	return &Store{
 		DB:           db,
 		Cache:        multiStoreCache,
+		pruningOpts:  types.PruneNothing,
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitStore),
 		keysByName:   make(map[string]types.StoreKey),
@@ -105,6 +107,128 @@ func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	}
 }
 
+// PruneVersions deletes the old versions of the iavl substores that are not kept by
+// the pruning options and sets them as the pruning of the store, returning the number of deleted versions
+func (rs *Store) PruneVersions(pruningOpts types.PruningOptions) (pruned int64, err error) {
+	for key, substore := range rs.stores {
+		s, ok := substore.(*iavl.Store)
+		if !ok {
+			continue
+		}
+		n, err := s.PruneVersions(pruningOpts)
+		pruned += n
+		if err != nil {
+			return pruned, fmt.Errorf("error pruning store: %s: %s", key.Name(), err.Error())
+		}
+	}
+	rs.SetPruning(pruningOpts)
+	return pruned, nil
+}
+
+// SetInitialVersion sets the version of the first commit of a store with no versions (e.g. a state imported at a height)
+func (rs *Store) SetInitialVersion(version int64) error {
+	if rs.lastCommitID.Version != 0 {
+		return fmt.Errorf("the store is not empty, latest version: %d", rs.lastCommitID.Version)
+	}
+	for key, substore := range rs.stores {
+		s, ok := substore.(*iavl.Store)
+		if !ok {
+			continue
+		}
+		if err := s.SetInitialVersion(version); err != nil {
+			return fmt.Errorf("error setting the initial version of store: %s: %s", key.Name(), err.Error())
+		}
+	}
+	rs.lastCommitID.Version = version - 1
+	return nil
+}
+
+// CommitIDOf returns the commit id of the version from its commit info (the app hash of the version)
+func (rs *Store) CommitIDOf(version int64) (types.CommitID, error) {
+	cInfo, err := getCommitInfo(rs.DB, version)
+	if err != nil {
+		return types.CommitID{}, err
+	}
+	return cInfo.CommitID(), nil
+}
+
+// ExportStore passes the nodes of the iavl store of the key at the version to fn (see iavl.ImmutableTree.Export)
+func (rs *Store) ExportStore(key types.StoreKey, version int64, fn func(iavl.ExportNode) error) error {
+	s, ok := rs.stores[key].(*iavl.Store)
+	if !ok {
+		return fmt.Errorf("store %s is not an iavl store", key.Name())
+	}
+	return s.Export(version, fn)
+}
+
+// StoreImporter returns the importer of the empty iavl store of the key, the imported tree is saved by the next Commit
+func (rs *Store) StoreImporter(key types.StoreKey) (*iavl.Importer, error) {
+	s, ok := rs.stores[key].(*iavl.Store)
+	if !ok {
+		return nil, fmt.Errorf("store %s is not an iavl store", key.Name())
+	}
+	return s.Importer()
+}
+
+// WorkingHash returns the app hash of the working stores, the hash of the commit info of the next Commit
+func (rs *Store) WorkingHash() []byte {
+	version := rs.lastCommitID.Version + 1
+	storeInfos := make([]StoreInfo, 0, len(rs.stores))
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeTransient {
+			continue
+		}
+		hash := store.LastCommitID().Hash
+		if s, ok := store.(*iavl.Store); ok {
+			hash = s.WorkingHash()
+		}
+		si := StoreInfo{}
+		si.Name = key.Name()
+		si.Core.CommitID = types.CommitID{Version: version, Hash: hash}
+		storeInfos = append(storeInfos, si)
+	}
+	cInfo := CommitInfo{Version: version, StoreInfos: storeInfos}
+	return cInfo.Hash()
+}
+
+// AbortImport removes the records written by an import (see StoreImporter) to the iavl stores of the empty store
+// and reloads the stores, leaving the database as it was before the import
+func (rs *Store) AbortImport() error {
+	if latest := getLatestVersion(rs.DB); latest != 0 {
+		return fmt.Errorf("the store is not empty, latest version: %d", latest)
+	}
+	for key, params := range rs.storesParams {
+		if params.typ != types.StoreTypeIAVL || params.db != nil {
+			continue
+		}
+		if err := deletePrefix(rs.DB, []byte(fmt.Sprintf(storeKeyPrefixFmt, key.Name()))); err != nil {
+			return fmt.Errorf("error removing the records of store: %s: %s", key.Name(), err.Error())
+		}
+	}
+	return rs.LoadLatestVersion()
+}
+
+// deletePrefix deletes the records of the prefix of db in batches
+func deletePrefix(db dbm.DB, prefix []byte) error {
+	for {
+		it, err := dbm.IteratePrefix(db, prefix)
+		if err != nil {
+			return err
+		}
+		batch, n := db.NewBatch(), 0
+		for ; it.Valid() && n < deleteBatchSize; it.Next() {
+			batch.Delete(append([]byte{}, it.Key()...))
+			n++
+		}
+		it.Close()
+		err = batch.Write()
+		batch.Close()
+		if err != nil || n < deleteBatchSize {
+			return err
+		}
+	}
+}
+
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
 func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
@@ -533,7 +657,7 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 	if params.db != nil {
 		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	} else {
-		db = dbm.NewPrefixDB(rs.DB, []byte("s/k:"+params.key.Name()+"/"))
+		db = dbm.NewPrefixDB(rs.DB, []byte(fmt.Sprintf(storeKeyPrefixFmt, params.key.Name())))
 	}
 
 	switch params.typ {
@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -284,3 +285,61 @@ func hashStores(stores map[types.StoreKey]types.CommitStore) []byte {
 	}
 	return merkle.SimpleHashFromMap(m)
 }
+
+func TestMultistoreSetInitialVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	require.Nil(t, store.LoadLatestVersion())
+	require.Error(t, store.SetInitialVersion(0))
+	store = newMultiStoreWithMounts(db)
+	require.Nil(t, store.LoadLatestVersion())
+	require.NoError(t, store.SetInitialVersion(100))
+	store.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte("value"))
+	commitID := store.Commit()
+	require.Equal(t, int64(100), commitID.Version)
+	require.Equal(t, int64(101), store.Commit().Version)
+	require.Error(t, store.SetInitialVersion(200))
+	// reloads at the version
+	store = newMultiStoreWithMounts(db)
+	require.Nil(t, store.LoadVersion(100))
+	checkStore(t, store, commitID, store.LastCommitID())
+	value, _ := store.getStoreByName("store1").(types.KVStore).Get([]byte("key"))
+	require.Equal(t, []byte("value"), value)
+}
+
+func TestMultistoreImport(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.Nil(t, store.LoadLatestVersion())
+	for v := 1; v <= 3; v++ {
+		for i, name := range []string{"store1", "store2", "store3"} {
+			store.getStoreByName(name).(types.KVStore).Set([]byte(fmt.Sprintf("key %d", v)), []byte(fmt.Sprintf("value %d %d", v, i)))
+		}
+		store.Commit()
+	}
+	expected := store.LastCommitID()
+	importStores := func(imported *Store) {
+		for _, name := range []string{"store1", "store2", "store3"} {
+			importer, err := imported.StoreImporter(imported.keysByName[name])
+			require.NoError(t, err)
+			require.NoError(t, store.ExportStore(store.keysByName[name], 3, importer.Add))
+			require.NoError(t, importer.Commit())
+		}
+	}
+	db := dbm.NewMemDB()
+	imported := newMultiStoreWithMounts(db)
+	require.Nil(t, imported.LoadLatestVersion())
+	require.NoError(t, imported.SetInitialVersion(3))
+	importStores(imported)
+	// the working hash is the hash of the next commit
+	require.Equal(t, expected.Hash, imported.WorkingHash())
+	// an aborted import leaves the database empty
+	require.NoError(t, imported.AbortImport())
+	it, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	require.False(t, it.Valid())
+	it.Close()
+	require.NoError(t, imported.SetInitialVersion(3))
+	importStores(imported)
+	require.Equal(t, expected, imported.Commit())
+	require.Error(t, imported.AbortImport())
+}
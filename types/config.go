@@ -22,6 +22,8 @@ type PocketConfig struct {
 	ChainsName               string `json:"chains_name"`
 	SessionDBName            string `json:"session_db_name"`
 	EvidenceDBName           string `json:"evidence_db_name"`
+	AccountingDBName         string `json:"accounting_db_name"`
+	JobsDBName               string `json:"jobs_db_name"`
 	TendermintURI            string `json:"tendermint_uri"`
 	KeybaseName              string `json:"keybase_name"`
 	RPCPort                  string `json:"rpc_port"`
@@ -42,6 +44,18 @@ type PocketConfig struct {
 	ABCILogging              bool   `json:"abci_logging"`
 	RelayErrors              bool   `json:"show_relay_errors"`
 	DisableTxEvents          bool   `json:"disable_tx_events"`
+	RelayStreamTimeout       int64  `json:"relay_stream_timeout"`
+	ChainsHotReloadInterval  int64  `json:"chains_hot_reload_interval"`
+	RelayCacheSize           int    `json:"relay_cache_size"`
+	MaxBatchRelays           int    `json:"max_batch_relays"`
+	BatchRelayConcurrency    int    `json:"batch_relay_concurrency"`
+	RemoteSignerListenAddr   string `json:"remote_signer_laddr"`
+	RemoteSignerTimeout      int64  `json:"remote_signer_timeout"`
+	PruningKeepRecent        int64  `json:"pruning_keep_recent"`
+	PruningKeepEvery         int64  `json:"pruning_keep_every"`
+	SnapshotInterval         int64  `json:"snapshot_interval"`
+	SnapshotKeepRecent       int    `json:"snapshot_keep_recent"`
+	SnapshotDir              string `json:"snapshot_dir"`
 	Cache                    bool   `json:"-"`
 }
 
@@ -66,6 +80,8 @@ const (
 	DefaultRPCPort                     = "8081"
 	DefaultSessionDBName               = "session"
 	DefaultEvidenceDBName              = "pocket_evidence"
+	DefaultAccountingDBName            = "pocket_accounting"
+	DefaultJobsDBName                  = "pocket_jobs"
 	DefaultTMURI                       = "tcp://localhost:26657"
 	DefaultMaxSessionCacheEntries      = 500
 	DefaultMaxEvidenceCacheEntries     = 500
@@ -79,6 +95,7 @@ const (
 	ConfigFileName                     = "config.json"
 	ApplicationDBName                  = "application"
 	TransactionIndexerDBName           = "txindexer"
+	LightClientDBName                  = "lightclient"
 	PlaceholderHash                    = "0001"
 	PlaceholderURL                     = "http://127.0.0.1:8081"
 	PlaceholderServiceURL              = PlaceholderURL
@@ -94,6 +111,18 @@ const (
 	DefaultCtxCacheSize                = 20
 	DefaultABCILogging                 = false
 	DefaultRelayErrors                 = true
+	DefaultRelayStreamTimeout          = 300000
+	DefaultChainsHotReloadInterval     = 10000
+	DefaultRelayCacheSize              = 1000
+	DefaultMaxBatchRelays              = 100
+	DefaultBatchRelayConcurrency       = 10
+	DefaultRemoteSignerListenAddr      = ""
+	DefaultRemoteSignerTimeout         = 30000
+	DefaultPruningKeepRecent           = 0
+	DefaultPruningKeepEvery            = 1
+	DefaultSnapshotInterval            = 0
+	DefaultSnapshotKeepRecent          = 2
+	DefaultSnapshotDir                 = "snapshots"
 	AuthFileName                       = "auth.json"
 )
 
@@ -106,6 +135,8 @@ func DefaultConfig(dataDir string) Config {
 			ChainsName:               DefaultChainsName,
 			SessionDBName:            DefaultSessionDBName,
 			EvidenceDBName:           DefaultEvidenceDBName,
+			AccountingDBName:         DefaultAccountingDBName,
+			JobsDBName:               DefaultJobsDBName,
 			TendermintURI:            DefaultTMURI,
 			KeybaseName:              DefaultKeybaseName,
 			RPCPort:                  DefaultRPCPort,
@@ -126,6 +157,18 @@ func DefaultConfig(dataDir string) Config {
 			ABCILogging:              DefaultABCILogging,
 			RelayErrors:              DefaultRelayErrors,
 			DisableTxEvents:          DefaultRPCDisableTransactionEvents,
+			RelayStreamTimeout:       DefaultRelayStreamTimeout,
+			ChainsHotReloadInterval:  DefaultChainsHotReloadInterval,
+			RelayCacheSize:           DefaultRelayCacheSize,
+			MaxBatchRelays:           DefaultMaxBatchRelays,
+			BatchRelayConcurrency:    DefaultBatchRelayConcurrency,
+			RemoteSignerListenAddr:   DefaultRemoteSignerListenAddr,
+			RemoteSignerTimeout:      DefaultRemoteSignerTimeout,
+			PruningKeepRecent:        DefaultPruningKeepRecent,
+			PruningKeepEvery:         DefaultPruningKeepEvery,
+			SnapshotInterval:         DefaultSnapshotInterval,
+			SnapshotKeepRecent:       DefaultSnapshotKeepRecent,
+			SnapshotDir:              DefaultSnapshotDir,
 		},
 	}
 	c.TendermintConfig.LevelDBOptions = config.DefaultLevelDBOpts()
@@ -62,6 +62,13 @@ type ProtoMsg interface {
 	GetFee() BigInt
 }
 
+// FeatureMsg - a msg with the fields of a feature upgrade; the binaries before the feature drop the fields they don't know
+// (and can't decode the msgs they don't know), so the msg is decoded as they do until the feature is active
+type FeatureMsg interface {
+	// Returns the msg without the fields of the features inactive at the height (nil if the msg itself is inactive)
+	WithoutInactiveFeatures(height int64) ProtoMsg
+}
+
 //__________________________________________________________
 
 // Transactions objects must fulfill the Tx
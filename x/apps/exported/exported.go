@@ -17,4 +17,5 @@ type ApplicationI interface {
 	GetPublicKey() crypto.PublicKey // validation consensus pubkey
 	GetTokens() sdk.BigInt          // validation tokens
 	GetMaxRelays() sdk.BigInt       // maximum relays
+	GetGatewayPublicKeys() []string // gateways allowed to sign AATs on behalf of the application
 }
@@ -22,6 +22,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgBeginUnstake(ctx, msg, k)
 		case types.MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k)
+		case types.MsgDelegate:
+			return handleMsgDelegate(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -113,3 +115,26 @@ func handleMsgUnjail(ctx sdk.Ctx, msg types.MsgUnjail, k keeper.Keeper) sdk.Resu
 	)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// Applications set the gateways allowed to sign AATs on their behalf (an empty list revokes every gateway)
+func handleMsgDelegate(ctx sdk.Ctx, msg types.MsgDelegate, k keeper.Keeper) sdk.Result {
+	application, err := k.ValidateDelegation(ctx, msg)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("App Delegation Validation Not Successful, at height: %d", ctx.BlockHeight()) + msg.Address.String())
+		return err.Result()
+	}
+	k.DelegateApplication(ctx, application, msg.GatewayPublicKeys)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
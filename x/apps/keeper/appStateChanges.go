@@ -5,6 +5,7 @@ import (
 	"github.com/tendermint/tendermint/libs/strings"
 	"time"
 
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/apps/types"
@@ -207,6 +208,8 @@ func (k Keeper) FinishUnstakingApplication(ctx sdk.Ctx, application types.Applic
 	application.MaxRelays = sdk.ZeroInt()
 	// update the unstaking time
 	application.UnstakingCompletionTime = time.Time{}
+	// remove the gateway delegations
+	application.GatewayPublicKeys = nil
 	// update the application in the main store
 	k.SetApplication(ctx, application)
 	ctx.Logger().Info("Finished unstaking application " + application.Address.String())
@@ -319,6 +322,33 @@ func (k Keeper) IncrementJailedApplications(ctx sdk.Ctx) {
 	// TODO
 }
 
+// ValidateDelegation - Check if the application can delegate the signing of its AATs
+func (k Keeper) ValidateDelegation(ctx sdk.Ctx, msg types.MsgDelegate) (application types.Application, err sdk.Error) {
+	if !k.Cdc.IsAfterFeatureUpgrade(codec.AppDelegationFeatureKey, ctx.BlockHeight()) {
+		return types.Application{}, types.ErrDelegationNotAllowed(k.Codespace())
+	}
+	application, found := k.GetApplication(ctx, msg.Address)
+	if !found {
+		return types.Application{}, types.ErrNoApplicationFound(k.Codespace())
+	}
+	// only a staked application may delegate
+	if !application.IsStaked() {
+		return types.Application{}, types.ErrApplicationStatus(k.Codespace())
+	}
+	return application, nil
+}
+
+// DelegateApplication - Store ops when an application sets the gateways allowed to sign its AATs
+// (relays and proofs read the gateways of the application at the session block height, so they apply from the next session)
+func (k Keeper) DelegateApplication(ctx sdk.Ctx, application types.Application, gatewayPublicKeys []string) {
+	if len(gatewayPublicKeys) == 0 {
+		gatewayPublicKeys = nil
+	}
+	application.GatewayPublicKeys = gatewayPublicKeys
+	k.SetApplication(ctx, application)
+	ctx.Logger().Info(fmt.Sprintf("application %s delegated to %d gateway(s)", application.Address, len(gatewayPublicKeys)))
+}
+
 // ValidateUnjailMessage - Check unjail message
 func (k Keeper) ValidateUnjailMessage(ctx sdk.Ctx, msg types.MsgUnjail) (addr sdk.Address, err sdk.Error) {
 	application, found := k.GetApplication(ctx, msg.AppAddr)
@@ -4,6 +4,7 @@ import (
 	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/stretchr/testify/assert"
 	"reflect"
 	"testing"
 )
@@ -371,3 +372,40 @@ func TestAppStateChange_BeginUnstakingApplication(t *testing.T) {
 		})
 	}
 }
+
+func TestAppStateChange_DelegateApplication(t *testing.T) {
+	defer func(f map[string]int64) { codec.UpgradeFeatureMap = f }(codec.UpgradeFeatureMap)
+	codec.UpgradeFeatureMap = map[string]int64{codec.AppDelegationFeatureKey: 30025}
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	gateway := getRandomPubKey().RawString()
+	msg := types.MsgDelegate{Address: application.Address, GatewayPublicKeys: []string{gateway}}
+	// not allowed before the delegation feature
+	_, err := keeper.ValidateDelegation(context, msg)
+	assert.Equal(t, types.ErrDelegationNotAllowed("apps"), err)
+	context = context.WithBlockHeight(30025)
+	// the application must exist and be staked
+	_, err = keeper.ValidateDelegation(context, types.MsgDelegate{Address: getRandomApplicationAddress()})
+	assert.Equal(t, types.ErrNoApplicationFound("apps"), err)
+	unstaked := getUnstakedApplication()
+	keeper.SetApplication(context, unstaked)
+	_, err = keeper.ValidateDelegation(context, types.MsgDelegate{Address: unstaked.Address})
+	assert.Equal(t, types.ErrApplicationStatus("apps"), err)
+	// delegate
+	app, err := keeper.ValidateDelegation(context, msg)
+	assert.Nil(t, err)
+	keeper.DelegateApplication(context, app, msg.GatewayPublicKeys)
+	got, found := keeper.GetApplication(context, application.Address)
+	assert.True(t, found)
+	assert.True(t, got.IsGateway(gateway))
+	// an edit stake keeps the gateways
+	assert.Nil(t, keeper.EditStakeApplication(context, got, got, got.StakedTokens))
+	got, _ = keeper.GetApplication(context, application.Address)
+	assert.Equal(t, []string{gateway}, got.GatewayPublicKeys)
+	// undelegate
+	keeper.DelegateApplication(context, got, []string{})
+	got, _ = keeper.GetApplication(context, application.Address)
+	assert.Nil(t, got.GatewayPublicKeys)
+	assert.False(t, got.IsGateway(gateway))
+}
@@ -15,14 +15,15 @@ import (
 
 // Application represents a pocket network decentralized application. Applications stake in the network for relay throughput.
 type Application struct {
-	Address                 sdk.Address      `json:"address" yaml:"address"`               // address of the application; hex encoded in JSON
-	PublicKey               crypto.PublicKey `json:"public_key" yaml:"public_key"`         // the public key of the application; hex encoded in JSON
-	Jailed                  bool             `json:"jailed" yaml:"jailed"`                 // has the application been jailed from staked status?
-	Status                  sdk.StakeStatus  `json:"status" yaml:"status"`                 // application status (staked/unstaking/unstaked)
-	Chains                  []string         `json:"chains" yaml:"chains"`                 // requested chains
-	StakedTokens            sdk.BigInt       `json:"tokens" yaml:"tokens"`                 // tokens staked in the network
-	MaxRelays               sdk.BigInt       `json:"max_relays" yaml:"max_relays"`         // maximum number of relays allowed
-	UnstakingCompletionTime time.Time        `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the application to complete unstaking
+	Address                 sdk.Address      `json:"address" yaml:"address"`                                   // address of the application; hex encoded in JSON
+	PublicKey               crypto.PublicKey `json:"public_key" yaml:"public_key"`                             // the public key of the application; hex encoded in JSON
+	Jailed                  bool             `json:"jailed" yaml:"jailed"`                                     // has the application been jailed from staked status?
+	Status                  sdk.StakeStatus  `json:"status" yaml:"status"`                                     // application status (staked/unstaking/unstaked)
+	Chains                  []string         `json:"chains" yaml:"chains"`                                     // requested chains
+	StakedTokens            sdk.BigInt       `json:"tokens" yaml:"tokens"`                                     // tokens staked in the network
+	MaxRelays               sdk.BigInt       `json:"max_relays" yaml:"max_relays"`                             // maximum number of relays allowed
+	UnstakingCompletionTime time.Time        `json:"unstaking_time" yaml:"unstaking_time"`                     // if unstaking, min time for the application to complete unstaking
+	GatewayPublicKeys       []string         `json:"gateway_public_keys,omitempty" yaml:"gateway_public_keys"` // the gateways allowed to sign AATs on behalf of the application
 }
 
 // NewApplication - initialize a new instance of an application
@@ -93,6 +94,7 @@ func (a Application) GetPublicKey() crypto.PublicKey { return a.PublicKey }
 func (a Application) GetTokens() sdk.BigInt          { return a.StakedTokens }
 func (a Application) GetConsensusPower() int64       { return a.ConsensusPower() }
 func (a Application) GetMaxRelays() sdk.BigInt       { return a.MaxRelays }
+func (a Application) GetGatewayPublicKeys() []string { return a.GatewayPublicKeys }
 
 var _ codec.ProtoMarshaler = &Application{}
 
@@ -145,6 +147,7 @@ func (a Application) ToProto() ProtoApplication {
 		StakedTokens:            a.StakedTokens,
 		MaxRelays:               a.MaxRelays,
 		UnstakingCompletionTime: a.UnstakingCompletionTime,
+		GatewayPublicKeys:       a.GatewayPublicKeys,
 	}
 }
 
@@ -162,6 +165,7 @@ func (ae ProtoApplication) FromProto() (Application, error) {
 		StakedTokens:            ae.StakedTokens,
 		MaxRelays:               ae.MaxRelays,
 		UnstakingCompletionTime: ae.UnstakingCompletionTime,
+		GatewayPublicKeys:       ae.GatewayPublicKeys,
 	}, nil
 }
 
@@ -175,23 +179,38 @@ func (a Applications) String() (out string) {
 	return strings.TrimSpace(out)
 }
 
+// IsGateway returns true if the gateway public key may sign AATs on behalf of the application
+func (a Application) IsGateway(publicKey string) bool {
+	for _, pk := range a.GatewayPublicKeys {
+		if pk == publicKey {
+			return true
+		}
+	}
+	return false
+}
+
 // String returns a human readable string representation of a application.
 func (a Application) String() string {
-	return fmt.Sprintf("Address:\t\t%s\nPublic Key:\t\t%s\nJailed:\t\t\t%v\nChains:\t\t\t%v\nMaxRelays:\t\t%v\nStatus:\t\t\t%s\nTokens:\t\t\t%s\nUnstaking Time:\t%v\n----\n",
-		a.Address, a.PublicKey.RawString(), a.Jailed, a.Chains, a.MaxRelays, a.Status, a.StakedTokens, a.UnstakingCompletionTime,
+	var gateways string
+	if len(a.GatewayPublicKeys) != 0 {
+		gateways = fmt.Sprintf("Gateways:\t\t%v\n", a.GatewayPublicKeys)
+	}
+	return fmt.Sprintf("Address:\t\t%s\nPublic Key:\t\t%s\nJailed:\t\t\t%v\nChains:\t\t\t%v\nMaxRelays:\t\t%v\nStatus:\t\t\t%s\nTokens:\t\t\t%s\nUnstaking Time:\t%v\n%s----\n",
+		a.Address, a.PublicKey.RawString(), a.Jailed, a.Chains, a.MaxRelays, a.Status, a.StakedTokens, a.UnstakingCompletionTime, gateways,
 	)
 }
 
 // this is a helper struct used for JSON de- and encoding only
 type JSONApplication struct {
-	Address                 sdk.Address     `json:"address" yaml:"address"`               // the hex address of the application
-	PublicKey               string          `json:"public_key" yaml:"public_key"`         // the hex consensus public key of the application
-	Jailed                  bool            `json:"jailed" yaml:"jailed"`                 // has the application been jailed from staked status?
-	Chains                  []string        `json:"chains" yaml:"chains"`                 // non native (external) blockchains needed for the application
-	MaxRelays               sdk.BigInt      `json:"max_relays" yaml:"max_relays"`         // maximum number of relays allowed for the application
-	Status                  sdk.StakeStatus `json:"status" yaml:"status"`                 // application status (staked/unstaking/unstaked)
-	StakedTokens            sdk.BigInt      `json:"staked_tokens" yaml:"staked_tokens"`   // how many staked tokens
-	UnstakingCompletionTime time.Time       `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the application to complete unstaking
+	Address                 sdk.Address     `json:"address" yaml:"address"`                                   // the hex address of the application
+	PublicKey               string          `json:"public_key" yaml:"public_key"`                             // the hex consensus public key of the application
+	Jailed                  bool            `json:"jailed" yaml:"jailed"`                                     // has the application been jailed from staked status?
+	Chains                  []string        `json:"chains" yaml:"chains"`                                     // non native (external) blockchains needed for the application
+	MaxRelays               sdk.BigInt      `json:"max_relays" yaml:"max_relays"`                             // maximum number of relays allowed for the application
+	Status                  sdk.StakeStatus `json:"status" yaml:"status"`                                     // application status (staked/unstaking/unstaked)
+	StakedTokens            sdk.BigInt      `json:"staked_tokens" yaml:"staked_tokens"`                       // how many staked tokens
+	UnstakingCompletionTime time.Time       `json:"unstaking_time" yaml:"unstaking_time"`                     // if unstaking, min time for the application to complete unstaking
+	GatewayPublicKeys       []string        `json:"gateway_public_keys,omitempty" yaml:"gateway_public_keys"` // the gateways allowed to sign AATs on behalf of the application
 }
 
 // marshal structure into JSON encoding
@@ -210,6 +229,7 @@ func (a Application) MarshalJSON() ([]byte, error) {
 		MaxRelays:               a.MaxRelays,
 		StakedTokens:            a.StakedTokens,
 		UnstakingCompletionTime: a.UnstakingCompletionTime,
+		GatewayPublicKeys:       a.GatewayPublicKeys,
 	})
 }
 
@@ -232,6 +252,7 @@ func (a *Application) UnmarshalJSON(data []byte) error {
 		StakedTokens:            bv.StakedTokens,
 		Status:                  bv.Status,
 		UnstakingCompletionTime: bv.UnstakingCompletionTime,
+		GatewayPublicKeys:       bv.GatewayPublicKeys,
 	}
 	return nil
 }
@@ -1312,3 +1312,33 @@ func TestApplicationUtil_UnMarshalApplication(t *testing.T) {
 		})
 	}
 }
+
+func TestApplication_GatewayPublicKeys(t *testing.T) {
+	pub := crypto.GenerateEd25519PrivKey().PublicKey()
+	gateway := crypto.GenerateEd25519PrivKey().PublicKey().RawString()
+	app := NewApplication(sdk.Address(pub.Address()), pub, []string{"0001"}, sdk.NewInt(100))
+	app.GatewayPublicKeys = []string{gateway}
+	if !app.IsGateway(gateway) || app.IsGateway(pub.RawString()) {
+		t.Errorf("IsGateway() of %v is invalid", app.GatewayPublicKeys)
+	}
+	// proto round trip
+	got, err := app.ToProto().FromProto()
+	if err != nil || !reflect.DeepEqual(got.GetGatewayPublicKeys(), app.GatewayPublicKeys) {
+		t.Errorf("FromProto() = %v, want %v (err %v)", got.GatewayPublicKeys, app.GatewayPublicKeys, err)
+	}
+	// json round trip
+	bz, err := app.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var unmarshalled Application
+	if err := unmarshalled.UnmarshalJSON(bz); err != nil || !reflect.DeepEqual(unmarshalled.GatewayPublicKeys, app.GatewayPublicKeys) {
+		t.Errorf("UnmarshalJSON() = %v, want %v (err %v)", unmarshalled.GatewayPublicKeys, app.GatewayPublicKeys, err)
+	}
+	// the field is omitted when the application did not delegate
+	app.GatewayPublicKeys = nil
+	bz, _ = app.MarshalJSON()
+	if strings.Contains(string(bz), "gateway_public_keys") {
+		t.Errorf("MarshalJSON() = %s, the gateways should be omitted", bz)
+	}
+}
@@ -49,6 +49,7 @@ type ProtoApplication struct {
 	StakedTokens            github_com_pokt_network_pocket_core_types.BigInt      `protobuf:"bytes,6,opt,name=staked_tokens,json=stakedTokens,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"tokens" yaml:"tokens"`
 	MaxRelays               github_com_pokt_network_pocket_core_types.BigInt      `protobuf:"bytes,7,opt,name=max_relays,json=maxRelays,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"max_relays" yaml:"max_relays"`
 	UnstakingCompletionTime time.Time                                             `protobuf:"bytes,8,opt,name=unstaking_completion_time,json=unstakingCompletionTime,proto3,stdtime" json:"unstaking_time" yaml:"unstaking_time"`
+	GatewayPublicKeys       []string                                              `protobuf:"bytes,9,rep,name=gateway_public_keys,proto3" json:"gateway_public_keys,omitempty" yaml:"gateway_public_keys"`
 }
 
 func (m *ProtoApplication) Reset()         { *m = ProtoApplication{} }
@@ -130,42 +131,45 @@ func init() {
 func init() { proto.RegisterFile("x/apps/apps.proto", fileDescriptor_5d5a21b1d350fd62) }
 
 var fileDescriptor_5d5a21b1d350fd62 = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0xd1, 0xd6, 0x4d, 0x8e, 0xb6, 0xa2, 0x16, 0x08, 0x53, 0x24, 0x9f, 0x65, 0x16, 0x2f,
-	0xb5, 0x81, 0x08, 0x09, 0x65, 0xab, 0x99, 0x80, 0x25, 0x72, 0x32, 0xc1, 0x10, 0x5d, 0x9c, 0xc3,
-	0x35, 0xfe, 0x71, 0x56, 0x7c, 0x11, 0x09, 0x7f, 0x01, 0x12, 0x4b, 0xff, 0x84, 0xcc, 0xfc, 0x25,
-	0x1d, 0x3b, 0x22, 0x86, 0x03, 0x25, 0x0b, 0xca, 0x84, 0x32, 0x32, 0xa1, 0xbb, 0x73, 0xea, 0xb2,
-	0x45, 0xb0, 0x44, 0xf7, 0xde, 0x97, 0xf7, 0xde, 0x77, 0x9f, 0x3f, 0x1b, 0x1e, 0x4f, 0x3d, 0x5c,
-	0x14, 0xa5, 0xfc, 0x71, 0x8b, 0x31, 0x65, 0x54, 0xd7, 0xa6, 0xae, 0x40, 0x27, 0x77, 0x23, 0x1a,
-	0x51, 0x49, 0x79, 0xe2, 0xa4, 0xaa, 0x27, 0x28, 0xa2, 0x34, 0x4a, 0x89, 0x27, 0xd1, 0x70, 0xf2,
-	0xce, 0x63, 0x71, 0x46, 0x4a, 0x86, 0xb3, 0x42, 0xfd, 0xc1, 0xfe, 0xa2, 0xc1, 0x3b, 0x5d, 0x71,
-	0x3a, 0x2b, 0x8a, 0x34, 0x0e, 0x31, 0x8b, 0x69, 0xae, 0xa7, 0x70, 0x1f, 0x8f, 0x46, 0x63, 0x52,
-	0x96, 0x06, 0xb0, 0x80, 0x73, 0xe0, 0x07, 0x2b, 0x8e, 0x36, 0xd4, 0x9a, 0xa3, 0xa3, 0x19, 0xce,
-	0xd2, 0x8e, 0x5d, 0x11, 0xf6, 0x6f, 0x8e, 0x9e, 0x44, 0x31, 0x3b, 0x9f, 0x0c, 0xdd, 0x90, 0x66,
-	0x5e, 0x41, 0x13, 0x76, 0x9a, 0x13, 0xf6, 0x81, 0x8e, 0x13, 0xaf, 0xa0, 0x61, 0x42, 0xd8, 0x69,
-	0x48, 0xc7, 0xc4, 0x63, 0xb3, 0x82, 0x94, 0xee, 0x99, 0x52, 0x05, 0x1b, 0x3f, 0xdd, 0x87, 0xb0,
-	0x98, 0x0c, 0xd3, 0x38, 0x1c, 0x24, 0x64, 0x66, 0xdc, 0x92, 0x81, 0x8f, 0x56, 0x1c, 0xdd, 0x60,
-	0xd7, 0x1c, 0x1d, 0xab, 0xcc, 0x9a, 0xb3, 0x83, 0x96, 0x02, 0xaf, 0xc9, 0x4c, 0x6f, 0x43, 0xed,
-	0x3d, 0x8e, 0x53, 0x32, 0x32, 0x76, 0x2c, 0xe0, 0x34, 0xfd, 0x87, 0x2b, 0x8e, 0x2a, 0x66, 0xcd,
-	0xd1, 0xa1, 0xd2, 0x2a, 0x6c, 0x07, 0x55, 0x41, 0x4f, 0xa1, 0x56, 0x32, 0xcc, 0x26, 0xa5, 0xb1,
-	0x6b, 0x01, 0x67, 0xcf, 0xef, 0x0b, 0x91, 0x62, 0x6a, 0x91, 0xc2, 0xe2, 0x8e, 0xcf, 0xb6, 0xbf,
-	0x63, 0x8f, 0xe1, 0x84, 0xf4, 0xa4, 0x32, 0xa8, 0x1c, 0x45, 0x8b, 0xe1, 0x39, 0x8e, 0xf3, 0xd2,
-	0xd8, 0xb3, 0x76, 0x9c, 0x96, 0x6a, 0x51, 0x31, 0x75, 0x9a, 0xc2, 0x76, 0x50, 0x15, 0xf4, 0x29,
-	0x3c, 0x2c, 0x85, 0xd7, 0x68, 0xc0, 0x68, 0x42, 0xf2, 0xd2, 0xd0, 0x2c, 0xe0, 0xb4, 0xfc, 0xde,
-	0x25, 0x47, 0x8d, 0x6f, 0x1c, 0x3d, 0xde, 0xbe, 0x25, 0x3f, 0x8e, 0x5e, 0xe6, 0x4c, 0x64, 0x2a,
-	0xa7, 0x3a, 0x53, 0x61, 0x3b, 0x38, 0x50, 0x49, 0x7d, 0x09, 0xf5, 0x8f, 0x10, 0x66, 0x78, 0x3a,
-	0x18, 0x93, 0x14, 0xcf, 0x4a, 0x63, 0x5f, 0xc6, 0xbe, 0xfd, 0x8f, 0xd8, 0x1b, 0x6e, 0xf5, 0xd3,
-	0xac, 0x39, 0x3b, 0x68, 0x65, 0x78, 0x1a, 0xc8, 0xb3, 0xfe, 0x19, 0xc0, 0x07, 0x93, 0x5c, 0xb4,
-	0x13, 0xe7, 0xd1, 0x20, 0xa4, 0x59, 0x91, 0x12, 0xb1, 0x98, 0x03, 0xb1, 0xbd, 0x46, 0xd3, 0x02,
-	0xce, 0xed, 0xa7, 0x27, 0xae, 0x5a, 0x6d, 0x77, 0xb3, 0xda, 0x6e, 0x7f, 0xb3, 0xda, 0x7e, 0x5b,
-	0xf4, 0xb9, 0xe2, 0xe8, 0xa8, 0x36, 0x11, 0xca, 0x35, 0x47, 0xf7, 0x54, 0xee, 0xdf, 0xbc, 0x7d,
-	0xf1, 0x1d, 0x81, 0xe0, 0xfe, 0x35, 0xf9, 0xe2, 0x3a, 0x50, 0x58, 0x76, 0x9a, 0x9f, 0xe6, 0xa8,
-	0xf1, 0x73, 0x8e, 0x80, 0x3d, 0x84, 0xbb, 0x5d, 0x4a, 0x53, 0xbd, 0x0b, 0xab, 0x21, 0xca, 0xd7,
-	0xa3, 0xe5, 0x3f, 0xff, 0xd7, 0xb9, 0x04, 0x95, 0x4f, 0xa7, 0x29, 0xfc, 0x7f, 0xcd, 0x11, 0xf0,
-	0x5f, 0x5d, 0x2e, 0x4c, 0x70, 0xb5, 0x30, 0xc1, 0x8f, 0x85, 0x09, 0x2e, 0x96, 0x66, 0xe3, 0x6a,
-	0x69, 0x36, 0xbe, 0x2e, 0xcd, 0xc6, 0x9b, 0xad, 0xdc, 0xab, 0x0f, 0x84, 0x0c, 0x19, 0x6a, 0x72,
-	0x36, 0xed, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x38, 0x85, 0x13, 0xd9, 0x37, 0x04, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x31, 0x6f, 0x13, 0x31,
+	0x18, 0x8d, 0x69, 0x9b, 0x26, 0xa6, 0xad, 0xe8, 0x01, 0xe2, 0x08, 0x22, 0x8e, 0x8e, 0x25, 0x03,
+	0xbd, 0x03, 0x2a, 0x24, 0xd4, 0x05, 0xf5, 0x98, 0x80, 0xa5, 0x72, 0x3b, 0xc1, 0x10, 0x39, 0x17,
+	0x73, 0x3d, 0x72, 0x77, 0x36, 0xb1, 0xa3, 0xe6, 0xf8, 0x05, 0x48, 0x2c, 0xfd, 0x03, 0x48, 0xfd,
+	0x39, 0x1d, 0x3b, 0x22, 0x06, 0x83, 0xda, 0x05, 0x65, 0x42, 0x19, 0x99, 0x90, 0xed, 0x4b, 0xaf,
+	0x48, 0x1d, 0x2a, 0x58, 0x22, 0xbf, 0xf7, 0xf9, 0x7b, 0xef, 0xb3, 0xbe, 0x97, 0x83, 0xeb, 0x93,
+	0x80, 0x70, 0x2e, 0xcc, 0x8f, 0xcf, 0x47, 0x4c, 0x32, 0xa7, 0x3e, 0xf1, 0x35, 0x6a, 0xdd, 0x8a,
+	0x59, 0xcc, 0x0c, 0x15, 0xe8, 0x93, 0xad, 0xb6, 0x50, 0xcc, 0x58, 0x9c, 0xd2, 0xc0, 0xa0, 0xfe,
+	0xf8, 0x5d, 0x20, 0x93, 0x8c, 0x0a, 0x49, 0x32, 0x6e, 0x2f, 0x78, 0x5f, 0x96, 0xe1, 0x8d, 0x1d,
+	0x7d, 0xda, 0xe6, 0x3c, 0x4d, 0x22, 0x22, 0x13, 0x96, 0x3b, 0x29, 0x5c, 0x26, 0x83, 0xc1, 0x88,
+	0x0a, 0xe1, 0x82, 0x0e, 0xe8, 0xae, 0x84, 0x78, 0xaa, 0xd0, 0x9c, 0x9a, 0x29, 0xb4, 0x56, 0x90,
+	0x2c, 0xdd, 0xf2, 0x4a, 0xc2, 0xfb, 0xad, 0xd0, 0xe3, 0x38, 0x91, 0xfb, 0xe3, 0xbe, 0x1f, 0xb1,
+	0x2c, 0xe0, 0x6c, 0x28, 0x37, 0x72, 0x2a, 0x0f, 0xd8, 0x68, 0x18, 0x70, 0x16, 0x0d, 0xa9, 0xdc,
+	0x88, 0xd8, 0x88, 0x06, 0xb2, 0xe0, 0x54, 0xf8, 0xdb, 0xb6, 0x0b, 0xcf, 0xf5, 0x9c, 0x10, 0x42,
+	0x3e, 0xee, 0xa7, 0x49, 0xd4, 0x1b, 0xd2, 0xc2, 0xbd, 0x66, 0x0c, 0x1f, 0x4c, 0x15, 0xba, 0xc0,
+	0xce, 0x14, 0x5a, 0xb7, 0x9e, 0x15, 0xe7, 0xe1, 0xa6, 0x05, 0xaf, 0x69, 0xe1, 0x6c, 0xc2, 0xfa,
+	0x7b, 0x92, 0xa4, 0x74, 0xe0, 0x2e, 0x74, 0x40, 0xb7, 0x11, 0xde, 0x9b, 0x2a, 0x54, 0x32, 0x33,
+	0x85, 0x56, 0x6d, 0xaf, 0xc5, 0x1e, 0x2e, 0x0b, 0x4e, 0x0a, 0xeb, 0x42, 0x12, 0x39, 0x16, 0xee,
+	0x62, 0x07, 0x74, 0x97, 0xc2, 0x3d, 0xdd, 0x64, 0x99, 0xaa, 0xc9, 0x62, 0xfd, 0xc6, 0xa7, 0x57,
+	0x7f, 0xe3, 0xae, 0x24, 0x43, 0xba, 0x6b, 0x3a, 0x71, 0xa9, 0xa8, 0x47, 0x8c, 0xf6, 0x49, 0x92,
+	0x0b, 0x77, 0xa9, 0xb3, 0xd0, 0x6d, 0xda, 0x11, 0x2d, 0x53, 0xb9, 0x59, 0xec, 0xe1, 0xb2, 0xe0,
+	0x4c, 0xe0, 0xaa, 0xd0, 0x5a, 0x83, 0x9e, 0x64, 0x43, 0x9a, 0x0b, 0xb7, 0xde, 0x01, 0xdd, 0x66,
+	0xb8, 0x7b, 0xac, 0x50, 0xed, 0x9b, 0x42, 0x8f, 0xae, 0x3e, 0x52, 0x98, 0xc4, 0x2f, 0x73, 0xa9,
+	0x3d, 0xad, 0x52, 0xe5, 0x69, 0xb1, 0x87, 0x57, 0xac, 0xd3, 0x9e, 0x81, 0xce, 0x47, 0x08, 0x33,
+	0x32, 0xe9, 0x8d, 0x68, 0x4a, 0x0a, 0xe1, 0x2e, 0x1b, 0xdb, 0xb7, 0xff, 0x61, 0x7b, 0x41, 0xad,
+	0xda, 0x66, 0xc5, 0x79, 0xb8, 0x99, 0x91, 0x09, 0x36, 0x67, 0xe7, 0x33, 0x80, 0x77, 0xc7, 0xb9,
+	0x1e, 0x27, 0xc9, 0xe3, 0x5e, 0xc4, 0x32, 0x9e, 0x52, 0x1d, 0xcc, 0x9e, 0x4e, 0xaf, 0xdb, 0xe8,
+	0x80, 0xee, 0xf5, 0x27, 0x2d, 0xdf, 0x46, 0xdb, 0x9f, 0x47, 0xdb, 0xdf, 0x9b, 0x47, 0x3b, 0xdc,
+	0xd4, 0x73, 0x4e, 0x15, 0x5a, 0xab, 0x44, 0x74, 0xe7, 0x4c, 0xa1, 0xdb, 0xd6, 0xf7, 0x6f, 0xde,
+	0x3b, 0xfc, 0x8e, 0x00, 0xbe, 0x73, 0x4e, 0xbe, 0x38, 0x37, 0xd4, 0x92, 0xce, 0x07, 0x78, 0x33,
+	0x26, 0x92, 0x1e, 0x90, 0xa2, 0x57, 0xa5, 0x4f, 0xb8, 0x4d, 0xb3, 0xc5, 0xe7, 0x53, 0x85, 0xee,
+	0x5f, 0x52, 0x7e, 0xc8, 0xb2, 0x44, 0xd2, 0x8c, 0x4b, 0x9d, 0xdd, 0x96, 0x75, 0xbd, 0xe4, 0x9a,
+	0x87, 0x2f, 0xd3, 0xde, 0x6a, 0x7c, 0x3a, 0x42, 0xb5, 0x9f, 0x47, 0x08, 0x78, 0x7d, 0xb8, 0xb8,
+	0xc3, 0x58, 0xea, 0xec, 0xc0, 0x72, 0x6f, 0xe6, 0x1f, 0xd9, 0x0c, 0x9f, 0xfd, 0xeb, 0x2a, 0x70,
+	0xa9, 0xb3, 0xd5, 0xd0, 0xfa, 0xbf, 0x8e, 0x10, 0x08, 0x5f, 0x1d, 0x9f, 0xb6, 0xc1, 0xc9, 0x69,
+	0x1b, 0xfc, 0x38, 0x6d, 0x83, 0xc3, 0xb3, 0x76, 0xed, 0xe4, 0xac, 0x5d, 0xfb, 0x7a, 0xd6, 0xae,
+	0xbd, 0xb9, 0x92, 0x7a, 0xf9, 0x4d, 0x32, 0x26, 0xfd, 0xba, 0x59, 0xc7, 0xe6, 0x9f, 0x01, 0x00,
+	0x9b, 0x3f, 0xb3, 0x0e, 0xaa, 0x04, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -174,294 +178,302 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func AppsDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 4590 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0x5d, 0x70, 0x1b, 0xd7,
-		0x75, 0xd6, 0x82, 0x00, 0x08, 0x1c, 0x80, 0xe0, 0x72, 0x49, 0x49, 0x10, 0x1d, 0x0b, 0x12, 0xfc,
-		0x23, 0xda, 0x8a, 0x48, 0x57, 0x7f, 0x96, 0xc1, 0xc6, 0x2e, 0x40, 0x42, 0x0c, 0x65, 0xfe, 0xc0,
-		0x0b, 0x32, 0xb6, 0x95, 0xc9, 0xec, 0x2c, 0x17, 0x97, 0xe0, 0x8a, 0x8b, 0xdd, 0xcd, 0xee, 0x42,
-		0x12, 0x35, 0x7d, 0x70, 0xc7, 0x69, 0x9b, 0x8c, 0xa7, 0x8d, 0xd3, 0x76, 0xda, 0xc4, 0x75, 0x5c,
-		0xc5, 0x9d, 0xd6, 0xad, 0xfb, 0x9b, 0xfe, 0x24, 0x4d, 0xfb, 0x92, 0x4e, 0xa7, 0xad, 0x9f, 0x3a,
-		0xf1, 0x5b, 0xa7, 0x0f, 0x6c, 0xc6, 0xf6, 0x4c, 0x53, 0xd5, 0x6d, 0x53, 0x55, 0x99, 0xe9, 0xd4,
-		0x2f, 0x99, 0xfb, 0xb7, 0xd8, 0x05, 0x40, 0x2d, 0xe8, 0x8c, 0xec, 0x17, 0x09, 0x7b, 0xee, 0xf9,
-		0xbe, 0x7b, 0xee, 0xb9, 0xe7, 0x9e, 0x7b, 0xee, 0xdd, 0x25, 0xfc, 0xdd, 0x2c, 0x1c, 0x6b, 0x5a,
-		0x56, 0xd3, 0x40, 0x33, 0xb6, 0x63, 0x79, 0xd6, 0x46, 0x7b, 0x73, 0xa6, 0x81, 0x5c, 0xcd, 0xd1,
-		0x6d, 0xcf, 0x72, 0xa6, 0x89, 0x4c, 0x1a, 0xa5, 0x1a, 0xd3, 0x5c, 0xa3, 0xb8, 0x0c, 0x63, 0x17,
-		0x75, 0x03, 0xcd, 0xfb, 0x8a, 0x75, 0xe4, 0x49, 0x17, 0x20, 0xbe, 0xa9, 0x1b, 0x28, 0x2f, 0x1c,
-		0x1b, 0x9a, 0xca, 0x9c, 0x7e, 0x70, 0xba, 0x0b, 0x34, 0x1d, 0x46, 0xd4, 0xb0, 0x58, 0x26, 0x88,
-		0xe2, 0x7b, 0x71, 0x18, 0xef, 0xd3, 0x2a, 0x49, 0x10, 0x37, 0xd5, 0x16, 0x66, 0x14, 0xa6, 0xd2,
-		0x32, 0xf9, 0x2d, 0xe5, 0x61, 0xd8, 0x56, 0xb5, 0x6d, 0xb5, 0x89, 0xf2, 0x31, 0x22, 0xe6, 0x8f,
-		0xd2, 0x51, 0x80, 0x06, 0xb2, 0x91, 0xd9, 0x40, 0xa6, 0xb6, 0x93, 0x1f, 0x3a, 0x36, 0x34, 0x95,
-		0x96, 0x03, 0x12, 0xe9, 0x24, 0x8c, 0xd9, 0xed, 0x0d, 0x43, 0xd7, 0x94, 0x80, 0x1a, 0x1c, 0x1b,
-		0x9a, 0x4a, 0xc8, 0x22, 0x6d, 0x98, 0xef, 0x28, 0x9f, 0x80, 0xd1, 0x6b, 0x48, 0xdd, 0x0e, 0xaa,
-		0x66, 0x88, 0x6a, 0x0e, 0x8b, 0x03, 0x8a, 0x73, 0x90, 0x6d, 0x21, 0xd7, 0x55, 0x9b, 0x48, 0xf1,
-		0x76, 0x6c, 0x94, 0x8f, 0x93, 0xd1, 0x1f, 0xeb, 0x19, 0x7d, 0xf7, 0xc8, 0x33, 0x0c, 0xb5, 0xb6,
-		0x63, 0x23, 0xa9, 0x0c, 0x69, 0x64, 0xb6, 0x5b, 0x94, 0x21, 0xb1, 0x87, 0xff, 0xaa, 0x66, 0xbb,
-		0xd5, 0xcd, 0x92, 0xc2, 0x30, 0x46, 0x31, 0xec, 0x22, 0xe7, 0xaa, 0xae, 0xa1, 0x7c, 0x92, 0x10,
-		0x9c, 0xe8, 0x21, 0xa8, 0xd3, 0xf6, 0x6e, 0x0e, 0x8e, 0x93, 0xe6, 0x20, 0x8d, 0xae, 0x7b, 0xc8,
-		0x74, 0x75, 0xcb, 0xcc, 0x0f, 0x13, 0x92, 0x87, 0xfa, 0xcc, 0x22, 0x32, 0x1a, 0xdd, 0x14, 0x1d,
-		0x9c, 0x74, 0x1e, 0x86, 0x2d, 0xdb, 0xd3, 0x2d, 0xd3, 0xcd, 0xa7, 0x8e, 0x09, 0x53, 0x99, 0xd3,
-		0x9f, 0xe8, 0x1b, 0x08, 0xab, 0x54, 0x47, 0xe6, 0xca, 0xd2, 0x22, 0x88, 0xae, 0xd5, 0x76, 0x34,
-		0xa4, 0x68, 0x56, 0x03, 0x29, 0xba, 0xb9, 0x69, 0xe5, 0xd3, 0x84, 0xa0, 0xd0, 0x3b, 0x10, 0xa2,
-		0x38, 0x67, 0x35, 0xd0, 0xa2, 0xb9, 0x69, 0xc9, 0x39, 0x37, 0xf4, 0x2c, 0x1d, 0x82, 0xa4, 0xbb,
-		0x63, 0x7a, 0xea, 0xf5, 0x7c, 0x96, 0x44, 0x08, 0x7b, 0x2a, 0x7e, 0x27, 0x09, 0xa3, 0x83, 0x84,
-		0xd8, 0x2c, 0x24, 0x36, 0xf1, 0x28, 0xf3, 0xb1, 0xfd, 0xf8, 0x80, 0x62, 0xc2, 0x4e, 0x4c, 0x7e,
-		0x48, 0x27, 0x96, 0x21, 0x63, 0x22, 0xd7, 0x43, 0x0d, 0x1a, 0x11, 0x43, 0x03, 0xc6, 0x14, 0x50,
-		0x50, 0x6f, 0x48, 0xc5, 0x3f, 0x54, 0x48, 0x3d, 0x07, 0xa3, 0xbe, 0x49, 0x8a, 0xa3, 0x9a, 0x4d,
-		0x1e, 0x9b, 0x33, 0x51, 0x96, 0x4c, 0x57, 0x39, 0x4e, 0xc6, 0x30, 0x39, 0x87, 0x42, 0xcf, 0xd2,
-		0x3c, 0x80, 0x65, 0x22, 0x6b, 0x53, 0x69, 0x20, 0xcd, 0xc8, 0xa7, 0xf6, 0xf0, 0xd2, 0x2a, 0x56,
-		0xe9, 0xf1, 0x92, 0x45, 0xa5, 0x9a, 0x21, 0x3d, 0xd1, 0x09, 0xb5, 0xe1, 0x3d, 0x22, 0x65, 0x99,
-		0x2e, 0xb2, 0x9e, 0x68, 0x5b, 0x87, 0x9c, 0x83, 0x70, 0xdc, 0xa3, 0x06, 0x1b, 0x59, 0x9a, 0x18,
-		0x31, 0x1d, 0x39, 0x32, 0x99, 0xc1, 0xe8, 0xc0, 0x46, 0x9c, 0xe0, 0xa3, 0xf4, 0x00, 0xf8, 0x02,
-		0x85, 0x84, 0x15, 0x90, 0x2c, 0x94, 0xe5, 0xc2, 0x15, 0xb5, 0x85, 0x26, 0x6f, 0x40, 0x2e, 0xec,
-		0x1e, 0x69, 0x02, 0x12, 0xae, 0xa7, 0x3a, 0x1e, 0x89, 0xc2, 0x84, 0x4c, 0x1f, 0x24, 0x11, 0x86,
-		0x90, 0xd9, 0x20, 0x59, 0x2e, 0x21, 0xe3, 0x9f, 0xd2, 0xcf, 0x74, 0x06, 0x3c, 0x44, 0x06, 0xfc,
-		0x70, 0xef, 0x8c, 0x86, 0x98, 0xbb, 0xc7, 0x3d, 0xf9, 0x38, 0x8c, 0x84, 0x06, 0x30, 0x68, 0xd7,
-		0xc5, 0x9f, 0x85, 0x83, 0x7d, 0xa9, 0xa5, 0xe7, 0x60, 0xa2, 0x6d, 0xea, 0xa6, 0x87, 0x1c, 0xdb,
-		0x41, 0x38, 0x62, 0x69, 0x57, 0xf9, 0x7f, 0x1b, 0xde, 0x23, 0xe6, 0xd6, 0x83, 0xda, 0x94, 0x45,
-		0x1e, 0x6f, 0xf7, 0x0a, 0x1f, 0x4d, 0xa7, 0x7e, 0x30, 0x2c, 0xbe, 0xf0, 0xc2, 0x0b, 0x2f, 0xc4,
-		0x8a, 0x7f, 0x9b, 0x84, 0x89, 0x7e, 0x6b, 0xa6, 0xef, 0xf2, 0x3d, 0x04, 0x49, 0xb3, 0xdd, 0xda,
-		0x40, 0x0e, 0x71, 0x52, 0x42, 0x66, 0x4f, 0x52, 0x19, 0x12, 0x86, 0xba, 0x81, 0x8c, 0x7c, 0xfc,
-		0x98, 0x30, 0x95, 0x3b, 0x7d, 0x72, 0xa0, 0x55, 0x39, 0xbd, 0x84, 0x21, 0x32, 0x45, 0x4a, 0x4f,
-		0x42, 0x9c, 0xa5, 0x68, 0xcc, 0xf0, 0xe8, 0x60, 0x0c, 0x78, 0x2d, 0xc9, 0x04, 0x27, 0xdd, 0x07,
-		0x69, 0xfc, 0x3f, 0x8d, 0x8d, 0x24, 0xb1, 0x39, 0x85, 0x05, 0x38, 0x2e, 0xa4, 0x49, 0x48, 0x91,
-		0x65, 0xd2, 0x40, 0x7c, 0x6b, 0xf3, 0x9f, 0x71, 0x60, 0x35, 0xd0, 0xa6, 0xda, 0x36, 0x3c, 0xe5,
-		0xaa, 0x6a, 0xb4, 0x11, 0x09, 0xf8, 0xb4, 0x9c, 0x65, 0xc2, 0xcf, 0x60, 0x99, 0x54, 0x80, 0x0c,
-		0x5d, 0x55, 0xba, 0xd9, 0x40, 0xd7, 0x49, 0xf6, 0x4c, 0xc8, 0x74, 0xa1, 0x2d, 0x62, 0x09, 0xee,
-		0xfe, 0x8a, 0x6b, 0x99, 0x3c, 0x34, 0x49, 0x17, 0x58, 0x40, 0xba, 0x7f, 0xbc, 0x3b, 0x71, 0xdf,
-		0xdf, 0x7f, 0x78, 0x3d, 0x6b, 0xe9, 0x04, 0x8c, 0x12, 0x8d, 0x33, 0x6c, 0xea, 0x55, 0x23, 0x3f,
-		0x76, 0x4c, 0x98, 0x4a, 0xc9, 0x39, 0x2a, 0x5e, 0x65, 0xd2, 0xe2, 0xb7, 0x62, 0x10, 0x27, 0x89,
-		0x65, 0x14, 0x32, 0x6b, 0xcf, 0xd7, 0xaa, 0xca, 0xfc, 0xea, 0x7a, 0x65, 0xa9, 0x2a, 0x0a, 0x52,
-		0x0e, 0x80, 0x08, 0x2e, 0x2e, 0xad, 0x96, 0xd7, 0xc4, 0x98, 0xff, 0xbc, 0xb8, 0xb2, 0x76, 0xfe,
-		0xac, 0x38, 0xe4, 0x03, 0xd6, 0xa9, 0x20, 0x1e, 0x54, 0x38, 0x73, 0x5a, 0x4c, 0x48, 0x22, 0x64,
-		0x29, 0xc1, 0xe2, 0x73, 0xd5, 0xf9, 0xf3, 0x67, 0xc5, 0x64, 0x58, 0x72, 0xe6, 0xb4, 0x38, 0x2c,
-		0x8d, 0x40, 0x9a, 0x48, 0x2a, 0xab, 0xab, 0x4b, 0x62, 0xca, 0xe7, 0xac, 0xaf, 0xc9, 0x8b, 0x2b,
-		0x0b, 0x62, 0xda, 0xe7, 0x5c, 0x90, 0x57, 0xd7, 0x6b, 0x22, 0xf8, 0x0c, 0xcb, 0xd5, 0x7a, 0xbd,
-		0xbc, 0x50, 0x15, 0x33, 0xbe, 0x46, 0xe5, 0xf9, 0xb5, 0x6a, 0x5d, 0xcc, 0x86, 0xcc, 0x3a, 0x73,
-		0x5a, 0x1c, 0xf1, 0xbb, 0xa8, 0xae, 0xac, 0x2f, 0x8b, 0x39, 0x69, 0x0c, 0x46, 0x68, 0x17, 0xdc,
-		0x88, 0xd1, 0x2e, 0xd1, 0xf9, 0xb3, 0xa2, 0xd8, 0x31, 0x84, 0xb2, 0x8c, 0x85, 0x04, 0xe7, 0xcf,
-		0x8a, 0x52, 0x71, 0x0e, 0x12, 0x24, 0x0c, 0x25, 0x09, 0x72, 0x4b, 0xe5, 0x4a, 0x75, 0x49, 0x59,
-		0xad, 0xad, 0x2d, 0xae, 0xae, 0x94, 0x97, 0x44, 0xa1, 0x23, 0x93, 0xab, 0xcf, 0xac, 0x2f, 0xca,
-		0xd5, 0x79, 0x31, 0x16, 0x94, 0xd5, 0xaa, 0xe5, 0xb5, 0xea, 0xbc, 0x38, 0x54, 0xd4, 0x60, 0xa2,
-		0x5f, 0x42, 0xed, 0xbb, 0x84, 0x02, 0xb1, 0x10, 0xdb, 0x23, 0x16, 0x08, 0x57, 0x77, 0x2c, 0x14,
-		0xdf, 0x8d, 0xc1, 0x78, 0x9f, 0x4d, 0xa5, 0x6f, 0x27, 0x4f, 0x41, 0x82, 0xc6, 0x32, 0xdd, 0x66,
-		0x1f, 0xe9, 0xbb, 0x3b, 0x91, 0xc8, 0xee, 0xd9, 0x6a, 0x09, 0x2e, 0x58, 0x6a, 0x0c, 0xed, 0x51,
-		0x6a, 0x60, 0x8a, 0x9e, 0x80, 0xfd, 0x5c, 0x4f, 0xf2, 0xa7, 0xfb, 0xe3, 0xf9, 0x41, 0xf6, 0x47,
-		0x22, 0xdb, 0xdf, 0x26, 0x90, 0xe8, 0xb3, 0x09, 0xcc, 0xc2, 0x58, 0x0f, 0xd1, 0xc0, 0xc9, 0xf8,
-		0x45, 0x01, 0xf2, 0x7b, 0x39, 0x27, 0x22, 0x25, 0xc6, 0x42, 0x29, 0x71, 0xb6, 0xdb, 0x83, 0xc7,
-		0xf7, 0x9e, 0x84, 0x9e, 0xb9, 0x7e, 0x43, 0x80, 0x43, 0xfd, 0x4b, 0xca, 0xbe, 0x36, 0x3c, 0x09,
-		0xc9, 0x16, 0xf2, 0xb6, 0x2c, 0x5e, 0x56, 0x3d, 0xdc, 0x67, 0xb3, 0xc6, 0xcd, 0xdd, 0x93, 0xcd,
-		0x50, 0xc1, 0xdd, 0x7e, 0x68, 0xaf, 0xba, 0x90, 0x5a, 0xd3, 0x63, 0xe9, 0x97, 0x62, 0x70, 0xb0,
-		0x2f, 0x79, 0x5f, 0x43, 0xef, 0x07, 0xd0, 0x4d, 0xbb, 0xed, 0xd1, 0xd2, 0x89, 0x66, 0xe2, 0x34,
-		0x91, 0x90, 0xe4, 0x85, 0xb3, 0x6c, 0xdb, 0xf3, 0xdb, 0x87, 0x48, 0x3b, 0x50, 0x11, 0x51, 0xb8,
-		0xd0, 0x31, 0x34, 0x4e, 0x0c, 0x3d, 0xba, 0xc7, 0x48, 0x7b, 0x02, 0xf3, 0x31, 0x10, 0x35, 0x43,
-		0x47, 0xa6, 0xa7, 0xb8, 0x9e, 0x83, 0xd4, 0x96, 0x6e, 0x36, 0xc9, 0x56, 0x93, 0x2a, 0x25, 0x36,
-		0x55, 0xc3, 0x45, 0xf2, 0x28, 0x6d, 0xae, 0xf3, 0x56, 0x8c, 0x20, 0x01, 0xe4, 0x04, 0x10, 0xc9,
-		0x10, 0x82, 0x36, 0xfb, 0x88, 0xe2, 0x57, 0xd2, 0x90, 0x09, 0x14, 0xe0, 0xd2, 0x71, 0xc8, 0x5e,
-		0x51, 0xaf, 0xaa, 0x0a, 0x3f, 0x54, 0x51, 0x4f, 0x64, 0xb0, 0xac, 0xc6, 0x0e, 0x56, 0x8f, 0xc1,
-		0x04, 0x51, 0xb1, 0xda, 0x1e, 0x72, 0x14, 0xcd, 0x50, 0x5d, 0x97, 0x38, 0x2d, 0x45, 0x54, 0x25,
-		0xdc, 0xb6, 0x8a, 0x9b, 0xe6, 0x78, 0x8b, 0x74, 0x0e, 0xc6, 0x09, 0xa2, 0xd5, 0x36, 0x3c, 0xdd,
-		0x36, 0x90, 0x82, 0x8f, 0x79, 0x2e, 0xd9, 0x72, 0x7c, 0xcb, 0xc6, 0xb0, 0xc6, 0x32, 0x53, 0xc0,
-		0x16, 0xb9, 0xd2, 0x3c, 0xdc, 0x4f, 0x60, 0x4d, 0x64, 0x22, 0x47, 0xf5, 0x90, 0x82, 0x3e, 0xdf,
-		0x56, 0x0d, 0x57, 0x51, 0xcd, 0x86, 0xb2, 0xa5, 0xba, 0x5b, 0xf9, 0x09, 0x4c, 0x50, 0x89, 0xe5,
-		0x05, 0xf9, 0x08, 0x56, 0x5c, 0x60, 0x7a, 0x55, 0xa2, 0x56, 0x36, 0x1b, 0x9f, 0x56, 0xdd, 0x2d,
-		0xa9, 0x04, 0x87, 0x08, 0x8b, 0xeb, 0x39, 0xba, 0xd9, 0x54, 0xb4, 0x2d, 0xa4, 0x6d, 0x2b, 0x6d,
-		0x6f, 0xf3, 0x42, 0xfe, 0xbe, 0x60, 0xff, 0xc4, 0xc2, 0x3a, 0xd1, 0x99, 0xc3, 0x2a, 0xeb, 0xde,
-		0xe6, 0x05, 0xa9, 0x0e, 0x59, 0x3c, 0x19, 0x2d, 0xfd, 0x06, 0x52, 0x36, 0x2d, 0x87, 0xec, 0xa1,
-		0xb9, 0x3e, 0xa9, 0x29, 0xe0, 0xc1, 0xe9, 0x55, 0x06, 0x58, 0xb6, 0x1a, 0xa8, 0x94, 0xa8, 0xd7,
-		0xaa, 0xd5, 0x79, 0x39, 0xc3, 0x59, 0x2e, 0x5a, 0x0e, 0x0e, 0xa8, 0xa6, 0xe5, 0x3b, 0x38, 0x43,
-		0x03, 0xaa, 0x69, 0x71, 0xf7, 0x9e, 0x83, 0x71, 0x4d, 0xa3, 0x63, 0xd6, 0x35, 0x85, 0x1d, 0xc6,
-		0xdc, 0xbc, 0x18, 0x72, 0x96, 0xa6, 0x2d, 0x50, 0x05, 0x16, 0xe3, 0xae, 0xf4, 0x04, 0x1c, 0xec,
-		0x38, 0x2b, 0x08, 0x1c, 0xeb, 0x19, 0x65, 0x37, 0xf4, 0x1c, 0x8c, 0xdb, 0x3b, 0xbd, 0x40, 0x29,
-		0xd4, 0xa3, 0xbd, 0xd3, 0x0d, 0x7b, 0x1c, 0x26, 0xec, 0x2d, 0xbb, 0x17, 0xf7, 0x68, 0x10, 0x27,
-		0xd9, 0x5b, 0x76, 0x37, 0xf0, 0x21, 0x72, 0x32, 0x77, 0x90, 0xa6, 0x7a, 0xa8, 0x91, 0x3f, 0x1c,
-		0x54, 0x0f, 0x34, 0x48, 0xd3, 0x20, 0x6a, 0x9a, 0x82, 0x4c, 0x75, 0xc3, 0x40, 0x8a, 0xea, 0x20,
-		0x53, 0x75, 0xf3, 0x05, 0xa2, 0x1c, 0xf7, 0x9c, 0x36, 0x92, 0x73, 0x9a, 0x56, 0x25, 0x8d, 0x65,
-		0xd2, 0x26, 0x3d, 0x0a, 0x63, 0xd6, 0xc6, 0x15, 0x8d, 0x46, 0xa4, 0x62, 0x3b, 0x68, 0x53, 0xbf,
-		0x9e, 0x7f, 0x90, 0xb8, 0x77, 0x14, 0x37, 0x90, 0x78, 0xac, 0x11, 0xb1, 0xf4, 0x08, 0x88, 0x9a,
-		0xbb, 0xa5, 0x3a, 0x36, 0x49, 0xc9, 0xae, 0xad, 0x6a, 0x28, 0xff, 0x10, 0x55, 0xa5, 0xf2, 0x15,
-		0x2e, 0xc6, 0x2b, 0xc2, 0xbd, 0xa6, 0x6f, 0x7a, 0x9c, 0xf1, 0x04, 0x5d, 0x11, 0x44, 0xc6, 0xd8,
-		0xa6, 0x40, 0xc4, 0x9e, 0x08, 0x75, 0x3c, 0x45, 0xd4, 0x72, 0xf6, 0x96, 0x1d, 0xec, 0xf7, 0x01,
-		0x18, 0xc1, 0x9a, 0x9d, 0x4e, 0x1f, 0xa1, 0x85, 0x9b, 0xbd, 0x15, 0xe8, 0xf1, 0x2c, 0x1c, 0xc2,
-		0x4a, 0x2d, 0xe4, 0xa9, 0x0d, 0xd5, 0x53, 0x03, 0xda, 0x9f, 0x24, 0xda, 0xd8, 0xed, 0xcb, 0xac,
-		0x31, 0x64, 0xa7, 0xd3, 0xde, 0xd8, 0xf1, 0x03, 0xeb, 0x14, 0xb5, 0x13, 0xcb, 0x78, 0x68, 0xdd,
-		0xb3, 0xe2, 0xbc, 0x58, 0x82, 0x6c, 0x30, 0xee, 0xa5, 0x34, 0xd0, 0xc8, 0x17, 0x05, 0x5c, 0x04,
-		0xcd, 0xad, 0xce, 0xe3, 0xf2, 0xe5, 0x72, 0x55, 0x8c, 0xe1, 0x32, 0x6a, 0x69, 0x71, 0xad, 0xaa,
-		0xc8, 0xeb, 0x2b, 0x6b, 0x8b, 0xcb, 0x55, 0x71, 0x28, 0x50, 0xd8, 0x5f, 0x8a, 0xa7, 0x1e, 0x16,
-		0x4f, 0x14, 0xdf, 0x8e, 0x41, 0x2e, 0x7c, 0x52, 0x93, 0x7e, 0x1a, 0x0e, 0xf3, 0x6b, 0x15, 0x17,
-		0x79, 0xca, 0x35, 0xdd, 0x21, 0x0b, 0xb2, 0xa5, 0xd2, 0xcd, 0xd1, 0x8f, 0x9f, 0x09, 0xa6, 0x55,
-		0x47, 0xde, 0xb3, 0xba, 0x83, 0x97, 0x5b, 0x4b, 0xf5, 0xa4, 0x25, 0x28, 0x98, 0x96, 0xe2, 0x7a,
-		0xaa, 0xd9, 0x50, 0x9d, 0x86, 0xd2, 0xb9, 0xd0, 0x52, 0x54, 0x4d, 0x43, 0xae, 0x6b, 0xd1, 0x8d,
-		0xd0, 0x67, 0xf9, 0x84, 0x69, 0xd5, 0x99, 0x72, 0x67, 0x87, 0x28, 0x33, 0xd5, 0xae, 0xf0, 0x1d,
-		0xda, 0x2b, 0x7c, 0xef, 0x83, 0x74, 0x4b, 0xb5, 0x15, 0x64, 0x7a, 0xce, 0x0e, 0xa9, 0xcf, 0x53,
-		0x72, 0xaa, 0xa5, 0xda, 0x55, 0xfc, 0xfc, 0x91, 0x1c, 0x93, 0x2e, 0xc5, 0x53, 0x29, 0x31, 0x7d,
-		0x29, 0x9e, 0x4a, 0x8b, 0x50, 0x7c, 0x67, 0x08, 0xb2, 0xc1, 0x7a, 0x1d, 0x1f, 0x7f, 0x34, 0xb2,
-		0x63, 0x09, 0x24, 0xa7, 0x3d, 0x70, 0xd7, 0xea, 0x7e, 0x7a, 0x0e, 0x6f, 0x65, 0xa5, 0x24, 0x2d,
-		0x8e, 0x65, 0x8a, 0xc4, 0x65, 0x04, 0x0e, 0x36, 0x44, 0x8b, 0x91, 0x94, 0xcc, 0x9e, 0xa4, 0x05,
-		0x48, 0x5e, 0x71, 0x09, 0x77, 0x92, 0x70, 0x3f, 0x78, 0x77, 0xee, 0x4b, 0x75, 0x42, 0x9e, 0xbe,
-		0x54, 0x57, 0x56, 0x56, 0xe5, 0xe5, 0xf2, 0x92, 0xcc, 0xe0, 0xd2, 0x11, 0x88, 0x1b, 0xea, 0x8d,
-		0x9d, 0xf0, 0xa6, 0x47, 0x44, 0x83, 0x4e, 0xc2, 0x11, 0x88, 0x5f, 0x43, 0xea, 0x76, 0x78, 0xab,
-		0x21, 0xa2, 0x7b, 0xb8, 0x18, 0x66, 0x20, 0x41, 0xfc, 0x25, 0x01, 0x30, 0x8f, 0x89, 0x07, 0xa4,
-		0x14, 0xc4, 0xe7, 0x56, 0x65, 0xbc, 0x20, 0x44, 0xc8, 0x52, 0xa9, 0x52, 0x5b, 0xac, 0xce, 0x55,
-		0xc5, 0x58, 0xf1, 0x1c, 0x24, 0xa9, 0x13, 0xf0, 0x62, 0xf1, 0xdd, 0x20, 0x1e, 0x60, 0x8f, 0x8c,
-		0x43, 0xe0, 0xad, 0xeb, 0xcb, 0x95, 0xaa, 0x2c, 0xc6, 0xc2, 0x53, 0x1d, 0x17, 0x13, 0x45, 0x17,
-		0xb2, 0xc1, 0x3a, 0xfc, 0xa3, 0x39, 0x8c, 0x7f, 0x57, 0x80, 0x4c, 0xa0, 0xae, 0xc6, 0x05, 0x91,
-		0x6a, 0x18, 0xd6, 0x35, 0x45, 0x35, 0x74, 0xd5, 0x65, 0xa1, 0x01, 0x44, 0x54, 0xc6, 0x92, 0x41,
-		0xa7, 0xee, 0x23, 0x5a, 0x22, 0x09, 0x31, 0x59, 0x7c, 0x4d, 0x00, 0xb1, 0xbb, 0xb0, 0xed, 0x32,
-		0x53, 0xf8, 0x38, 0xcd, 0x2c, 0xbe, 0x2a, 0x40, 0x2e, 0x5c, 0xcd, 0x76, 0x99, 0x77, 0xfc, 0x63,
-		0x35, 0xef, 0xfb, 0x31, 0x18, 0x09, 0xd5, 0xb0, 0x83, 0x5a, 0xf7, 0x79, 0x18, 0xd3, 0x1b, 0xa8,
-		0x65, 0x5b, 0x1e, 0x32, 0xb5, 0x1d, 0xc5, 0x40, 0x57, 0x91, 0x91, 0x2f, 0x92, 0xa4, 0x31, 0x73,
-		0xf7, 0x2a, 0x79, 0x7a, 0xb1, 0x83, 0x5b, 0xc2, 0xb0, 0xd2, 0xf8, 0xe2, 0x7c, 0x75, 0xb9, 0xb6,
-		0xba, 0x56, 0x5d, 0x99, 0x7b, 0x5e, 0x59, 0x5f, 0x79, 0x7a, 0x65, 0xf5, 0xd9, 0x15, 0x59, 0xd4,
-		0xbb, 0xd4, 0xee, 0xe1, 0xb2, 0xaf, 0x81, 0xd8, 0x6d, 0x94, 0x74, 0x18, 0xfa, 0x99, 0x25, 0x1e,
-		0x90, 0xc6, 0x61, 0x74, 0x65, 0x55, 0xa9, 0x2f, 0xce, 0x57, 0x95, 0xea, 0xc5, 0x8b, 0xd5, 0xb9,
-		0xb5, 0x3a, 0xbd, 0xf7, 0xf0, 0xb5, 0xd7, 0x42, 0x0b, 0xbc, 0xf8, 0xca, 0x10, 0x8c, 0xf7, 0xb1,
-		0x44, 0x2a, 0xb3, 0x13, 0x0b, 0x3d, 0x44, 0x9d, 0x1a, 0xc4, 0xfa, 0x69, 0x5c, 0x33, 0xd4, 0x54,
-		0xc7, 0x63, 0x07, 0x9c, 0x47, 0x00, 0x7b, 0xc9, 0xf4, 0xf4, 0x4d, 0x1d, 0x39, 0xec, 0x3e, 0x89,
-		0x1e, 0x63, 0x46, 0x3b, 0x72, 0x7a, 0xa5, 0xf4, 0x49, 0x90, 0x6c, 0xcb, 0xd5, 0x3d, 0xfd, 0x2a,
-		0x52, 0x74, 0x93, 0x5f, 0x3e, 0xe1, 0x63, 0x4d, 0x5c, 0x16, 0x79, 0xcb, 0xa2, 0xe9, 0xf9, 0xda,
-		0x26, 0x6a, 0xaa, 0x5d, 0xda, 0x38, 0x99, 0x0f, 0xc9, 0x22, 0x6f, 0xf1, 0xb5, 0x8f, 0x43, 0xb6,
-		0x61, 0xb5, 0x71, 0xad, 0x47, 0xf5, 0xf0, 0xde, 0x21, 0xc8, 0x19, 0x2a, 0xf3, 0x55, 0x58, 0x15,
-		0xdf, 0xb9, 0xf5, 0xca, 0xca, 0x19, 0x2a, 0xa3, 0x2a, 0x27, 0x60, 0x54, 0x6d, 0x36, 0x1d, 0x4c,
-		0xce, 0x89, 0xe8, 0xb9, 0x24, 0xe7, 0x8b, 0x89, 0xe2, 0xe4, 0x25, 0x48, 0x71, 0x3f, 0xe0, 0xad,
-		0x1a, 0x7b, 0x42, 0xb1, 0xe9, 0x61, 0x3b, 0x36, 0x95, 0x96, 0x53, 0x26, 0x6f, 0x3c, 0x0e, 0x59,
-		0xdd, 0x55, 0x3a, 0x97, 0xf8, 0xb1, 0x63, 0xb1, 0xa9, 0x94, 0x9c, 0xd1, 0x5d, 0xff, 0x02, 0xb4,
-		0xf8, 0x46, 0x0c, 0x72, 0xe1, 0x97, 0x10, 0xd2, 0x3c, 0xa4, 0x0c, 0x4b, 0x53, 0x49, 0x68, 0xd1,
-		0x37, 0x60, 0x53, 0x11, 0xef, 0x2d, 0xa6, 0x97, 0x98, 0xbe, 0xec, 0x23, 0x27, 0xff, 0x49, 0x80,
-		0x14, 0x17, 0x4b, 0x87, 0x20, 0x6e, 0xab, 0xde, 0x16, 0xa1, 0x4b, 0x54, 0x62, 0xa2, 0x20, 0x93,
-		0x67, 0x2c, 0x77, 0x6d, 0xd5, 0x24, 0x21, 0xc0, 0xe4, 0xf8, 0x19, 0xcf, 0xab, 0x81, 0xd4, 0x06,
-		0x39, 0xf4, 0x58, 0xad, 0x16, 0x32, 0x3d, 0x97, 0xcf, 0x2b, 0x93, 0xcf, 0x31, 0xb1, 0x74, 0x12,
-		0xc6, 0x3c, 0x47, 0xd5, 0x8d, 0x90, 0x6e, 0x9c, 0xe8, 0x8a, 0xbc, 0xc1, 0x57, 0x2e, 0xc1, 0x11,
-		0xce, 0xdb, 0x40, 0x9e, 0xaa, 0x6d, 0xa1, 0x46, 0x07, 0x94, 0x24, 0x97, 0x1b, 0x87, 0x99, 0xc2,
-		0x3c, 0x6b, 0xe7, 0xd8, 0xe2, 0xdb, 0x02, 0x8c, 0xf1, 0x63, 0x5a, 0xc3, 0x77, 0xd6, 0x32, 0x80,
-		0x6a, 0x9a, 0x96, 0x17, 0x74, 0x57, 0x6f, 0x28, 0xf7, 0xe0, 0xa6, 0xcb, 0x3e, 0x48, 0x0e, 0x10,
-		0x4c, 0xb6, 0x00, 0x3a, 0x2d, 0x7b, 0xba, 0xad, 0x00, 0x19, 0xf6, 0x86, 0x89, 0xbc, 0xa6, 0xa4,
-		0x07, 0x7b, 0xa0, 0x22, 0x7c, 0x9e, 0x93, 0x26, 0x20, 0xb1, 0x81, 0x9a, 0xba, 0xc9, 0xee, 0x8d,
-		0xe9, 0x03, 0xbf, 0x7e, 0x89, 0xfb, 0xd7, 0x2f, 0x95, 0x2f, 0x0b, 0x30, 0xae, 0x59, 0xad, 0x6e,
-		0x7b, 0x2b, 0x62, 0xd7, 0xed, 0x82, 0xfb, 0x69, 0xe1, 0xf2, 0x93, 0x4d, 0xdd, 0xdb, 0x6a, 0x6f,
-		0x4c, 0x6b, 0x56, 0x6b, 0xa6, 0x69, 0x19, 0xaa, 0xd9, 0xec, 0xbc, 0x67, 0x25, 0x3f, 0xb4, 0x53,
-		0x4d, 0x64, 0x9e, 0x6a, 0x5a, 0x81, 0xb7, 0xae, 0xb3, 0x9d, 0x9f, 0xff, 0x27, 0x08, 0xaf, 0xc7,
-		0x86, 0x16, 0x6a, 0x95, 0x37, 0x63, 0x93, 0x0b, 0xb4, 0xbb, 0x1a, 0x77, 0x8f, 0x8c, 0x36, 0x0d,
-		0xa4, 0xe1, 0x21, 0xc3, 0xad, 0x93, 0x30, 0xd1, 0xb4, 0x9a, 0x16, 0x61, 0x9c, 0xc1, 0xbf, 0xd8,
-		0x9b, 0xdb, 0xb4, 0x2f, 0x9d, 0x8c, 0x7c, 0xcd, 0x5b, 0x5a, 0x81, 0x71, 0xa6, 0xac, 0x90, 0x57,
-		0x47, 0xf4, 0x60, 0x23, 0xdd, 0xf5, 0x56, 0x2d, 0xff, 0xcd, 0xf7, 0xc8, 0x86, 0x2e, 0x8f, 0x31,
-		0x28, 0x6e, 0xa3, 0x67, 0x9f, 0x92, 0x0c, 0x07, 0x43, 0x7c, 0x74, 0xd9, 0x22, 0x27, 0x82, 0xf1,
-		0xef, 0x19, 0xe3, 0x78, 0x80, 0xb1, 0xce, 0xa0, 0xa5, 0x39, 0x18, 0xd9, 0x0f, 0xd7, 0x3f, 0x30,
-		0xae, 0x2c, 0x0a, 0x92, 0x2c, 0xc0, 0x28, 0x21, 0xd1, 0xda, 0xae, 0x67, 0xb5, 0x48, 0x4e, 0xbc,
-		0x3b, 0xcd, 0x3f, 0xbe, 0x47, 0xd7, 0x51, 0x0e, 0xc3, 0xe6, 0x7c, 0x54, 0xa9, 0x04, 0xe4, 0x6d,
-		0x59, 0x03, 0x69, 0x46, 0x04, 0xc3, 0x5b, 0xcc, 0x10, 0x5f, 0xbf, 0xf4, 0x19, 0x98, 0xc0, 0xbf,
-		0x49, 0xca, 0x0a, 0x5a, 0x12, 0x7d, 0x05, 0x97, 0x7f, 0xfb, 0x45, 0xba, 0x54, 0xc7, 0x7d, 0x82,
-		0x80, 0x4d, 0x81, 0x59, 0x6c, 0x22, 0xcf, 0x43, 0x8e, 0xab, 0xa8, 0x46, 0x3f, 0xf3, 0x02, 0x77,
-		0x18, 0xf9, 0xaf, 0xbd, 0x1f, 0x9e, 0xc5, 0x05, 0x8a, 0x2c, 0x1b, 0x46, 0x69, 0x1d, 0x0e, 0xf7,
-		0x89, 0x8a, 0x01, 0x38, 0x5f, 0x61, 0x9c, 0x13, 0x3d, 0x91, 0x81, 0x69, 0x6b, 0xc0, 0xe5, 0xfe,
-		0x5c, 0x0e, 0xc0, 0xf9, 0x9b, 0x8c, 0x53, 0x62, 0x58, 0x3e, 0xa5, 0x98, 0xf1, 0x12, 0x8c, 0x5d,
-		0x45, 0xce, 0x86, 0xe5, 0xb2, 0x7b, 0xa3, 0x01, 0xe8, 0x5e, 0x65, 0x74, 0xa3, 0x0c, 0x48, 0x2e,
-		0x92, 0x30, 0xd7, 0x13, 0x90, 0xda, 0x54, 0x35, 0x34, 0x00, 0xc5, 0xd7, 0x19, 0xc5, 0x30, 0xd6,
-		0xc7, 0xd0, 0x32, 0x64, 0x9b, 0x16, 0xdb, 0xb5, 0xa2, 0xe1, 0xaf, 0x31, 0x78, 0x86, 0x63, 0x18,
-		0x85, 0x6d, 0xd9, 0x6d, 0x03, 0x6f, 0x69, 0xd1, 0x14, 0xbf, 0xc5, 0x29, 0x38, 0x86, 0x51, 0xec,
-		0xc3, 0xad, 0x37, 0x39, 0x85, 0x1b, 0xf0, 0xe7, 0x53, 0x90, 0xb1, 0x4c, 0x63, 0xc7, 0x32, 0x07,
-		0x31, 0xe2, 0x1b, 0x8c, 0x01, 0x18, 0x04, 0x13, 0xcc, 0x42, 0x7a, 0xd0, 0x89, 0xf8, 0x9d, 0xf7,
-		0xf9, 0xf2, 0xe0, 0x33, 0xb0, 0x00, 0xa3, 0x3c, 0x41, 0xe9, 0x96, 0x39, 0x00, 0xc5, 0xef, 0x32,
-		0x8a, 0x5c, 0x00, 0xc6, 0x86, 0xe1, 0x21, 0xd7, 0x6b, 0xa2, 0x41, 0x48, 0xde, 0xe0, 0xc3, 0x60,
-		0x10, 0xe6, 0xca, 0x0d, 0x64, 0x6a, 0x5b, 0x83, 0x31, 0xfc, 0x1e, 0x77, 0x25, 0xc7, 0x60, 0x8a,
-		0x39, 0x18, 0x69, 0xa9, 0x8e, 0xbb, 0xa5, 0x1a, 0x03, 0x4d, 0xc7, 0xef, 0x33, 0x8e, 0xac, 0x0f,
-		0x62, 0x1e, 0x69, 0x9b, 0xfb, 0xa1, 0x79, 0x93, 0x7b, 0x24, 0x00, 0x63, 0x4b, 0xcf, 0xf5, 0xc8,
-		0x25, 0xdb, 0x7e, 0xd8, 0xfe, 0x80, 0x2f, 0x3d, 0x8a, 0x5d, 0x0e, 0x32, 0xce, 0x42, 0xda, 0xd5,
-		0x6f, 0x0c, 0x44, 0xf3, 0x87, 0x7c, 0xa6, 0x09, 0x00, 0x83, 0x9f, 0x87, 0x23, 0x7d, 0xb7, 0x89,
-		0x01, 0xc8, 0xfe, 0x88, 0x91, 0x1d, 0xea, 0xb3, 0x55, 0xb0, 0x94, 0xb0, 0x5f, 0xca, 0x3f, 0xe6,
-		0x29, 0x01, 0x75, 0x71, 0xd5, 0xf0, 0x39, 0xc2, 0x55, 0x37, 0xf7, 0xe7, 0xb5, 0x3f, 0xe1, 0x5e,
-		0xa3, 0xd8, 0x90, 0xd7, 0xd6, 0xe0, 0x10, 0x63, 0xdc, 0xdf, 0xbc, 0xfe, 0x29, 0x4f, 0xac, 0x14,
-		0xbd, 0x1e, 0x9e, 0xdd, 0xcf, 0xc2, 0xa4, 0xef, 0x4e, 0x5e, 0xb0, 0xba, 0x4a, 0x4b, 0xb5, 0x07,
-		0x60, 0xfe, 0x26, 0x63, 0xe6, 0x19, 0xdf, 0xaf, 0x78, 0xdd, 0x65, 0xd5, 0xc6, 0xe4, 0xcf, 0x41,
-		0x9e, 0x93, 0xb7, 0x4d, 0x07, 0x69, 0x56, 0xd3, 0xd4, 0x6f, 0xa0, 0xc6, 0x00, 0xd4, 0x7f, 0xd6,
-		0x35, 0x55, 0xeb, 0x01, 0x38, 0x66, 0x5e, 0x04, 0xd1, 0xaf, 0x55, 0x14, 0xbd, 0x65, 0x5b, 0x8e,
-		0x17, 0xc1, 0xf8, 0xe7, 0x7c, 0xa6, 0x7c, 0xdc, 0x22, 0x81, 0x95, 0xaa, 0x40, 0xdf, 0x3c, 0x0f,
-		0x1a, 0x92, 0x7f, 0xc1, 0x88, 0x46, 0x3a, 0x28, 0x96, 0x38, 0x34, 0xab, 0x65, 0xab, 0xce, 0x20,
-		0xf9, 0xef, 0x2f, 0x79, 0xe2, 0x60, 0x10, 0x96, 0x38, 0xbc, 0x1d, 0x1b, 0xe1, 0xdd, 0x7e, 0x00,
-		0x86, 0x6f, 0xf1, 0xc4, 0xc1, 0x31, 0x8c, 0x82, 0x17, 0x0c, 0x03, 0x50, 0x7c, 0x9b, 0x53, 0x70,
-		0x0c, 0xa6, 0x78, 0xa6, 0xb3, 0xd1, 0x3a, 0xa8, 0xa9, 0xbb, 0x9e, 0x43, 0xcb, 0xe4, 0xbb, 0x53,
-		0xfd, 0xd5, 0xfb, 0xe1, 0x22, 0x4c, 0x0e, 0x40, 0x71, 0x26, 0x62, 0xd7, 0xae, 0xe4, 0x14, 0x15,
-		0x6d, 0xd8, 0x77, 0x78, 0x26, 0x0a, 0xc0, 0xb0, 0x6d, 0x81, 0x0a, 0x11, 0xbb, 0x5d, 0xc3, 0x67,
-		0x87, 0x01, 0xe8, 0xfe, 0xba, 0xcb, 0xb8, 0x3a, 0xc7, 0x62, 0xce, 0x40, 0xfd, 0xd3, 0x36, 0xb7,
-		0xd1, 0xce, 0x40, 0xd1, 0xf9, 0x37, 0x5d, 0xf5, 0xcf, 0x3a, 0x45, 0xd2, 0x1c, 0x32, 0xda, 0x55,
-		0x4f, 0x49, 0x51, 0xdf, 0x19, 0xe5, 0x7f, 0xee, 0x0e, 0x1b, 0x6f, 0xb8, 0x9c, 0x2a, 0x2d, 0xe1,
-		0x20, 0x0f, 0x17, 0x3d, 0xd1, 0x64, 0x2f, 0xde, 0xf1, 0xe3, 0x3c, 0x54, 0xf3, 0x94, 0x2e, 0xc2,
-		0x48, 0xa8, 0xe0, 0x89, 0xa6, 0xfa, 0x02, 0xa3, 0xca, 0x06, 0xeb, 0x9d, 0xd2, 0x39, 0x88, 0xe3,
-		0xe2, 0x25, 0x1a, 0xfe, 0xf3, 0x0c, 0x4e, 0xd4, 0x4b, 0x9f, 0x82, 0x14, 0x2f, 0x5a, 0xa2, 0xa1,
-		0xbf, 0xc0, 0xa0, 0x3e, 0x04, 0xc3, 0x79, 0xc1, 0x12, 0x0d, 0xff, 0x45, 0x0e, 0xe7, 0x10, 0x0c,
-		0x1f, 0xdc, 0x85, 0xdf, 0x7d, 0x29, 0xce, 0x36, 0x1d, 0xee, 0xbb, 0x59, 0x18, 0x66, 0x95, 0x4a,
-		0x34, 0xfa, 0x4b, 0xac, 0x73, 0x8e, 0x28, 0x3d, 0x0e, 0x89, 0x01, 0x1d, 0xfe, 0x4b, 0x0c, 0x4a,
-		0xf5, 0x4b, 0x73, 0x90, 0x09, 0x54, 0x27, 0xd1, 0xf0, 0x5f, 0x66, 0xf0, 0x20, 0x0a, 0x9b, 0xce,
-		0xaa, 0x93, 0x68, 0x82, 0x2f, 0x73, 0xd3, 0x19, 0x02, 0xbb, 0x8d, 0x17, 0x26, 0xd1, 0xe8, 0x97,
-		0xb9, 0xd7, 0x39, 0xa4, 0xf4, 0x14, 0xa4, 0xfd, 0xcd, 0x26, 0x1a, 0xff, 0x15, 0x86, 0xef, 0x60,
-		0xb0, 0x07, 0x02, 0x9b, 0x5d, 0x34, 0xc5, 0xaf, 0x70, 0x0f, 0x04, 0x50, 0x78, 0x19, 0x75, 0x17,
-		0x30, 0xd1, 0x4c, 0xbf, 0xca, 0x97, 0x51, 0x57, 0xfd, 0x82, 0x67, 0x93, 0xe4, 0xfc, 0x68, 0x8a,
-		0x5f, 0xe3, 0xb3, 0x49, 0xf4, 0xb1, 0x19, 0xdd, 0x15, 0x41, 0x34, 0xc7, 0x6f, 0x70, 0x33, 0xba,
-		0x0a, 0x82, 0x52, 0x0d, 0xa4, 0xde, 0x6a, 0x20, 0x9a, 0xef, 0xab, 0x8c, 0x6f, 0xac, 0xa7, 0x18,
-		0x28, 0x3d, 0x0b, 0x87, 0xfa, 0x57, 0x02, 0xd1, 0xac, 0x5f, 0xbb, 0xd3, 0x75, 0x76, 0x0b, 0x16,
-		0x02, 0xa5, 0xb5, 0xce, 0x96, 0x12, 0xac, 0x02, 0xa2, 0x69, 0x5f, 0xb9, 0x13, 0x4e, 0xdc, 0xc1,
-		0x22, 0xa0, 0x54, 0x06, 0xe8, 0x6c, 0xc0, 0xd1, 0x5c, 0xaf, 0x32, 0xae, 0x00, 0x08, 0x2f, 0x0d,
-		0xb6, 0xff, 0x46, 0xe3, 0xbf, 0xce, 0x97, 0x06, 0x43, 0xe0, 0xa5, 0xc1, 0xb7, 0xde, 0x68, 0xf4,
-		0x6b, 0x7c, 0x69, 0x70, 0x08, 0x8e, 0xec, 0xc0, 0xee, 0x16, 0xcd, 0xf0, 0x0d, 0x1e, 0xd9, 0x01,
-		0x54, 0x69, 0x05, 0xc6, 0x7a, 0x36, 0xc4, 0x68, 0xaa, 0xd7, 0x19, 0x95, 0xd8, 0xbd, 0x1f, 0x06,
-		0x37, 0x2f, 0xb6, 0x19, 0x46, 0xb3, 0xfd, 0x76, 0xd7, 0xe6, 0xc5, 0xf6, 0xc2, 0xd2, 0x2c, 0xa4,
-		0xcc, 0xb6, 0x61, 0xe0, 0xc5, 0x23, 0xdd, 0xfd, 0xdb, 0xc0, 0xfc, 0xbf, 0x7f, 0xc0, 0xbc, 0xc3,
-		0x01, 0xa5, 0x73, 0x90, 0x40, 0xad, 0x0d, 0xd4, 0x88, 0x42, 0xde, 0xfa, 0x80, 0x27, 0x4c, 0xac,
-		0x5d, 0x7a, 0x0a, 0x80, 0x5e, 0x8d, 0x90, 0xd7, 0x83, 0x11, 0xd8, 0xff, 0xf8, 0x80, 0x7d, 0x8c,
-		0xd3, 0x81, 0x74, 0x08, 0xe8, 0xa7, 0x3d, 0x77, 0x27, 0x78, 0x3f, 0x4c, 0x40, 0x66, 0xe4, 0x09,
-		0x18, 0xbe, 0xe2, 0x5a, 0xa6, 0xa7, 0x36, 0xa3, 0xd0, 0xff, 0xc9, 0xd0, 0x5c, 0x1f, 0x3b, 0xac,
-		0x65, 0x39, 0xc8, 0x53, 0x9b, 0x6e, 0x14, 0xf6, 0xbf, 0x18, 0xd6, 0x07, 0x60, 0xb0, 0xa6, 0xba,
-		0xde, 0x20, 0xe3, 0xfe, 0x6f, 0x0e, 0xe6, 0x00, 0x6c, 0x34, 0xfe, 0xbd, 0x8d, 0x76, 0xa2, 0xb0,
-		0x3f, 0xe4, 0x46, 0x33, 0xfd, 0xd2, 0xa7, 0x20, 0x8d, 0x7f, 0xd2, 0x2f, 0xec, 0x22, 0xc0, 0xff,
-		0xc3, 0xc0, 0x1d, 0x04, 0xee, 0xd9, 0xf5, 0x1a, 0x9e, 0x1e, 0xed, 0xec, 0xdb, 0x6c, 0xa6, 0xb9,
-		0x7e, 0xa9, 0x0c, 0x19, 0xd7, 0x6b, 0x34, 0xda, 0xac, 0x3e, 0x8d, 0x80, 0xff, 0xef, 0x07, 0xfe,
-		0x95, 0x85, 0x8f, 0xc1, 0xb3, 0x7d, 0x6d, 0xdb, 0xb3, 0x2d, 0xf2, 0x0a, 0x24, 0x8a, 0xe1, 0x0e,
-		0x63, 0x08, 0x40, 0x4a, 0x73, 0x90, 0xc5, 0x63, 0x71, 0x90, 0x8d, 0xc8, 0xfb, 0xaa, 0x08, 0x8a,
-		0x1f, 0x31, 0x07, 0x84, 0x40, 0x95, 0xcf, 0xbd, 0xf5, 0xce, 0x51, 0xe1, 0x7b, 0xef, 0x1c, 0x15,
-		0xbe, 0xff, 0xce, 0x51, 0xe1, 0xe5, 0x77, 0x8f, 0x1e, 0xf8, 0xde, 0xbb, 0x47, 0x0f, 0xfc, 0xf3,
-		0xbb, 0x47, 0x0f, 0xf4, 0xbf, 0x36, 0x86, 0x05, 0x6b, 0xc1, 0xa2, 0x17, 0xc6, 0x97, 0x8b, 0xa1,
-		0xeb, 0xe2, 0xa6, 0xd5, 0xb9, 0xad, 0xf5, 0x0f, 0x39, 0xf0, 0x72, 0x0c, 0x0a, 0xdd, 0x77, 0xb9,
-		0xd8, 0x81, 0xae, 0xa7, 0xb6, 0xec, 0xbd, 0xfe, 0x62, 0x67, 0x16, 0xd2, 0x6b, 0x5c, 0x47, 0xca,
-		0xc3, 0xb0, 0x8b, 0x34, 0xcb, 0x6c, 0xb8, 0xe4, 0x35, 0xe7, 0x90, 0xcc, 0x1f, 0xa5, 0x09, 0x48,
-		0x98, 0xaa, 0x69, 0xb9, 0xec, 0x3b, 0x41, 0xfa, 0x50, 0xf9, 0x75, 0x61, 0x7f, 0x23, 0xca, 0xf9,
-		0x5d, 0x91, 0x61, 0xd5, 0x84, 0xcb, 0x27, 0xef, 0x76, 0x0d, 0x8e, 0x43, 0xd6, 0xed, 0x0c, 0x21,
-		0x70, 0xe7, 0x7d, 0xb4, 0xfb, 0xce, 0xfb, 0x59, 0x64, 0x18, 0x4f, 0x9b, 0xd6, 0x35, 0x73, 0x0d,
-		0x63, 0x36, 0x92, 0xf4, 0x0b, 0x63, 0xf8, 0x76, 0x0a, 0xc6, 0xae, 0xcf, 0xa8, 0xb6, 0xed, 0x92,
-		0x7f, 0x98, 0x13, 0x92, 0xd7, 0xa7, 0xf1, 0xd3, 0x64, 0xdf, 0xab, 0xf1, 0xc9, 0x28, 0x1f, 0x16,
-		0xdf, 0x4c, 0x82, 0x48, 0x3a, 0x2e, 0xdb, 0xb6, 0xa1, 0xb3, 0x77, 0x32, 0x06, 0x0c, 0xab, 0x8d,
-		0x86, 0x83, 0x5c, 0xea, 0xba, 0x6c, 0x45, 0xbe, 0xb5, 0x5b, 0xe0, 0xa2, 0xdb, 0xbb, 0x85, 0xdc,
-		0x8e, 0xda, 0x32, 0x4a, 0x45, 0x26, 0x28, 0xfe, 0xff, 0x6e, 0xe1, 0xa7, 0x02, 0x43, 0xb7, 0xad,
-		0x6d, 0xef, 0x94, 0x89, 0xbc, 0x6b, 0x96, 0xb3, 0x3d, 0x63, 0x5b, 0xda, 0x36, 0xf2, 0x4e, 0x69,
-		0x96, 0x83, 0x66, 0x88, 0x0b, 0xa6, 0xcb, 0x14, 0x25, 0x73, 0x3e, 0xa9, 0x02, 0xc0, 0xfe, 0x64,
-		0x69, 0x1b, 0xed, 0x90, 0x39, 0xc9, 0x56, 0x1e, 0xb8, 0xb5, 0x5b, 0x08, 0x48, 0x6f, 0xef, 0x16,
-		0xc6, 0x68, 0x9f, 0x1d, 0x59, 0x51, 0x4e, 0xd3, 0x87, 0xa7, 0xd1, 0x8e, 0x74, 0x06, 0x92, 0x57,
-		0x54, 0xdd, 0xe0, 0x6f, 0xde, 0x2b, 0xf7, 0xdd, 0xda, 0x2d, 0x30, 0xc9, 0xed, 0xdd, 0xc2, 0x08,
-		0xc5, 0xd2, 0xe7, 0xa2, 0xcc, 0x1a, 0x24, 0x03, 0x92, 0xae, 0xa7, 0x7a, 0x6d, 0xfa, 0x52, 0x28,
-		0x51, 0x59, 0xc3, 0x20, 0x2a, 0xe9, 0x80, 0xe8, 0x33, 0x1e, 0xe3, 0xb9, 0xc1, 0xc7, 0x58, 0xf7,
-		0xd4, 0x6d, 0x54, 0x27, 0x48, 0x99, 0x31, 0x62, 0x13, 0xb5, 0x2d, 0x55, 0x37, 0x5d, 0xfa, 0xa9,
-		0x2c, 0x35, 0x91, 0x4a, 0x3a, 0xbd, 0xd1, 0xe7, 0xa2, 0xcc, 0x1a, 0xa4, 0xeb, 0x30, 0xe2, 0x62,
-		0xae, 0x86, 0xe2, 0x59, 0xdb, 0xc8, 0x74, 0xe9, 0xf7, 0xf4, 0x95, 0xfa, 0x5b, 0xbb, 0x85, 0x03,
-		0xff, 0xb2, 0x5b, 0x78, 0x6c, 0x70, 0x93, 0x2a, 0x7a, 0x73, 0xd1, 0xf4, 0x70, 0x9f, 0x94, 0xa9,
-		0xd3, 0x27, 0x7d, 0x2e, 0xca, 0x59, 0xda, 0xd3, 0x1a, 0x79, 0x94, 0x6e, 0x00, 0xb4, 0xd4, 0xeb,
-		0x8a, 0x83, 0x0c, 0x75, 0x87, 0xfe, 0xe9, 0x49, 0xba, 0xf2, 0xd9, 0x9f, 0xa0, 0xdb, 0x00, 0x5b,
-		0x67, 0x36, 0x3b, 0xb2, 0x22, 0xae, 0x9f, 0xaf, 0xcb, 0xe4, 0xb7, 0xf4, 0x92, 0x00, 0x47, 0xda,
-		0x26, 0x36, 0x87, 0xbd, 0xba, 0xb3, 0x0d, 0x44, 0x2e, 0x48, 0x71, 0xf4, 0xb2, 0x0f, 0xf7, 0x27,
-		0x7b, 0x92, 0x96, 0xbf, 0x1e, 0x2b, 0x67, 0xb0, 0x9d, 0xb7, 0x76, 0x0b, 0xb9, 0x0e, 0x09, 0x46,
-		0xde, 0xde, 0x2d, 0x1c, 0xa4, 0xfd, 0x86, 0xe5, 0xc5, 0x97, 0xff, 0xb5, 0x20, 0xc8, 0x87, 0x7d,
-		0xe1, 0x9c, 0xdf, 0x21, 0xa6, 0x2c, 0xa5, 0xbe, 0x78, 0xb3, 0x70, 0xe0, 0x07, 0x37, 0x0b, 0x42,
-		0x71, 0x03, 0xe2, 0x35, 0xcb, 0x32, 0xa4, 0x1a, 0x30, 0x27, 0xd2, 0x0f, 0x49, 0x2b, 0x17, 0x3e,
-		0xac, 0x5f, 0x64, 0xc6, 0x53, 0x4a, 0x61, 0xfe, 0x1f, 0xde, 0x2c, 0x08, 0x95, 0x4b, 0x7b, 0x65,
-		0xa1, 0xcb, 0x03, 0xb1, 0xb3, 0x04, 0xe1, 0x85, 0x33, 0x47, 0x02, 0x44, 0xd6, 0xd0, 0x72, 0x9b,
-		0x83, 0x24, 0x8e, 0xe2, 0x8f, 0x04, 0x18, 0x59, 0x76, 0x9b, 0x35, 0x7a, 0x16, 0x57, 0xb7, 0x91,
-		0x74, 0x16, 0x86, 0xed, 0xf6, 0x06, 0x59, 0xa3, 0x34, 0x29, 0x90, 0x00, 0xb6, 0xdb, 0x1b, 0x74,
-		0x7d, 0x8e, 0xf8, 0xeb, 0x93, 0xac, 0x4d, 0xdc, 0xc0, 0x16, 0x26, 0x8b, 0xfa, 0xd8, 0xe0, 0x51,
-		0xdf, 0xe4, 0x1f, 0xcd, 0x93, 0x8d, 0xa7, 0xf2, 0xcc, 0x4f, 0x10, 0x76, 0x94, 0xe8, 0xf6, 0x6e,
-		0x21, 0x4b, 0xbb, 0x22, 0x8f, 0x45, 0xf6, 0x71, 0x7d, 0x49, 0xc4, 0x53, 0xfb, 0xd5, 0x9b, 0x05,
-		0x01, 0xbb, 0xff, 0x8b, 0xaf, 0x17, 0x84, 0xe2, 0x9b, 0x02, 0x8c, 0x2e, 0xbb, 0xcd, 0x0a, 0x6a,
-		0xea, 0xe6, 0x3a, 0x09, 0x08, 0x24, 0x7d, 0x41, 0x80, 0xe1, 0x72, 0x28, 0x1f, 0x5e, 0xb9, 0xb5,
-		0x5b, 0x18, 0x57, 0x3b, 0x19, 0x53, 0xe9, 0xe4, 0xc6, 0x49, 0x96, 0x1b, 0x7b, 0x1b, 0x3f, 0x6c,
-		0x9e, 0x64, 0x3f, 0xfa, 0x18, 0xfb, 0x92, 0x00, 0xe9, 0x65, 0xb7, 0xb9, 0x6e, 0xe2, 0x84, 0x86,
-		0xb3, 0x76, 0xd9, 0xb6, 0xb1, 0xf6, 0xbd, 0xcc, 0xda, 0xac, 0x8b, 0x5e, 0x6b, 0xee, 0x45, 0xe4,
-		0xfe, 0x38, 0x00, 0x00, 0xff, 0xff, 0x18, 0x05, 0x79, 0x57, 0xba, 0x3b, 0x00, 0x00,
+		// 4706 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7b, 0x5d, 0x70, 0x1b, 0xd7,
+		0x75, 0xbf, 0x16, 0x04, 0x48, 0xe0, 0x00, 0x04, 0x97, 0x4b, 0x5a, 0x82, 0xe8, 0x98, 0x90, 0x61,
+		0x3b, 0x96, 0xbf, 0x28, 0xff, 0x25, 0x4b, 0xb6, 0x57, 0xff, 0xc4, 0x05, 0x48, 0x88, 0xa1, 0xcd,
+		0x0f, 0x78, 0x41, 0xc6, 0x5f, 0x93, 0xd9, 0x59, 0x2e, 0x2e, 0xc1, 0x15, 0x17, 0xbb, 0xeb, 0xdd,
+		0x85, 0x24, 0x68, 0xfa, 0xe0, 0x8e, 0xd3, 0x34, 0x19, 0xa7, 0xad, 0xfb, 0x31, 0x53, 0xc7, 0xb5,
+		0x5d, 0xc7, 0x9d, 0xd6, 0xae, 0xfb, 0x99, 0x7e, 0xa4, 0x4d, 0xfa, 0xd2, 0x17, 0xb7, 0x7e, 0xea,
+		0x24, 0x6f, 0x99, 0x4e, 0x87, 0x8d, 0x25, 0xcf, 0xd4, 0x55, 0xdd, 0xd6, 0x55, 0x9d, 0x99, 0x4c,
+		0xfc, 0xd2, 0xb9, 0x5f, 0x8b, 0x5d, 0x00, 0xd4, 0x82, 0x76, 0xe4, 0xf4, 0x45, 0xc2, 0x3d, 0xf7,
+		0xfc, 0x7e, 0xf7, 0xdc, 0x73, 0xcf, 0x3d, 0xf7, 0xec, 0xdd, 0x25, 0xbc, 0x2d, 0xc3, 0x91, 0xa6,
+		0x6d, 0x37, 0x4d, 0x74, 0xcc, 0x71, 0x6d, 0xdf, 0xde, 0x6c, 0x6f, 0x1d, 0x6b, 0x20, 0x4f, 0x77,
+		0x0d, 0xc7, 0xb7, 0xdd, 0x39, 0x22, 0x93, 0x26, 0xa8, 0xc6, 0x1c, 0xd7, 0x28, 0xad, 0xc0, 0xe4,
+		0x19, 0xc3, 0x44, 0x0b, 0x81, 0x62, 0x1d, 0xf9, 0xd2, 0x03, 0x90, 0xdc, 0x32, 0x4c, 0x54, 0x10,
+		0x8e, 0x8c, 0x1c, 0xcd, 0x1e, 0xbf, 0x75, 0xae, 0x07, 0x34, 0x17, 0x45, 0xd4, 0xb0, 0x58, 0x21,
+		0x88, 0xd2, 0xbb, 0x49, 0x98, 0x1a, 0xd0, 0x2b, 0x49, 0x90, 0xb4, 0xb4, 0x16, 0x66, 0x14, 0x8e,
+		0x66, 0x14, 0xf2, 0x5b, 0x2a, 0xc0, 0x98, 0xa3, 0xe9, 0x3b, 0x5a, 0x13, 0x15, 0x12, 0x44, 0xcc,
+		0x9b, 0xd2, 0x2c, 0x40, 0x03, 0x39, 0xc8, 0x6a, 0x20, 0x4b, 0xef, 0x14, 0x46, 0x8e, 0x8c, 0x1c,
+		0xcd, 0x28, 0x21, 0x89, 0x74, 0x17, 0x4c, 0x3a, 0xed, 0x4d, 0xd3, 0xd0, 0xd5, 0x90, 0x1a, 0x1c,
+		0x19, 0x39, 0x9a, 0x52, 0x44, 0xda, 0xb1, 0xd0, 0x55, 0xbe, 0x1d, 0x26, 0xce, 0x23, 0x6d, 0x27,
+		0xac, 0x9a, 0x25, 0xaa, 0x79, 0x2c, 0x0e, 0x29, 0xce, 0x43, 0xae, 0x85, 0x3c, 0x4f, 0x6b, 0x22,
+		0xd5, 0xef, 0x38, 0xa8, 0x90, 0x24, 0xb3, 0x3f, 0xd2, 0x37, 0xfb, 0xde, 0x99, 0x67, 0x19, 0x6a,
+		0xbd, 0xe3, 0x20, 0xa9, 0x0c, 0x19, 0x64, 0xb5, 0x5b, 0x94, 0x21, 0xb5, 0x87, 0xff, 0xaa, 0x56,
+		0xbb, 0xd5, 0xcb, 0x92, 0xc6, 0x30, 0x46, 0x31, 0xe6, 0x21, 0xf7, 0x9c, 0xa1, 0xa3, 0xc2, 0x28,
+		0x21, 0xb8, 0xbd, 0x8f, 0xa0, 0x4e, 0xfb, 0x7b, 0x39, 0x38, 0x4e, 0x9a, 0x87, 0x0c, 0xba, 0xe0,
+		0x23, 0xcb, 0x33, 0x6c, 0xab, 0x30, 0x46, 0x48, 0x6e, 0x1b, 0xb0, 0x8a, 0xc8, 0x6c, 0xf4, 0x52,
+		0x74, 0x71, 0xd2, 0x29, 0x18, 0xb3, 0x1d, 0xdf, 0xb0, 0x2d, 0xaf, 0x90, 0x3e, 0x22, 0x1c, 0xcd,
+		0x1e, 0xff, 0xcc, 0xc0, 0x40, 0x58, 0xa3, 0x3a, 0x0a, 0x57, 0x96, 0x96, 0x40, 0xf4, 0xec, 0xb6,
+		0xab, 0x23, 0x55, 0xb7, 0x1b, 0x48, 0x35, 0xac, 0x2d, 0xbb, 0x90, 0x21, 0x04, 0xc5, 0xfe, 0x89,
+		0x10, 0xc5, 0x79, 0xbb, 0x81, 0x96, 0xac, 0x2d, 0x5b, 0xc9, 0x7b, 0x91, 0xb6, 0x74, 0x10, 0x46,
+		0xbd, 0x8e, 0xe5, 0x6b, 0x17, 0x0a, 0x39, 0x12, 0x21, 0xac, 0x55, 0xfa, 0xce, 0x28, 0x4c, 0x0c,
+		0x13, 0x62, 0xa7, 0x21, 0xb5, 0x85, 0x67, 0x59, 0x48, 0xec, 0xc7, 0x07, 0x14, 0x13, 0x75, 0xe2,
+		0xe8, 0xc7, 0x74, 0x62, 0x19, 0xb2, 0x16, 0xf2, 0x7c, 0xd4, 0xa0, 0x11, 0x31, 0x32, 0x64, 0x4c,
+		0x01, 0x05, 0xf5, 0x87, 0x54, 0xf2, 0x63, 0x85, 0xd4, 0xe3, 0x30, 0x11, 0x98, 0xa4, 0xba, 0x9a,
+		0xd5, 0xe4, 0xb1, 0x79, 0x2c, 0xce, 0x92, 0xb9, 0x2a, 0xc7, 0x29, 0x18, 0xa6, 0xe4, 0x51, 0xa4,
+		0x2d, 0x2d, 0x00, 0xd8, 0x16, 0xb2, 0xb7, 0xd4, 0x06, 0xd2, 0xcd, 0x42, 0x7a, 0x0f, 0x2f, 0xad,
+		0x61, 0x95, 0x3e, 0x2f, 0xd9, 0x54, 0xaa, 0x9b, 0xd2, 0x83, 0xdd, 0x50, 0x1b, 0xdb, 0x23, 0x52,
+		0x56, 0xe8, 0x26, 0xeb, 0x8b, 0xb6, 0x0d, 0xc8, 0xbb, 0x08, 0xc7, 0x3d, 0x6a, 0xb0, 0x99, 0x65,
+		0x88, 0x11, 0x73, 0xb1, 0x33, 0x53, 0x18, 0x8c, 0x4e, 0x6c, 0xdc, 0x0d, 0x37, 0xa5, 0x5b, 0x20,
+		0x10, 0xa8, 0x24, 0xac, 0x80, 0x64, 0xa1, 0x1c, 0x17, 0xae, 0x6a, 0x2d, 0x34, 0x73, 0x11, 0xf2,
+		0x51, 0xf7, 0x48, 0xd3, 0x90, 0xf2, 0x7c, 0xcd, 0xf5, 0x49, 0x14, 0xa6, 0x14, 0xda, 0x90, 0x44,
+		0x18, 0x41, 0x56, 0x83, 0x64, 0xb9, 0x94, 0x82, 0x7f, 0x4a, 0x3f, 0xd7, 0x9d, 0xf0, 0x08, 0x99,
+		0xf0, 0x67, 0xfb, 0x57, 0x34, 0xc2, 0xdc, 0x3b, 0xef, 0x99, 0xfb, 0x61, 0x3c, 0x32, 0x81, 0x61,
+		0x87, 0x2e, 0xfd, 0x3c, 0xdc, 0x30, 0x90, 0x5a, 0x7a, 0x1c, 0xa6, 0xdb, 0x96, 0x61, 0xf9, 0xc8,
+		0x75, 0x5c, 0x84, 0x23, 0x96, 0x0e, 0x55, 0xf8, 0xd7, 0xb1, 0x3d, 0x62, 0x6e, 0x23, 0xac, 0x4d,
+		0x59, 0x94, 0xa9, 0x76, 0xbf, 0xf0, 0xce, 0x4c, 0xfa, 0xbd, 0x31, 0xf1, 0x99, 0x67, 0x9e, 0x79,
+		0x26, 0x51, 0x7a, 0x61, 0x14, 0xa6, 0x07, 0xed, 0x99, 0x81, 0xdb, 0xf7, 0x20, 0x8c, 0x5a, 0xed,
+		0xd6, 0x26, 0x72, 0x89, 0x93, 0x52, 0x0a, 0x6b, 0x49, 0x65, 0x48, 0x99, 0xda, 0x26, 0x32, 0x0b,
+		0xc9, 0x23, 0xc2, 0xd1, 0xfc, 0xf1, 0xbb, 0x86, 0xda, 0x95, 0x73, 0xcb, 0x18, 0xa2, 0x50, 0xa4,
+		0xf4, 0x79, 0x48, 0xb2, 0x14, 0x8d, 0x19, 0xee, 0x1c, 0x8e, 0x01, 0xef, 0x25, 0x85, 0xe0, 0xa4,
+		0x1b, 0x21, 0x83, 0xff, 0xa7, 0xb1, 0x31, 0x4a, 0x6c, 0x4e, 0x63, 0x01, 0x8e, 0x0b, 0x69, 0x06,
+		0xd2, 0x64, 0x9b, 0x34, 0x10, 0x3f, 0xda, 0x82, 0x36, 0x0e, 0xac, 0x06, 0xda, 0xd2, 0xda, 0xa6,
+		0xaf, 0x9e, 0xd3, 0xcc, 0x36, 0x22, 0x01, 0x9f, 0x51, 0x72, 0x4c, 0xf8, 0x45, 0x2c, 0x93, 0x8a,
+		0x90, 0xa5, 0xbb, 0xca, 0xb0, 0x1a, 0xe8, 0x02, 0xc9, 0x9e, 0x29, 0x85, 0x6e, 0xb4, 0x25, 0x2c,
+		0xc1, 0xc3, 0x9f, 0xf5, 0x6c, 0x8b, 0x87, 0x26, 0x19, 0x02, 0x0b, 0xc8, 0xf0, 0xf7, 0xf7, 0x26,
+		0xee, 0x9b, 0x06, 0x4f, 0xaf, 0x37, 0xa6, 0x4a, 0xdf, 0x4e, 0x40, 0x92, 0xe4, 0x8b, 0x09, 0xc8,
+		0xae, 0x3f, 0x51, 0xab, 0xaa, 0x0b, 0x6b, 0x1b, 0x95, 0xe5, 0xaa, 0x28, 0x48, 0x79, 0x00, 0x22,
+		0x38, 0xb3, 0xbc, 0x56, 0x5e, 0x17, 0x13, 0x41, 0x7b, 0x69, 0x75, 0xfd, 0xd4, 0x7d, 0xe2, 0x48,
+		0x00, 0xd8, 0xa0, 0x82, 0x64, 0x58, 0xe1, 0xc4, 0x71, 0x31, 0x25, 0x89, 0x90, 0xa3, 0x04, 0x4b,
+		0x8f, 0x57, 0x17, 0x4e, 0xdd, 0x27, 0x8e, 0x46, 0x25, 0x27, 0x8e, 0x8b, 0x63, 0xd2, 0x38, 0x64,
+		0x88, 0xa4, 0xb2, 0xb6, 0xb6, 0x2c, 0xa6, 0x03, 0xce, 0xfa, 0xba, 0xb2, 0xb4, 0xba, 0x28, 0x66,
+		0x02, 0xce, 0x45, 0x65, 0x6d, 0xa3, 0x26, 0x42, 0xc0, 0xb0, 0x52, 0xad, 0xd7, 0xcb, 0x8b, 0x55,
+		0x31, 0x1b, 0x68, 0x54, 0x9e, 0x58, 0xaf, 0xd6, 0xc5, 0x5c, 0xc4, 0xac, 0x13, 0xc7, 0xc5, 0xf1,
+		0x60, 0x88, 0xea, 0xea, 0xc6, 0x8a, 0x98, 0x97, 0x26, 0x61, 0x9c, 0x0e, 0xc1, 0x8d, 0x98, 0xe8,
+		0x11, 0x9d, 0xba, 0x4f, 0x14, 0xbb, 0x86, 0x50, 0x96, 0xc9, 0x88, 0xe0, 0xd4, 0x7d, 0xa2, 0x54,
+		0x9a, 0x87, 0x14, 0x89, 0x2e, 0x49, 0x82, 0xfc, 0x72, 0xb9, 0x52, 0x5d, 0x56, 0xd7, 0x6a, 0xeb,
+		0x4b, 0x6b, 0xab, 0xe5, 0x65, 0x51, 0xe8, 0xca, 0x94, 0xea, 0xa3, 0x1b, 0x4b, 0x4a, 0x75, 0x41,
+		0x4c, 0x84, 0x65, 0xb5, 0x6a, 0x79, 0xbd, 0xba, 0x20, 0x8e, 0x94, 0x74, 0x98, 0x1e, 0x94, 0x27,
+		0x07, 0xee, 0x8c, 0xd0, 0x12, 0x27, 0xf6, 0x58, 0x62, 0xc2, 0xd5, 0xb7, 0xc4, 0x97, 0x13, 0x30,
+		0x35, 0xe0, 0xac, 0x18, 0x38, 0xc8, 0x43, 0x90, 0xa2, 0x21, 0x4a, 0x4f, 0xcf, 0x3b, 0x06, 0x1e,
+		0x3a, 0x24, 0x60, 0xfb, 0x4e, 0x50, 0x82, 0x0b, 0x57, 0x10, 0x23, 0x7b, 0x54, 0x10, 0x98, 0xa2,
+		0x2f, 0xa7, 0x7f, 0xa9, 0x2f, 0xa7, 0xd3, 0x63, 0xef, 0xd4, 0x30, 0xc7, 0x1e, 0x91, 0xed, 0x2f,
+		0xb7, 0xa7, 0x06, 0xe4, 0xf6, 0xd3, 0x30, 0xd9, 0x47, 0x34, 0x74, 0x8e, 0x7d, 0x56, 0x80, 0xc2,
+		0x5e, 0xce, 0x89, 0xc9, 0x74, 0x89, 0x48, 0xa6, 0x3b, 0xdd, 0xeb, 0xc1, 0x9b, 0xf7, 0x5e, 0x84,
+		0xbe, 0xb5, 0x7e, 0x5d, 0x80, 0x83, 0x83, 0x2b, 0xc5, 0x81, 0x36, 0x7c, 0x1e, 0x46, 0x5b, 0xc8,
+		0xdf, 0xb6, 0x79, 0xb5, 0xf4, 0xd9, 0x01, 0x67, 0x30, 0xee, 0xee, 0x5d, 0x6c, 0x86, 0x92, 0x1e,
+		0xec, 0xb5, 0xb5, 0xb8, 0x57, 0xdd, 0xda, 0x67, 0xe9, 0xd7, 0x12, 0x70, 0xc3, 0x40, 0xf2, 0x81,
+		0x86, 0xde, 0x04, 0x60, 0x58, 0x4e, 0xdb, 0xa7, 0x15, 0x11, 0x4d, 0xb0, 0x19, 0x22, 0x21, 0xc9,
+		0x0b, 0x27, 0xcf, 0xb6, 0x1f, 0xf4, 0x8f, 0x90, 0x7e, 0xa0, 0x22, 0xa2, 0xf0, 0x40, 0xd7, 0xd0,
+		0x24, 0x31, 0x74, 0x76, 0x8f, 0x99, 0xf6, 0x05, 0xe6, 0xbd, 0x20, 0xea, 0xa6, 0x81, 0x2c, 0x5f,
+		0xf5, 0x7c, 0x17, 0x69, 0x2d, 0xc3, 0x6a, 0x92, 0x13, 0x24, 0x2d, 0xa7, 0xb6, 0x34, 0xd3, 0x43,
+		0xca, 0x04, 0xed, 0xae, 0xf3, 0x5e, 0x8c, 0x20, 0x01, 0xe4, 0x86, 0x10, 0xa3, 0x11, 0x04, 0xed,
+		0x0e, 0x10, 0xa5, 0xaf, 0x67, 0x20, 0x1b, 0xaa, 0xab, 0xa5, 0x9b, 0x21, 0x77, 0x56, 0x3b, 0xa7,
+		0xa9, 0xfc, 0x59, 0x89, 0x7a, 0x22, 0x8b, 0x65, 0x35, 0x2a, 0x92, 0xee, 0x85, 0x69, 0xa2, 0x62,
+		0xb7, 0x7d, 0xe4, 0xaa, 0xba, 0xa9, 0x79, 0x1e, 0x71, 0x5a, 0x9a, 0xa8, 0x4a, 0xb8, 0x6f, 0x0d,
+		0x77, 0xcd, 0xf3, 0x1e, 0xe9, 0x24, 0x4c, 0x11, 0x44, 0xab, 0x6d, 0xfa, 0x86, 0x63, 0x22, 0x15,
+		0x3f, 0xbd, 0x79, 0x05, 0x08, 0x5b, 0x36, 0x89, 0x35, 0x56, 0x98, 0x02, 0xb6, 0xc8, 0x93, 0x16,
+		0xe0, 0x26, 0x02, 0x6b, 0x22, 0x0b, 0xb9, 0x9a, 0x8f, 0x54, 0xf4, 0x74, 0x5b, 0x33, 0x3d, 0x55,
+		0xb3, 0x1a, 0xea, 0xb6, 0xe6, 0x6d, 0x17, 0xa6, 0x31, 0x41, 0x25, 0x51, 0x10, 0x94, 0xc3, 0x58,
+		0x71, 0x91, 0xe9, 0x55, 0x89, 0x5a, 0xd9, 0x6a, 0x7c, 0x41, 0xf3, 0xb6, 0x25, 0x19, 0x0e, 0x12,
+		0x16, 0xcf, 0x77, 0x0d, 0xab, 0xa9, 0xea, 0xdb, 0x48, 0xdf, 0x51, 0xdb, 0xfe, 0xd6, 0x03, 0x85,
+		0x1b, 0xc3, 0xe3, 0x13, 0x0b, 0xeb, 0x44, 0x67, 0x1e, 0xab, 0x6c, 0xf8, 0x5b, 0x0f, 0x48, 0x75,
+		0xc8, 0xe1, 0xc5, 0x68, 0x19, 0x17, 0x91, 0xba, 0x65, 0xbb, 0xe4, 0x68, 0xcc, 0x0f, 0x48, 0x4d,
+		0x21, 0x0f, 0xce, 0xad, 0x31, 0xc0, 0x8a, 0xdd, 0x40, 0x72, 0xaa, 0x5e, 0xab, 0x56, 0x17, 0x94,
+		0x2c, 0x67, 0x39, 0x63, 0xbb, 0x38, 0xa0, 0x9a, 0x76, 0xe0, 0xe0, 0x2c, 0x0d, 0xa8, 0xa6, 0xcd,
+		0xdd, 0x7b, 0x12, 0xa6, 0x74, 0x9d, 0xce, 0xd9, 0xd0, 0x55, 0xf6, 0x8c, 0xe5, 0x15, 0xc4, 0x88,
+		0xb3, 0x74, 0x7d, 0x91, 0x2a, 0xb0, 0x18, 0xf7, 0xa4, 0x07, 0xe1, 0x86, 0xae, 0xb3, 0xc2, 0xc0,
+		0xc9, 0xbe, 0x59, 0xf6, 0x42, 0x4f, 0xc2, 0x94, 0xd3, 0xe9, 0x07, 0x4a, 0x91, 0x11, 0x9d, 0x4e,
+		0x2f, 0xec, 0x7e, 0x98, 0x76, 0xb6, 0x9d, 0x7e, 0xdc, 0x9d, 0x61, 0x9c, 0xe4, 0x6c, 0x3b, 0xbd,
+		0xc0, 0xdb, 0xc8, 0x03, 0xb7, 0x8b, 0x74, 0xcd, 0x47, 0x8d, 0xc2, 0xa1, 0xb0, 0x7a, 0xa8, 0x43,
+		0x3a, 0x06, 0xa2, 0xae, 0xab, 0xc8, 0xd2, 0x36, 0x4d, 0xa4, 0x6a, 0x2e, 0xb2, 0x34, 0xaf, 0x50,
+		0x0c, 0x2b, 0xe7, 0x75, 0xbd, 0x4a, 0x7a, 0xcb, 0xa4, 0x53, 0xba, 0x13, 0x26, 0xed, 0xcd, 0xb3,
+		0x3a, 0x0d, 0x49, 0xd5, 0x71, 0xd1, 0x96, 0x71, 0xa1, 0x70, 0x2b, 0xf1, 0xef, 0x04, 0xee, 0x20,
+		0x01, 0x59, 0x23, 0x62, 0xe9, 0x0e, 0x10, 0x75, 0x6f, 0x5b, 0x73, 0x1d, 0x92, 0x93, 0x3d, 0x47,
+		0xd3, 0x51, 0xe1, 0x36, 0xaa, 0x4a, 0xe5, 0xab, 0x5c, 0x8c, 0xb7, 0x84, 0x77, 0xde, 0xd8, 0xf2,
+		0x39, 0xe3, 0xed, 0x74, 0x4b, 0x10, 0x19, 0x63, 0x3b, 0x0a, 0x22, 0x76, 0x45, 0x64, 0xe0, 0xa3,
+		0x44, 0x2d, 0xef, 0x6c, 0x3b, 0xe1, 0x71, 0x6f, 0x81, 0x71, 0x67, 0x3b, 0x3c, 0xe8, 0x1d, 0xb4,
+		0x20, 0x73, 0xb6, 0x43, 0x23, 0xde, 0x07, 0x07, 0xb1, 0x52, 0x0b, 0xf9, 0x5a, 0x43, 0xf3, 0xb5,
+		0x90, 0xf6, 0xdd, 0x44, 0x1b, 0xfb, 0x7d, 0x85, 0x75, 0x46, 0xec, 0x74, 0xdb, 0x9b, 0x9d, 0x20,
+		0xb2, 0xee, 0xa1, 0x76, 0x62, 0x19, 0x8f, 0xad, 0xeb, 0x56, 0x74, 0x97, 0x64, 0xc8, 0x85, 0x03,
+		0x5f, 0xca, 0x00, 0x0d, 0x7d, 0x51, 0xc0, 0x55, 0xd0, 0xfc, 0xda, 0x02, 0xae, 0x5f, 0x9e, 0xac,
+		0x8a, 0x09, 0x5c, 0x47, 0x2d, 0x2f, 0xad, 0x57, 0x55, 0x65, 0x63, 0x75, 0x7d, 0x69, 0xa5, 0x2a,
+		0x8e, 0x84, 0x0b, 0xf6, 0xb7, 0x12, 0x90, 0x8f, 0x3e, 0x7b, 0x49, 0xff, 0x1f, 0x0e, 0xf1, 0x8b,
+		0x12, 0x0f, 0xf9, 0xea, 0x79, 0xc3, 0x25, 0x7b, 0xb1, 0xa5, 0xd1, 0x73, 0x31, 0x88, 0x86, 0x69,
+		0xa6, 0x55, 0x47, 0xfe, 0x63, 0x86, 0x8b, 0x77, 0x5a, 0x4b, 0xf3, 0xa5, 0x65, 0x28, 0x5a, 0xb6,
+		0xea, 0xf9, 0x9a, 0xd5, 0xd0, 0xdc, 0x86, 0xda, 0xbd, 0xa2, 0x52, 0x35, 0x5d, 0x47, 0x9e, 0x67,
+		0xd3, 0x33, 0x30, 0x60, 0xf9, 0x8c, 0x65, 0xd7, 0x99, 0x72, 0xf7, 0x70, 0x28, 0x33, 0xd5, 0x9e,
+		0xc8, 0x1d, 0xd9, 0x2b, 0x72, 0x6f, 0x84, 0x4c, 0x4b, 0x73, 0x54, 0x64, 0xf9, 0x6e, 0x87, 0x54,
+		0xdc, 0x69, 0x25, 0xdd, 0xd2, 0x9c, 0x2a, 0x6e, 0x7f, 0x3a, 0x0f, 0x3e, 0xff, 0x3c, 0x02, 0xb9,
+		0x70, 0xd5, 0x8d, 0x1f, 0x62, 0x74, 0x72, 0x40, 0x09, 0x24, 0x85, 0xdd, 0x72, 0xcd, 0x1a, 0x7d,
+		0x6e, 0x1e, 0x9f, 0x5c, 0xf2, 0x28, 0xad, 0x85, 0x15, 0x8a, 0xc4, 0x55, 0x03, 0x0e, 0x2d, 0x44,
+		0x6b, 0x8f, 0xb4, 0xc2, 0x5a, 0xd2, 0x22, 0x8c, 0x9e, 0xf5, 0x08, 0xf7, 0x28, 0xe1, 0xbe, 0xf5,
+		0xda, 0xdc, 0x0f, 0xd7, 0x09, 0x79, 0xe6, 0xe1, 0xba, 0xba, 0xba, 0xa6, 0xac, 0x94, 0x97, 0x15,
+		0x06, 0x97, 0x0e, 0x43, 0xd2, 0xd4, 0x2e, 0x76, 0xa2, 0x67, 0x1c, 0x11, 0x0d, 0xeb, 0xf8, 0xc3,
+		0x90, 0xc4, 0xd7, 0x6c, 0xd1, 0x93, 0x85, 0x88, 0xae, 0x63, 0xe8, 0x1f, 0x83, 0x14, 0xf1, 0x97,
+		0x04, 0xc0, 0x3c, 0x26, 0x1e, 0x90, 0xd2, 0x90, 0x9c, 0x5f, 0x53, 0x70, 0xf8, 0x8b, 0x90, 0xa3,
+		0x52, 0xb5, 0xb6, 0x54, 0x9d, 0xaf, 0x8a, 0x89, 0xd2, 0x49, 0x18, 0xa5, 0x4e, 0xc0, 0x5b, 0x23,
+		0x70, 0x83, 0x78, 0x80, 0x35, 0x19, 0x87, 0xc0, 0x7b, 0x37, 0x56, 0x2a, 0x55, 0x45, 0x4c, 0x84,
+		0x97, 0xd7, 0x83, 0x5c, 0xb8, 0xe0, 0xfe, 0x74, 0x62, 0xea, 0xbb, 0x02, 0x64, 0x43, 0x05, 0x34,
+		0xae, 0x7c, 0x34, 0xd3, 0xb4, 0xcf, 0xab, 0x9a, 0x69, 0x68, 0x1e, 0x0b, 0x0a, 0x20, 0xa2, 0x32,
+		0x96, 0x0c, 0xbb, 0x68, 0x9f, 0x8a, 0xf1, 0xaf, 0x08, 0x20, 0xf6, 0xd6, 0xae, 0x3d, 0x06, 0x0a,
+		0x3f, 0x53, 0x03, 0x5f, 0x12, 0x20, 0x1f, 0x2d, 0x58, 0x7b, 0xcc, 0xbb, 0xf9, 0x67, 0x6a, 0xde,
+		0x0f, 0x13, 0x30, 0x1e, 0x29, 0x53, 0x87, 0xb5, 0xee, 0x69, 0x98, 0x34, 0x1a, 0xa8, 0xe5, 0xd8,
+		0x3e, 0xbe, 0xf6, 0x56, 0x4d, 0x74, 0x0e, 0x99, 0x85, 0x12, 0x49, 0x14, 0xc7, 0xae, 0x5d, 0x08,
+		0xcf, 0x2d, 0x75, 0x71, 0xcb, 0x18, 0x26, 0x4f, 0x2d, 0x2d, 0x54, 0x57, 0x6a, 0x6b, 0xeb, 0xd5,
+		0xd5, 0xf9, 0x27, 0xd4, 0x8d, 0xd5, 0x47, 0x56, 0xd7, 0x1e, 0x5b, 0x55, 0x44, 0xa3, 0x47, 0xed,
+		0x3a, 0x6e, 0xf5, 0x1a, 0x88, 0xbd, 0x46, 0x49, 0x87, 0x60, 0x90, 0x59, 0xe2, 0x01, 0x69, 0x0a,
+		0x26, 0x56, 0xd7, 0xd4, 0xfa, 0xd2, 0x42, 0x55, 0xad, 0x9e, 0x39, 0x53, 0x9d, 0x5f, 0xaf, 0xd3,
+		0xab, 0x8d, 0x40, 0x7b, 0x3d, 0xba, 0xa9, 0x5f, 0x1c, 0x81, 0xa9, 0x01, 0x96, 0x48, 0x65, 0xf6,
+		0x50, 0x42, 0x9f, 0x93, 0xee, 0x19, 0xc6, 0xfa, 0x39, 0x5c, 0x15, 0xd4, 0x34, 0xd7, 0x67, 0xcf,
+		0x30, 0x77, 0x00, 0xf6, 0x92, 0xe5, 0x1b, 0x5b, 0x06, 0x72, 0xd9, 0x4d, 0x10, 0x7d, 0x52, 0x99,
+		0xe8, 0xca, 0xe9, 0x65, 0xd0, 0xdd, 0x20, 0x39, 0xb6, 0x67, 0xf8, 0xc6, 0x39, 0x7c, 0x99, 0xce,
+		0xaf, 0x8d, 0xf0, 0x93, 0x4b, 0x52, 0x11, 0x79, 0xcf, 0x92, 0xe5, 0x07, 0xda, 0x16, 0x6a, 0x6a,
+		0x3d, 0xda, 0x38, 0x81, 0x8f, 0x28, 0x22, 0xef, 0x09, 0xb4, 0x6f, 0x86, 0x5c, 0xc3, 0x6e, 0xe3,
+		0x72, 0x8e, 0xea, 0xe1, 0xf3, 0x42, 0x50, 0xb2, 0x54, 0x16, 0xa8, 0xb0, 0x42, 0xbd, 0x7b, 0x5f,
+		0x95, 0x53, 0xb2, 0x54, 0x46, 0x55, 0x6e, 0x87, 0x09, 0xad, 0xd9, 0x74, 0x31, 0x39, 0x27, 0xa2,
+		0x8f, 0x1e, 0xf9, 0x40, 0x4c, 0x14, 0x67, 0x1e, 0x86, 0x34, 0xf7, 0x03, 0x3e, 0x92, 0xb1, 0x27,
+		0x54, 0x87, 0x3e, 0x4f, 0x27, 0xf0, 0x15, 0x96, 0xc5, 0x3b, 0x6f, 0x86, 0x9c, 0xe1, 0xa9, 0xdd,
+		0xeb, 0xf7, 0xc4, 0x91, 0xc4, 0xd1, 0xb4, 0x92, 0x35, 0xbc, 0xe0, 0xea, 0xb2, 0xf4, 0x7a, 0x02,
+		0xf2, 0xd1, 0xd7, 0x07, 0xd2, 0x02, 0xa4, 0x4d, 0x5b, 0xd7, 0x48, 0x68, 0xd1, 0x77, 0x57, 0x47,
+		0x63, 0xde, 0x38, 0xcc, 0x2d, 0x33, 0x7d, 0x25, 0x40, 0xce, 0xfc, 0xa3, 0x00, 0x69, 0x2e, 0x96,
+		0x0e, 0x42, 0xd2, 0xd1, 0xfc, 0x6d, 0x42, 0x97, 0xaa, 0x24, 0x44, 0x41, 0x21, 0x6d, 0x2c, 0xf7,
+		0x1c, 0xcd, 0x2a, 0x24, 0xba, 0x72, 0xdc, 0xc6, 0xeb, 0x6a, 0x22, 0xad, 0x41, 0x9e, 0x6b, 0xec,
+		0x56, 0x0b, 0x59, 0xbe, 0xc7, 0xd7, 0x95, 0xc9, 0xe7, 0x99, 0x18, 0xbf, 0xc5, 0xf2, 0x5d, 0xcd,
+		0x30, 0x23, 0xba, 0x49, 0xa2, 0x2b, 0xf2, 0x8e, 0x40, 0x59, 0x86, 0xc3, 0x9c, 0xb7, 0x81, 0x7c,
+		0x4d, 0xdf, 0x46, 0x8d, 0x2e, 0x68, 0x94, 0xdc, 0x5f, 0x1c, 0x62, 0x0a, 0x0b, 0xac, 0x9f, 0x63,
+		0x4b, 0xdf, 0x17, 0x60, 0x92, 0x3f, 0x89, 0x35, 0x02, 0x67, 0xad, 0x00, 0x68, 0x96, 0x65, 0xfb,
+		0x61, 0x77, 0xf5, 0x87, 0x72, 0x1f, 0x6e, 0xae, 0x1c, 0x80, 0x94, 0x10, 0xc1, 0x4c, 0x0b, 0xa0,
+		0xdb, 0xb3, 0xa7, 0xdb, 0x8a, 0x90, 0x65, 0xef, 0x86, 0xc8, 0x0b, 0x46, 0xfa, 0xec, 0x0e, 0x54,
+		0x84, 0x1f, 0xd9, 0xf0, 0x0d, 0xcb, 0x26, 0x6a, 0x1a, 0x16, 0xbb, 0xf1, 0xa5, 0x0d, 0x7e, 0xc3,
+		0x92, 0x0c, 0x6e, 0x58, 0x2a, 0x4f, 0xc1, 0x94, 0x6e, 0xb7, 0x7a, 0xcd, 0xad, 0x88, 0x3d, 0xf7,
+		0x07, 0xde, 0x17, 0x84, 0x27, 0xa1, 0x5b, 0x62, 0xfe, 0x58, 0x10, 0x5e, 0x4b, 0x8c, 0x2c, 0xd6,
+		0x2a, 0x6f, 0x26, 0x66, 0x16, 0x29, 0xb4, 0xc6, 0x67, 0xaa, 0xa0, 0x2d, 0x13, 0xe9, 0xd8, 0x7a,
+		0xb8, 0x72, 0x17, 0x4c, 0x37, 0xed, 0xa6, 0x4d, 0x68, 0x8f, 0xe1, 0x5f, 0x74, 0x04, 0x29, 0x13,
+		0x48, 0x67, 0x62, 0xdf, 0xb5, 0xca, 0xab, 0x30, 0xc5, 0x94, 0x55, 0xf2, 0xfe, 0x86, 0x3e, 0x85,
+		0x48, 0xd7, 0xbc, 0x03, 0x2b, 0x7c, 0xeb, 0x5d, 0x72, 0x2a, 0x2b, 0x93, 0x0c, 0x8a, 0xfb, 0xe8,
+		0x83, 0x8a, 0xac, 0xc0, 0x0d, 0x11, 0x3e, 0xba, 0x03, 0x91, 0x1b, 0xc3, 0xf8, 0x16, 0x63, 0x9c,
+		0x0a, 0x31, 0xd6, 0x19, 0x54, 0x9e, 0x87, 0xf1, 0xfd, 0x70, 0xfd, 0x3d, 0xe3, 0xca, 0xa1, 0x30,
+		0xc9, 0x22, 0x4c, 0x10, 0x12, 0xbd, 0xed, 0xf9, 0x76, 0x8b, 0xa4, 0xb7, 0x6b, 0xd3, 0xfc, 0xc3,
+		0xbb, 0x74, 0x4b, 0xe4, 0x31, 0x6c, 0x3e, 0x40, 0xc9, 0x32, 0x90, 0x57, 0x56, 0xf8, 0x55, 0x52,
+		0x0c, 0xc3, 0xdb, 0xcc, 0x90, 0x40, 0x5f, 0xfe, 0x22, 0x4c, 0xe3, 0xdf, 0x24, 0xfb, 0x84, 0x2d,
+		0x89, 0xbf, 0x30, 0x2b, 0x7c, 0xff, 0x59, 0xba, 0xeb, 0xa6, 0x02, 0x82, 0x90, 0x4d, 0xa1, 0x55,
+		0x6c, 0x22, 0xdf, 0x47, 0xae, 0xa7, 0x6a, 0xe6, 0x20, 0xf3, 0x42, 0x37, 0x0e, 0x85, 0x6f, 0xbc,
+		0x1f, 0x5d, 0xc5, 0x45, 0x8a, 0x2c, 0x9b, 0xa6, 0xbc, 0x01, 0x87, 0x06, 0x44, 0xc5, 0x10, 0x9c,
+		0x2f, 0x32, 0xce, 0xe9, 0xbe, 0xc8, 0xc0, 0xb4, 0x35, 0xe0, 0xf2, 0x60, 0x2d, 0x87, 0xe0, 0xfc,
+		0x6d, 0xc6, 0x29, 0x31, 0x2c, 0x5f, 0x52, 0xcc, 0xf8, 0x30, 0x4c, 0x9e, 0x43, 0xee, 0xa6, 0xed,
+		0xb1, 0x5b, 0x9e, 0x21, 0xe8, 0x5e, 0x62, 0x74, 0x13, 0x0c, 0x48, 0xae, 0x7d, 0x30, 0xd7, 0x83,
+		0x90, 0xde, 0xd2, 0x74, 0x34, 0x04, 0xc5, 0xcb, 0x8c, 0x62, 0x0c, 0xeb, 0x63, 0x68, 0x19, 0x72,
+		0x4d, 0x9b, 0x1d, 0x40, 0xf1, 0xf0, 0x57, 0x18, 0x3c, 0xcb, 0x31, 0x8c, 0xc2, 0xb1, 0x9d, 0xb6,
+		0x89, 0x4f, 0xa7, 0x78, 0x8a, 0xdf, 0xe1, 0x14, 0x1c, 0xc3, 0x28, 0xf6, 0xe1, 0xd6, 0x57, 0x39,
+		0x85, 0x17, 0xf2, 0xe7, 0x43, 0xf8, 0x9d, 0x8e, 0xd9, 0xb1, 0xad, 0x61, 0x8c, 0xf8, 0x26, 0x63,
+		0x00, 0x06, 0xc1, 0x04, 0xa7, 0x21, 0x33, 0xec, 0x42, 0xfc, 0xde, 0xfb, 0x7c, 0x7b, 0xf0, 0x15,
+		0x58, 0x84, 0x09, 0x9e, 0xa0, 0xf0, 0x3b, 0xe0, 0x78, 0x8a, 0xdf, 0x67, 0x14, 0xf9, 0x10, 0x8c,
+		0x4d, 0xc3, 0x47, 0x9e, 0xdf, 0x44, 0xc3, 0x90, 0xbc, 0xce, 0xa7, 0xc1, 0x20, 0xcc, 0x95, 0x9b,
+		0xc8, 0xd2, 0xb7, 0x87, 0x63, 0x78, 0x83, 0xbb, 0x92, 0x63, 0x30, 0xc5, 0x3c, 0x8c, 0xb7, 0x34,
+		0xd7, 0xdb, 0xd6, 0xcc, 0xa1, 0x96, 0xe3, 0x0f, 0x18, 0x47, 0x2e, 0x00, 0x31, 0x8f, 0xb4, 0xad,
+		0xfd, 0xd0, 0xbc, 0xc9, 0x3d, 0xd2, 0xb6, 0x22, 0x44, 0x35, 0x98, 0xf6, 0x7c, 0x72, 0x25, 0xb6,
+		0x1f, 0xb6, 0x3f, 0xe4, 0x5b, 0x8f, 0x62, 0x57, 0xc2, 0x8c, 0xa7, 0x21, 0xe3, 0x19, 0x17, 0x87,
+		0xa2, 0xf9, 0x23, 0xbe, 0xd2, 0x04, 0x80, 0xc1, 0x4f, 0xc0, 0xe1, 0x81, 0xc7, 0xc4, 0x10, 0x64,
+		0x7f, 0xcc, 0xc8, 0x0e, 0x0e, 0x38, 0x2a, 0x58, 0x4a, 0xd8, 0x2f, 0xe5, 0x9f, 0xf0, 0x94, 0x80,
+		0x7a, 0xb8, 0x6a, 0xf8, 0x91, 0xc0, 0xd3, 0xb6, 0xf6, 0xe7, 0xb5, 0x3f, 0xe5, 0x5e, 0xa3, 0xd8,
+		0x88, 0xd7, 0xd6, 0xe1, 0x20, 0x63, 0xdc, 0xdf, 0xba, 0xfe, 0x19, 0x4f, 0xac, 0x14, 0xbd, 0x11,
+		0x5d, 0xdd, 0xa7, 0x60, 0x26, 0x70, 0x27, 0xaf, 0x3d, 0x3d, 0x15, 0x5f, 0x26, 0xc5, 0x33, 0x7f,
+		0x8b, 0x31, 0xf3, 0x8c, 0x1f, 0x14, 0xaf, 0xde, 0x8a, 0xe6, 0x60, 0xf2, 0xc7, 0xa1, 0xc0, 0xc9,
+		0xdb, 0x96, 0x8b, 0x74, 0xbb, 0x69, 0x19, 0x17, 0x51, 0x63, 0x08, 0xea, 0x3f, 0xef, 0x59, 0xaa,
+		0x8d, 0x10, 0x1c, 0x33, 0x2f, 0x81, 0x18, 0xd4, 0x2a, 0xaa, 0xd1, 0x72, 0x6c, 0xd7, 0x8f, 0x61,
+		0xfc, 0x0b, 0xbe, 0x52, 0x01, 0x6e, 0x89, 0xc0, 0xe4, 0x2a, 0xe4, 0x49, 0x73, 0xd8, 0x90, 0xfc,
+		0x4b, 0x46, 0x34, 0xde, 0x45, 0xb1, 0xc4, 0xa1, 0xdb, 0x2d, 0x47, 0x73, 0x87, 0xc9, 0x7f, 0x7f,
+		0xc5, 0x13, 0x07, 0x83, 0xb0, 0xc4, 0x81, 0x2f, 0xa5, 0xf0, 0x69, 0x3f, 0x04, 0xc3, 0xb7, 0x79,
+		0xe2, 0xe0, 0x18, 0x46, 0xc1, 0x0b, 0x86, 0x21, 0x28, 0xfe, 0x9a, 0x53, 0x70, 0x0c, 0xa6, 0x78,
+		0xb4, 0x7b, 0xd0, 0xba, 0xa8, 0x69, 0x78, 0xbe, 0x4b, 0x2b, 0xde, 0x6b, 0x53, 0xfd, 0xcd, 0xfb,
+		0xd1, 0x22, 0x4c, 0x09, 0x41, 0x71, 0x26, 0x62, 0x37, 0xa5, 0xe4, 0x81, 0x28, 0xde, 0xb0, 0xef,
+		0xf0, 0x4c, 0x14, 0x82, 0x61, 0xdb, 0x42, 0x15, 0x22, 0x76, 0xbb, 0x8e, 0x1f, 0x03, 0x86, 0xa0,
+		0xfb, 0x6e, 0x8f, 0x71, 0x75, 0x8e, 0xc5, 0x9c, 0xa1, 0xfa, 0xa7, 0x6d, 0xed, 0xa0, 0xce, 0x50,
+		0xd1, 0xf9, 0xb7, 0x3d, 0xf5, 0xcf, 0x06, 0x45, 0xd2, 0x1c, 0x32, 0xd1, 0x53, 0x4f, 0x49, 0x71,
+		0x1f, 0xfb, 0x14, 0x7e, 0xe1, 0x43, 0x36, 0xdf, 0x68, 0x39, 0x25, 0x2f, 0x83, 0xc8, 0x24, 0xdd,
+		0x02, 0x36, 0x96, 0xec, 0xd9, 0x0f, 0x83, 0x38, 0x8f, 0xd4, 0x3c, 0xf2, 0x19, 0x18, 0x8f, 0x14,
+		0x3c, 0xf1, 0x54, 0x5f, 0x66, 0x54, 0xb9, 0x70, 0xbd, 0x23, 0x9f, 0x84, 0x24, 0x2e, 0x5e, 0xe2,
+		0xe1, 0xbf, 0xc8, 0xe0, 0x44, 0x5d, 0xfe, 0x1c, 0xa4, 0x79, 0xd1, 0x12, 0x0f, 0xfd, 0x0a, 0x83,
+		0x06, 0x10, 0x0c, 0xe7, 0x05, 0x4b, 0x3c, 0xfc, 0x97, 0x38, 0x9c, 0x43, 0x30, 0x7c, 0x78, 0x17,
+		0xfe, 0xdd, 0x73, 0x49, 0x0a, 0xe7, 0x10, 0x19, 0xbf, 0xa7, 0xa6, 0x95, 0x4a, 0x3c, 0xfa, 0x6b,
+		0x6c, 0x70, 0x8e, 0x90, 0xef, 0x87, 0xd4, 0x90, 0x0e, 0xff, 0x65, 0x06, 0xa5, 0xfa, 0xf2, 0x3c,
+		0x64, 0x43, 0xd5, 0x49, 0x3c, 0xfc, 0x57, 0x18, 0x3c, 0x8c, 0xc2, 0xa6, 0xb3, 0xea, 0x24, 0x9e,
+		0xe0, 0x57, 0xb9, 0xe9, 0x0c, 0x81, 0xdd, 0xc6, 0x0b, 0x93, 0x78, 0xf4, 0xf3, 0xdc, 0xeb, 0x1c,
+		0x22, 0x3f, 0x04, 0x99, 0xe0, 0xb0, 0x89, 0xc7, 0xff, 0x1a, 0xc3, 0x77, 0x31, 0xd8, 0x03, 0x6d,
+		0x6b, 0x1f, 0x14, 0xbf, 0xce, 0x3d, 0x10, 0x42, 0xe1, 0x6d, 0xd4, 0x5b, 0xc0, 0xc4, 0x33, 0xfd,
+		0x06, 0xdf, 0x46, 0x3d, 0xf5, 0x0b, 0x5e, 0x4d, 0x92, 0xf3, 0xe3, 0x29, 0x7e, 0x93, 0xaf, 0x26,
+		0xd1, 0xc7, 0x66, 0xf4, 0x56, 0x04, 0xf1, 0x1c, 0xbf, 0xc5, 0xcd, 0xe8, 0x29, 0x08, 0xe4, 0x1a,
+		0x48, 0xfd, 0xd5, 0x40, 0x3c, 0xdf, 0x0b, 0x8c, 0x6f, 0xb2, 0xaf, 0x18, 0x90, 0x1f, 0x83, 0x83,
+		0x83, 0x2b, 0x81, 0x78, 0xd6, 0x6f, 0x7c, 0xd8, 0xf3, 0xec, 0x16, 0x2e, 0x04, 0xe4, 0x75, 0x98,
+		0x1e, 0x54, 0x05, 0xc4, 0xd3, 0xbe, 0xf8, 0x61, 0x34, 0x71, 0x87, 0x8b, 0x00, 0xb9, 0x0c, 0xd0,
+		0x3d, 0x80, 0xe3, 0xb9, 0x5e, 0x62, 0x5c, 0x21, 0x10, 0xde, 0x1a, 0xec, 0xfc, 0x8d, 0xc7, 0xbf,
+		0xcc, 0xb7, 0x06, 0x43, 0xe0, 0xad, 0xc1, 0x8f, 0xde, 0x78, 0xf4, 0x2b, 0x7c, 0x6b, 0x70, 0x08,
+		0x8e, 0xec, 0xd0, 0xe9, 0x16, 0xcf, 0xf0, 0x4d, 0x1e, 0xd9, 0x21, 0x94, 0xbc, 0x0a, 0x93, 0x7d,
+		0x07, 0x62, 0x3c, 0xd5, 0x6b, 0x8c, 0x4a, 0xec, 0x3d, 0x0f, 0xc3, 0x87, 0x17, 0x3b, 0x0c, 0xe3,
+		0xd9, 0x7e, 0xb7, 0xe7, 0xf0, 0x62, 0x67, 0xa1, 0x7c, 0x1a, 0xd2, 0x56, 0xdb, 0x34, 0xf1, 0xe6,
+		0x91, 0xae, 0xfd, 0x81, 0x5e, 0xe1, 0xdf, 0x3e, 0x62, 0xde, 0xe1, 0x00, 0xf9, 0x24, 0xa4, 0x50,
+		0x6b, 0x13, 0x35, 0xe2, 0x90, 0x57, 0x3e, 0xe2, 0x09, 0x13, 0x6b, 0xcb, 0x0f, 0x01, 0xd0, 0xab,
+		0x11, 0xf2, 0x76, 0x2f, 0x06, 0xfb, 0xef, 0x1f, 0xb1, 0x4f, 0x67, 0xba, 0x90, 0x2e, 0x01, 0xfd,
+		0x10, 0xe7, 0xda, 0x04, 0xef, 0x47, 0x09, 0xc8, 0x8a, 0x3c, 0x08, 0x63, 0xf8, 0x3b, 0x45, 0x5f,
+		0x6b, 0xc6, 0xa1, 0xff, 0x83, 0xa1, 0xb9, 0x3e, 0x76, 0x58, 0xcb, 0x76, 0x91, 0xaf, 0x35, 0xbd,
+		0x38, 0xec, 0x7f, 0x32, 0x6c, 0x00, 0xc0, 0x60, 0x5d, 0xf3, 0xfc, 0x61, 0xe6, 0xfd, 0x5f, 0x1c,
+		0xcc, 0x01, 0xd8, 0x68, 0xfc, 0x7b, 0x07, 0x75, 0xe2, 0xb0, 0x1f, 0x70, 0xa3, 0x99, 0xbe, 0xfc,
+		0x39, 0xc8, 0xe0, 0x9f, 0xf4, 0x7b, 0xb8, 0x18, 0xf0, 0x7f, 0x33, 0x70, 0x17, 0x81, 0x47, 0xf6,
+		0xfc, 0x86, 0x6f, 0xc4, 0x3b, 0xfb, 0x2a, 0x5b, 0x69, 0xae, 0x2f, 0x97, 0x21, 0xeb, 0xf9, 0x8d,
+		0x46, 0x9b, 0xd5, 0xa7, 0x31, 0xf0, 0xff, 0xf9, 0x28, 0xb8, 0xb2, 0x08, 0x30, 0x78, 0xb5, 0xcf,
+		0xef, 0xf8, 0x8e, 0x4d, 0xde, 0x66, 0xc4, 0x31, 0x7c, 0xc8, 0x18, 0x42, 0x10, 0x79, 0x1e, 0x72,
+		0x78, 0x2e, 0x2e, 0x72, 0x10, 0x79, 0xf5, 0x14, 0x43, 0xf1, 0x23, 0xe6, 0x80, 0x08, 0xa8, 0xf2,
+		0xa5, 0xb7, 0x2f, 0xcd, 0x0a, 0xdf, 0xbb, 0x34, 0x2b, 0xfc, 0xf0, 0xd2, 0xac, 0xf0, 0xfc, 0xe5,
+		0xd9, 0x03, 0xdf, 0xbb, 0x3c, 0x7b, 0xe0, 0x07, 0x97, 0x67, 0x0f, 0x0c, 0xbe, 0x02, 0x86, 0x45,
+		0x7b, 0xd1, 0xa6, 0x97, 0xbf, 0x4f, 0x96, 0x9a, 0x86, 0xbf, 0xdd, 0xde, 0x9c, 0xd3, 0xed, 0x16,
+		0xb9, 0xc6, 0xed, 0xde, 0xd6, 0x06, 0x0f, 0x39, 0xf0, 0x95, 0x04, 0x14, 0x7b, 0xef, 0x72, 0xb1,
+		0x03, 0x3d, 0x5f, 0x6b, 0x39, 0x7b, 0xfd, 0xd9, 0xcc, 0x69, 0xc8, 0xac, 0x73, 0x1d, 0xfc, 0x87,
+		0x2c, 0x1e, 0xd2, 0x6d, 0xab, 0xe1, 0x91, 0x37, 0x96, 0x23, 0x0a, 0x6f, 0xe2, 0xdb, 0x6c, 0x4b,
+		0xb3, 0x6c, 0x8f, 0x7d, 0xd5, 0x47, 0x1b, 0x95, 0xaf, 0x0b, 0x3f, 0x78, 0x67, 0xf6, 0xc0, 0x07,
+		0xef, 0xcc, 0x0a, 0x6f, 0x5c, 0x9a, 0x15, 0xc2, 0xb3, 0x7b, 0xef, 0xd2, 0xec, 0x81, 0x0f, 0xd8,
+		0x2c, 0xdf, 0xb8, 0x3c, 0x2b, 0xbc, 0x7d, 0x79, 0x56, 0xb8, 0xf6, 0x6c, 0xf3, 0x81, 0x19, 0x64,
+		0xca, 0x35, 0xe1, 0xc9, 0x14, 0x0e, 0x59, 0x2f, 0x74, 0xd3, 0x3d, 0xdb, 0x7b, 0xd3, 0xfd, 0x18,
+		0x32, 0xcd, 0x47, 0x2c, 0xfb, 0xbc, 0x85, 0xdf, 0x78, 0x7b, 0x9b, 0xa3, 0x84, 0xec, 0x04, 0x3c,
+		0x9b, 0x80, 0xd9, 0xbe, 0x4b, 0x6d, 0x16, 0x0a, 0x7b, 0xf9, 0x41, 0x86, 0xf4, 0x02, 0x8f, 0xb0,
+		0xfd, 0xba, 0xe1, 0xb9, 0x9f, 0xa2, 0x1b, 0xc6, 0xb9, 0x15, 0x9f, 0xd8, 0x0b, 0x3f, 0x11, 0xf0,
+		0xfd, 0x49, 0xd4, 0x0b, 0x9a, 0xd5, 0xd9, 0xcb, 0x01, 0xa7, 0x60, 0xa4, 0x6c, 0x75, 0xa4, 0xc3,
+		0xf4, 0xb0, 0x53, 0xdb, 0xae, 0xc9, 0x3e, 0xd0, 0x1b, 0xc3, 0xed, 0x0d, 0xd7, 0xc4, 0x93, 0xe7,
+		0x5f, 0xd1, 0xe2, 0x17, 0x67, 0xb4, 0x11, 0x9a, 0xfc, 0x8f, 0xdf, 0x99, 0x15, 0x9e, 0xb9, 0xf4,
+		0xd3, 0x70, 0x42, 0xba, 0x6c, 0x75, 0xa2, 0xf3, 0xdf, 0xef, 0xe4, 0xdf, 0xcc, 0xc0, 0xe4, 0x85,
+		0x63, 0x9a, 0xe3, 0x78, 0xe4, 0x1f, 0x36, 0xe9, 0xd1, 0x0b, 0x73, 0xb8, 0x35, 0x33, 0xf0, 0x9d,
+		0xc8, 0x4c, 0xdc, 0xe6, 0x29, 0xbd, 0x3c, 0x06, 0x22, 0x19, 0xb8, 0xec, 0x38, 0xa6, 0xc1, 0xde,
+		0xab, 0x99, 0x30, 0xa6, 0x35, 0x1a, 0x2e, 0xf2, 0x68, 0xb0, 0xe4, 0x2a, 0xca, 0x95, 0xdd, 0x22,
+		0x17, 0x5d, 0xdd, 0x2d, 0xe6, 0x3b, 0x5a, 0xcb, 0x94, 0x4b, 0x4c, 0x50, 0xfa, 0xc9, 0x6e, 0xf1,
+		0xff, 0x85, 0xf6, 0xb2, 0x63, 0xef, 0xf8, 0xf7, 0x58, 0xc8, 0x3f, 0x6f, 0xbb, 0x3b, 0xc7, 0x1c,
+		0x5b, 0xdf, 0x41, 0xfe, 0x3d, 0xba, 0xed, 0xa2, 0x63, 0x64, 0xd6, 0x73, 0x65, 0x8a, 0x52, 0x38,
+		0x9f, 0x54, 0x01, 0x60, 0x7f, 0x30, 0xb6, 0x83, 0x3a, 0x74, 0x21, 0x2a, 0xb7, 0x5c, 0xd9, 0x2d,
+		0x86, 0xa4, 0x57, 0x77, 0x8b, 0x93, 0x74, 0xcc, 0xae, 0xac, 0xa4, 0x64, 0x68, 0xe3, 0x11, 0xd4,
+		0x91, 0x4e, 0xc0, 0xe8, 0x59, 0xcd, 0x30, 0xf9, 0x77, 0x13, 0x95, 0x1b, 0xaf, 0xec, 0x16, 0x99,
+		0xe4, 0xea, 0x6e, 0x71, 0x9c, 0x62, 0x69, 0xbb, 0xa4, 0xb0, 0x0e, 0xc9, 0x84, 0x51, 0xcf, 0xd7,
+		0xfc, 0x36, 0x7d, 0xb1, 0x97, 0xaa, 0xac, 0x63, 0x10, 0x95, 0x74, 0x41, 0xb4, 0x8d, 0xe7, 0x78,
+		0x72, 0xf8, 0x39, 0xd6, 0x7d, 0x6d, 0x07, 0xd5, 0x09, 0x52, 0x61, 0x8c, 0xd8, 0x44, 0x7d, 0x5b,
+		0x33, 0x2c, 0x8f, 0x7e, 0xd1, 0x4c, 0x4d, 0xa4, 0x92, 0xee, 0x68, 0xb4, 0x5d, 0x52, 0x58, 0x87,
+		0x74, 0x01, 0xc6, 0x3d, 0xcc, 0xd5, 0x50, 0x7d, 0x7b, 0x07, 0x59, 0x1e, 0xfd, 0x6b, 0x86, 0x4a,
+		0xfd, 0xed, 0xdd, 0xe2, 0x81, 0x7f, 0xda, 0x2d, 0xde, 0x3b, 0xbc, 0x49, 0x15, 0xa3, 0xb9, 0x64,
+		0xf9, 0x78, 0x4c, 0xca, 0xd4, 0x1d, 0x93, 0xb6, 0x4b, 0x4a, 0x8e, 0x8e, 0xb4, 0x4e, 0x9a, 0xd2,
+		0x45, 0x80, 0x96, 0x76, 0x41, 0x75, 0x91, 0xa9, 0x75, 0xe8, 0x1f, 0xfe, 0x64, 0x2a, 0x4f, 0x7d,
+		0x82, 0x61, 0x43, 0x6c, 0xdd, 0xd5, 0xec, 0xca, 0x4a, 0xf8, 0xc1, 0xe9, 0x82, 0x42, 0x7e, 0x4b,
+		0xcf, 0x09, 0x70, 0xb8, 0x6d, 0x61, 0x73, 0xd8, 0xeb, 0x57, 0xc7, 0x44, 0xe4, 0x66, 0x1c, 0x47,
+		0x2f, 0xfb, 0xb3, 0x89, 0x99, 0xbe, 0xd3, 0x2a, 0x48, 0xb6, 0x95, 0x13, 0xd8, 0xce, 0x2b, 0xbb,
+		0xc5, 0x7c, 0x97, 0x04, 0x23, 0xaf, 0xee, 0x16, 0x6f, 0xa0, 0xe3, 0x46, 0xe5, 0xa5, 0xe7, 0xff,
+		0xa5, 0x28, 0x28, 0x87, 0x02, 0xe1, 0x7c, 0x30, 0x20, 0xa6, 0x94, 0x9e, 0x86, 0xa9, 0xa6, 0xe6,
+		0xa3, 0xf3, 0x5a, 0x47, 0xed, 0x46, 0x9f, 0x47, 0xfe, 0x92, 0x29, 0x53, 0x79, 0xe8, 0xca, 0x6e,
+		0xf1, 0xa6, 0x01, 0xdd, 0x77, 0xdb, 0x2d, 0xc3, 0x47, 0x2d, 0xc7, 0xc7, 0xb1, 0x3b, 0x43, 0x47,
+		0x1d, 0xa0, 0x56, 0x52, 0x06, 0x71, 0xcb, 0xe9, 0xaf, 0xbe, 0x5a, 0x3c, 0xf0, 0xde, 0xab, 0x45,
+		0xa1, 0xb4, 0x09, 0xc9, 0x9a, 0x6d, 0x9b, 0x52, 0x0d, 0xd8, 0xba, 0xd1, 0x0c, 0x56, 0x79, 0xe0,
+		0xe3, 0x2e, 0x85, 0xc2, 0x78, 0xe4, 0x34, 0xe6, 0xff, 0xe0, 0xd5, 0xa2, 0x50, 0x79, 0x78, 0xaf,
+		0x33, 0xfc, 0xc9, 0xa1, 0xd8, 0x59, 0x4e, 0xf2, 0x23, 0xc9, 0xea, 0xad, 0x31, 0x10, 0x59, 0x47,
+		0xcb, 0x6b, 0x0e, 0x93, 0xab, 0x4a, 0x3f, 0x12, 0x60, 0x7c, 0xc5, 0x6b, 0xd6, 0xe8, 0xbd, 0x8f,
+		0xb6, 0x83, 0x3f, 0xec, 0x1c, 0x73, 0xda, 0x9b, 0x24, 0x2d, 0xd0, 0x3c, 0x44, 0xf6, 0x8c, 0xd3,
+		0xde, 0xa4, 0x29, 0x61, 0x3c, 0x48, 0x09, 0x24, 0x1d, 0xe0, 0x0e, 0x96, 0x0b, 0xd8, 0x46, 0x4b,
+		0x0c, 0xbf, 0xd1, 0x9a, 0xfc, 0x20, 0x20, 0x45, 0x4e, 0xe5, 0xd1, 0x4f, 0x10, 0xe9, 0x94, 0xe8,
+		0xea, 0x6e, 0x31, 0x47, 0x87, 0x22, 0xcd, 0x12, 0x3b, 0x5b, 0x64, 0x11, 0x2f, 0xed, 0x0b, 0xaf,
+		0x16, 0x05, 0xec, 0xfe, 0xaf, 0xbe, 0x56, 0x14, 0x4a, 0x6f, 0x0a, 0x30, 0xb1, 0xe2, 0x35, 0x2b,
+		0xf8, 0x65, 0xfa, 0x06, 0x89, 0x41, 0x24, 0x7d, 0x59, 0x80, 0xb1, 0x72, 0x24, 0x05, 0x9f, 0xbd,
+		0xb2, 0x5b, 0x9c, 0xd2, 0xba, 0x49, 0x5a, 0xed, 0xa6, 0x63, 0x16, 0x5e, 0x03, 0x3a, 0x3f, 0x6e,
+		0x6a, 0x66, 0x3f, 0x06, 0x18, 0xfb, 0x9c, 0x00, 0x99, 0x15, 0xaf, 0xb9, 0x61, 0xe1, 0x1c, 0x8a,
+		0x0f, 0x8a, 0xb2, 0xe3, 0x60, 0xed, 0xeb, 0x79, 0x50, 0xb0, 0x21, 0x06, 0x59, 0x93, 0x80, 0xec,
+		0x8a, 0xd7, 0x5c, 0x40, 0x26, 0xf9, 0x8e, 0xe5, 0xff, 0x88, 0xdb, 0x24, 0x1d, 0x26, 0x17, 0xe9,
+		0xae, 0xae, 0xf1, 0x13, 0x8a, 0x07, 0xe3, 0x49, 0x6c, 0xcf, 0x80, 0x2d, 0x1f, 0x93, 0x25, 0xfa,
+		0xf9, 0xfa, 0xbd, 0x71, 0x3d, 0xf6, 0xf1, 0xff, 0x0e, 0x00, 0xa4, 0x75, 0x85, 0xb4, 0xb9, 0x3e,
+		0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -525,6 +537,14 @@ func (this *ProtoApplication) Equal(that interface{}) bool {
 	if !this.UnstakingCompletionTime.Equal(that1.UnstakingCompletionTime) {
 		return false
 	}
+	if len(this.GatewayPublicKeys) != len(that1.GatewayPublicKeys) {
+		return false
+	}
+	for i := range this.GatewayPublicKeys {
+		if this.GatewayPublicKeys[i] != that1.GatewayPublicKeys[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *Pool) Equal(that interface{}) bool {
@@ -571,6 +591,15 @@ func (m *ProtoApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GatewayPublicKeys) > 0 {
+		for iNdEx := len(m.GatewayPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GatewayPublicKeys[iNdEx])
+			copy(dAtA[i:], m.GatewayPublicKeys[iNdEx])
+			i = encodeVarintApps(dAtA, i, uint64(len(m.GatewayPublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnstakingCompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnstakingCompletionTime):])
 	if err1 != nil {
 		return 0, err1
@@ -716,6 +745,12 @@ func (m *ProtoApplication) Size() (n int) {
 	n += 1 + l + sovApps(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnstakingCompletionTime)
 	n += 1 + l + sovApps(uint64(l))
+	if len(m.GatewayPublicKeys) > 0 {
+		for _, s := range m.GatewayPublicKeys {
+			l = len(s)
+			n += 1 + l + sovApps(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1005,6 +1040,38 @@ func (m *ProtoApplication) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayPublicKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayPublicKeys = append(m.GatewayPublicKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApps(dAtA[iNdEx:])
@@ -13,8 +13,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterStructure(MsgStake{}, "apps/MsgAppStake")
 	cdc.RegisterStructure(MsgBeginUnstake{}, "apps/MsgAppBeginUnstake")
 	cdc.RegisterStructure(MsgUnjail{}, "apps/MsgAppUnjail")
-	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgStake{}, &MsgBeginUnstake{}, &MsgUnjail{})
-	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgStake{}, &MsgBeginUnstake{}, &MsgUnjail{})
+	cdc.RegisterStructure(MsgDelegate{}, "apps/MsgAppDelegate")
+	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgStake{}, &MsgBeginUnstake{}, &MsgUnjail{}, &MsgDelegate{})
+	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgStake{}, &MsgBeginUnstake{}, &MsgUnjail{}, &MsgDelegate{})
 	ModuleCdc = cdc
 }
 
@@ -26,6 +26,9 @@ const (
 	CodeTooManyChains         CodeType          = 118
 	CodeMaxApplications       CodeType          = 119
 	CodeMinimumEditStake      CodeType          = 120
+	CodeInvalidGateway        CodeType          = 121
+	CodeTooManyGateways       CodeType          = 122
+	CodeDelegationNotAllowed  CodeType          = 123
 )
 
 func ErrTooManyChains(Codespace sdk.CodespaceType) sdk.Error {
@@ -101,3 +104,15 @@ func ErrInvalidNetworkIdentifier(codespace sdk.CodespaceType, err error) sdk.Err
 func ErrMinimumEditStake(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeMinimumEditStake, "application must edit stake with a stake greater than or equal to current stake")
 }
+
+func ErrInvalidGatewayPublicKey(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidGateway, fmt.Sprintf("the gateway public key is not valid: "+err.Error()))
+}
+
+func ErrTooManyGateways(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeTooManyGateways, fmt.Sprintf("an application can't delegate to more than %d gateways", MaxGatewayPublicKeys))
+}
+
+func ErrDelegationNotAllowed(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeDelegationNotAllowed, "the delegation to gateways is not allowed before the upgrade")
+}
@@ -7,6 +7,7 @@ const (
 	EventTypeStake             = "stake"
 	EventTypeBeginUnstake      = "begin_unstake"
 	EventTypeUnstake           = "unstake"
+	EventTypeDelegate          = "delegate"
 	AttributeKeyApplication    = "application"
 	AttributeValueCategory     = ModuleName
 )
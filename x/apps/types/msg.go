@@ -14,12 +14,17 @@ var (
 	_ codec.ProtoMarshaler = &MsgStake{}
 	_ sdk.ProtoMsg         = &MsgBeginUnstake{}
 	_ sdk.ProtoMsg         = &MsgUnjail{}
+	_ sdk.ProtoMsg         = &MsgDelegate{}
+	_ sdk.FeatureMsg       = MsgDelegate{}
 )
 
 const (
-	MsgAppStakeName   = "app_stake"
-	MsgAppUnstakeName = "app_begin_unstake"
-	MsgAppUnjailName  = "app_unjail"
+	MsgAppStakeName    = "app_stake"
+	MsgAppUnstakeName  = "app_begin_unstake"
+	MsgAppUnjailName   = "app_unjail"
+	MsgAppDelegateName = "app_delegate"
+	// the maximum number of gateways an application delegates to
+	MaxGatewayPublicKeys = 10
 )
 
 type MsgStake struct {
@@ -211,3 +216,61 @@ func (msg MsgUnjail) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgDelegate) GetSigner() sdk.Address {
+	return msg.Address
+}
+
+func (msg MsgDelegate) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgDelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check for the delegation of an application;
+// the gateway public keys replace the current ones (an empty list revokes every gateway)
+func (msg MsgDelegate) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilApplicationAddr(DefaultCodespace)
+	}
+	if len(msg.GatewayPublicKeys) > MaxGatewayPublicKeys {
+		return ErrTooManyGateways(DefaultCodespace)
+	}
+	m := make(map[string]struct{})
+	for _, pk := range msg.GatewayPublicKeys {
+		if _, found := m[pk]; found {
+			return ErrInvalidGatewayPublicKey(DefaultCodespace, fmt.Errorf("duplicate gateway public key %s", pk))
+		}
+		m[pk] = struct{}{}
+		if _, err := crypto.NewPublicKey(pk); err != nil {
+			return ErrInvalidGatewayPublicKey(DefaultCodespace, err)
+		}
+	}
+	return nil
+}
+
+// WithoutInactiveFeatures refuses the delegation before its feature
+func (msg MsgDelegate) WithoutInactiveFeatures(height int64) sdk.ProtoMsg {
+	if !ModuleCdc.IsAfterFeatureUpgrade(codec.AppDelegationFeatureKey, height) {
+		return nil
+	}
+	return &msg
+}
+
+// Route provides router key for msg
+func (msg MsgDelegate) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgDelegate) Type() string { return MsgAppDelegateName }
+
+// GetFee get fee for msg
+func (msg MsgDelegate) GetFee() sdk.BigInt {
+	return sdk.NewInt(AppFeeMap[msg.Type()])
+}
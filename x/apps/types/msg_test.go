@@ -435,3 +435,65 @@ func TestMsgAppUnjail_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgDelegate_ValidateBasic(t *testing.T) {
+	gateway := crypto.GenerateEd25519PrivKey().PublicKey().RawString()
+	tooMany := make([]string, MaxGatewayPublicKeys+1)
+	for i := range tooMany {
+		tooMany[i] = crypto.GenerateEd25519PrivKey().PublicKey().RawString()
+	}
+	tests := []struct {
+		name     string
+		msg      MsgDelegate
+		hasError bool
+	}{
+		{
+			name:     "errs if no Address",
+			msg:      MsgDelegate{GatewayPublicKeys: []string{gateway}},
+			hasError: true,
+		},
+		{
+			name:     "errs if invalid gateway public key",
+			msg:      MsgDelegate{Address: sdk.Address(pk.Address()), GatewayPublicKeys: []string{"abcd"}},
+			hasError: true,
+		},
+		{
+			name:     "errs if duplicate gateway public key",
+			msg:      MsgDelegate{Address: sdk.Address(pk.Address()), GatewayPublicKeys: []string{gateway, gateway}},
+			hasError: true,
+		},
+		{
+			name:     "errs if too many gateways",
+			msg:      MsgDelegate{Address: sdk.Address(pk.Address()), GatewayPublicKeys: tooMany},
+			hasError: true,
+		},
+		{
+			name: "returns nil if valid gateways",
+			msg:  MsgDelegate{Address: sdk.Address(pk.Address()), GatewayPublicKeys: []string{gateway}},
+		},
+		{
+			name: "returns nil if no gateways (undelegate)",
+			msg:  MsgDelegate{Address: sdk.Address(pk.Address())},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); (got != nil) != tt.hasError {
+				t.Errorf("ValidateBasic() = %v, want error %v", got, tt.hasError)
+			}
+		})
+	}
+}
+
+func TestMsgDelegate_WithoutInactiveFeatures(t *testing.T) {
+	defer func(f map[string]int64) { codec.UpgradeFeatureMap = f }(codec.UpgradeFeatureMap)
+	codec.UpgradeFeatureMap = map[string]int64{codec.AppDelegationFeatureKey: 100}
+	msg := MsgDelegate{Address: sdk.Address(pk.Address()), GatewayPublicKeys: []string{crypto.GenerateEd25519PrivKey().PublicKey().RawString()}}
+	// the binaries before the feature can't decode the msg
+	if got := msg.WithoutInactiveFeatures(99); got != nil {
+		t.Errorf("WithoutInactiveFeatures() = %v, want nil", got)
+	}
+	if got := msg.WithoutInactiveFeatures(100); !reflect.DeepEqual(got, &msg) {
+		t.Errorf("WithoutInactiveFeatures() = %v, want %v", got, msg)
+	}
+}
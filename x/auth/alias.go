@@ -33,6 +33,7 @@ var (
 	DefaultTxDecoder          = types.DefaultTxDecoder
 	DefaultTxEncoder          = types.DefaultTxEncoder
 	NewTxBuilder              = types.NewTxBuilder
+	NewMultisigPartialTx      = types.NewMultisigPartialTx
 	ModuleCdc                 = types.ModuleCdc
 )
 
@@ -49,4 +50,5 @@ type (
 	StdSignDoc         = types.StdSignDoc
 	StdSignature       = types.ProtoStdSignature
 	TxBuilder          = types.TxBuilder
+	MultisigPartialTx  = types.MultisigPartialTx
 )
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+
 	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/codec/types"
 	"github.com/pokt-network/pocket-core/crypto"
@@ -29,3 +33,25 @@ func init() {
 	RegisterCodec(ModuleCdc)
 	crypto.RegisterAmino(ModuleCdc.AminoCodec().Amino)
 }
+
+// UnmarshalJSONProtoMsg decodes the json msg into its proto msg;
+// the msgs are registered by value while the proto msgs are implemented by pointer
+func UnmarshalJSONProtoMsg(cdc *codec.Codec, bz []byte) (sdk.ProtoMsg, error) {
+	var m sdk.Msg
+	if err := cdc.UnmarshalJSON(bz, &m); err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, errors.New("the msg is missing")
+	}
+	if msg, ok := m.(sdk.ProtoMsg); ok {
+		return msg, nil
+	}
+	ptr := reflect.New(reflect.TypeOf(m))
+	ptr.Elem().Set(reflect.ValueOf(m))
+	msg, ok := ptr.Interface().(sdk.ProtoMsg)
+	if !ok {
+		return nil, fmt.Errorf("unsupported msg type %T", m)
+	}
+	return msg, nil
+}
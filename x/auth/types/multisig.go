@@ -0,0 +1,252 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/crypto"
+	crkeys "github.com/pokt-network/pocket-core/crypto/keys"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/tendermint/tendermint/libs/rand"
+)
+
+// MultisigPartialTx is a multisignature transaction proposal: the signers add their signature to the
+// proposal (in any order) and the partial signatures of independent signers may be combined;
+// once every key signed, it is encoded into a std tx
+type MultisigPartialTx struct {
+	ChainID     string                     `json:"chain_id"`
+	PublicKeys  []string                   `json:"public_keys"` // hex, in the order of the multisig public key
+	Msg         json.RawMessage            `json:"msg"`
+	Fee         sdk.Coins                  `json:"fee"`
+	Memo        string                     `json:"memo"`
+	Entropy     int64                      `json:"entropy"`
+	LegacyCodec bool                       `json:"legacy_codec"`
+	Signatures  []MultisigPartialSignature `json:"signatures"`
+}
+
+// MultisigPartialSignature is the signature of one of the keys of the multisig public key
+type MultisigPartialSignature struct {
+	PublicKey string `json:"public_key"`
+	Signature string `json:"signature"`
+}
+
+// NewMultisigPartialTx returns a proposal of the msg without signatures
+func NewMultisigPartialTx(cdc *codec.Codec, msg sdk.Msg, pk crypto.PublicKeyMultiSignature, chainID string, fee sdk.Coins, memo string, legacyCodec bool) (MultisigPartialTx, error) {
+	if chainID == "" {
+		return MultisigPartialTx{}, errors.New("cant build the multisig transaction: the chainID is empty")
+	}
+	if len(pk.PublicKeys) < 2 {
+		return MultisigPartialTx{}, errors.New("cant build the multisig transaction: the multisig public key must have at least two public keys")
+	}
+	msgBz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return MultisigPartialTx{}, err
+	}
+	keys := make([]string, len(pk.PublicKeys))
+	for i, k := range pk.PublicKeys {
+		keys[i] = k.RawString()
+	}
+	return MultisigPartialTx{
+		ChainID:     chainID,
+		PublicKeys:  keys,
+		Msg:         msgBz,
+		Fee:         fee,
+		Memo:        memo,
+		Entropy:     rand.Int64(),
+		LegacyCodec: legacyCodec,
+		Signatures:  []MultisigPartialSignature{},
+	}, nil
+}
+
+// MultisigKey returns the multisig public key of the proposal
+func (ptx MultisigPartialTx) MultisigKey() (crypto.PublicKeyMultiSignature, error) {
+	keys := make([]crypto.PublicKey, len(ptx.PublicKeys))
+	for i, k := range ptx.PublicKeys {
+		pk, err := crypto.NewPublicKey(k)
+		if err != nil {
+			return crypto.PublicKeyMultiSignature{}, fmt.Errorf("invalid public key %s in the multisig transaction: %s", k, err.Error())
+		}
+		keys[i] = pk
+	}
+	return crypto.PublicKeyMultiSignature{PublicKeys: keys}, nil
+}
+
+// GetMsg decodes the msg of the proposal
+func (ptx MultisigPartialTx) GetMsg(cdc *codec.Codec) (sdk.ProtoMsg, error) {
+	msg, err := UnmarshalJSONProtoMsg(cdc, ptx.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the msg of the multisig transaction: %s", err.Error())
+	}
+	return msg, nil
+}
+
+// SignBytes returns the bytes every key signs
+func (ptx MultisigPartialTx) SignBytes(cdc *codec.Codec) ([]byte, error) {
+	msg, err := ptx.GetMsg(cdc)
+	if err != nil {
+		return nil, err
+	}
+	return StdSignBytes(ptx.ChainID, ptx.Entropy, ptx.Fee, msg, ptx.Memo)
+}
+
+// AddSignature verifies the signature of the key and adds it to the proposal (replacing a previous signature of the key)
+func (ptx *MultisigPartialTx) AddSignature(cdc *codec.Codec, pubKey crypto.PublicKey, sig []byte) error {
+	index := ptx.keyIndex(pubKey.RawString())
+	if index == -1 {
+		return fmt.Errorf("the public key %s is not a signer of the multisig transaction", pubKey.RawString())
+	}
+	signBz, err := ptx.SignBytes(cdc)
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifyBytes(signBz, sig) {
+		return fmt.Errorf("invalid signature of %s for the multisig transaction", pubKey.RawString())
+	}
+	partial := MultisigPartialSignature{PublicKey: pubKey.RawString(), Signature: hex.EncodeToString(sig)}
+	// keep the signatures in the order of the keys
+	sigs := make([]MultisigPartialSignature, 0, len(ptx.Signatures)+1)
+	added := false
+	for _, s := range ptx.Signatures {
+		i := ptx.keyIndex(s.PublicKey)
+		if i == index {
+			continue
+		}
+		if i > index && !added {
+			sigs = append(sigs, partial)
+			added = true
+		}
+		sigs = append(sigs, s)
+	}
+	if !added {
+		sigs = append(sigs, partial)
+	}
+	ptx.Signatures = sigs
+	return nil
+}
+
+// Sign signs the proposal with the account of the keybase and adds the signature
+func (ptx *MultisigPartialTx) Sign(cdc *codec.Codec, keybase crkeys.Keybase, address sdk.Address, passphrase string) error {
+	if keybase == nil {
+		return errors.New("cant sign the multisig transaction: the keybase is nil")
+	}
+	signBz, err := ptx.SignBytes(cdc)
+	if err != nil {
+		return err
+	}
+	sig, pubKey, err := keybase.Sign(address, passphrase, signBz)
+	if err != nil {
+		return err
+	}
+	return ptx.AddSignature(cdc, pubKey, sig)
+}
+
+// Combine adds the signatures of the other partial transactions (of the same proposal) to the proposal
+func (ptx *MultisigPartialTx) Combine(cdc *codec.Codec, others ...MultisigPartialTx) error {
+	signBz, err := ptx.SignBytes(cdc)
+	if err != nil {
+		return err
+	}
+	for _, other := range others {
+		otherBz, err := other.SignBytes(cdc)
+		if err != nil {
+			return err
+		}
+		if string(signBz) != string(otherBz) || !reflect.DeepEqual(ptx.PublicKeys, other.PublicKeys) || ptx.LegacyCodec != other.LegacyCodec {
+			return errors.New("cant combine the multisig transactions: they are not the same transaction")
+		}
+		for _, s := range other.Signatures {
+			pk, err := crypto.NewPublicKey(s.PublicKey)
+			if err != nil {
+				return err
+			}
+			sig, err := hex.DecodeString(s.Signature)
+			if err != nil {
+				return err
+			}
+			if err := ptx.AddSignature(cdc, pk, sig); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Verify verifies the signatures of the proposal (e.g. after reading it from a file)
+func (ptx MultisigPartialTx) Verify(cdc *codec.Codec) error {
+	verified := ptx
+	verified.Signatures = []MultisigPartialSignature{}
+	return verified.Combine(cdc, ptx)
+}
+
+// Missing returns the keys that did not sign yet
+func (ptx MultisigPartialTx) Missing() []string {
+	missing := make([]string, 0)
+	for _, k := range ptx.PublicKeys {
+		if _, found := ptx.signature(k); !found {
+			missing = append(missing, k)
+		}
+	}
+	return missing
+}
+
+// IsComplete returns true if every key signed
+func (ptx MultisigPartialTx) IsComplete() bool {
+	return len(ptx.Missing()) == 0
+}
+
+// StdTx returns the std tx of the complete proposal, with the signatures in the order of the keys
+func (ptx MultisigPartialTx) StdTx(cdc *codec.Codec) (StdTx, error) {
+	if missing := ptx.Missing(); len(missing) != 0 {
+		return StdTx{}, fmt.Errorf("the multisig transaction is missing %d signature(s)", len(missing))
+	}
+	msg, err := ptx.GetMsg(cdc)
+	if err != nil {
+		return StdTx{}, err
+	}
+	pk, err := ptx.MultisigKey()
+	if err != nil {
+		return StdTx{}, err
+	}
+	ms := crypto.MultiSignature{Sigs: make([][]byte, len(ptx.PublicKeys))}
+	for i, k := range ptx.PublicKeys {
+		s, _ := ptx.signature(k)
+		if ms.Sigs[i], err = hex.DecodeString(s.Signature); err != nil {
+			return StdTx{}, err
+		}
+	}
+	return NewTx(msg, ptx.Fee, StdSignature{PublicKey: pk, Signature: ms.Marshal()}, ptx.Memo, ptx.Entropy).(StdTx), nil
+}
+
+// Encode returns the encoded std tx of the complete proposal
+func (ptx MultisigPartialTx) Encode(cdc *codec.Codec) ([]byte, error) {
+	tx, err := ptx.StdTx(cdc)
+	if err != nil {
+		return nil, err
+	}
+	if ptx.LegacyCodec {
+		return DefaultTxEncoder(cdc)(tx, 0)
+	}
+	return DefaultTxEncoder(cdc)(tx, -1)
+}
+
+func (ptx MultisigPartialTx) keyIndex(pubKey string) int {
+	for i, k := range ptx.PublicKeys {
+		if k == pubKey {
+			return i
+		}
+	}
+	return -1
+}
+
+func (ptx MultisigPartialTx) signature(pubKey string) (MultisigPartialSignature, bool) {
+	for _, s := range ptx.Signatures {
+		if s.PublicKey == pubKey {
+			return s, true
+		}
+	}
+	return MultisigPartialSignature{}, false
+}
@@ -308,6 +308,12 @@ func DefaultTxDecoder(cdc *codec.Codec) sdk.TxDecoder {
 		if err != nil {
 			return nil, sdk.ErrTxDecode("error decoding transaction: " + err.Error()).TraceSDK(err.Error())
 		}
+		// decode the msg as the binaries before its features until they are active
+		if msg, ok := tx.Msg.(sdk.FeatureMsg); ok {
+			if tx.Msg = msg.WithoutInactiveFeatures(blockHeight); tx.Msg == nil {
+				return nil, sdk.ErrTxDecode("error decoding transaction: the msg is not allowed before its upgrade")
+			}
+		}
 		return tx, nil
 	}
 }
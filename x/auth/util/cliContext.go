@@ -23,7 +23,7 @@ type CLIContext struct { // TODO consider module passing clicontext instead of n
 	Passphrase    string
 	Height        int64
 	BroadcastMode BroadcastType
-	PrivateKey    crypto.PrivateKey
+	PrivateKey    crypto.Signer // signs the transactions instead of the keybase if set
 }
 
 // NewCLIContext returns a new initialized CLIContext with parameters from the
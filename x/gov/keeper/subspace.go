@@ -138,7 +138,21 @@ func handleUpgradeAfterUpdate(ctx sdk.Ctx, aclKey string, paramValue interface{}
 	oldUpgrade := types.Upgrade{}
 	space.Get(ctx, []byte(paramKey), &oldUpgrade)
 	newUpgrade, ok := paramValue.(types.Upgrade)
-	newUpgrade.OldUpgradeHeight = oldUpgrade.GetHeight()
+	// an active feature can't be rescheduled
+	for key := range newUpgrade.FeatureHeights() {
+		if k.cdc.IsAfterFeatureUpgrade(key, ctx.BlockHeight()) {
+			return types.ErrInvalidUpgradeFeature(types.ModuleName, fmt.Errorf("the feature %s is already active", key)).Result()
+		}
+	}
+	if newUpgrade.UpgradeVersion() == types.FeatureUpgradeVersion {
+		// a feature upgrade only activates its features, the protocol upgrade stays the same
+		features := newUpgrade.Features
+		newUpgrade = oldUpgrade
+		newUpgrade.Features = features
+	} else {
+		newUpgrade.OldUpgradeHeight = oldUpgrade.GetHeight()
+	}
+	newUpgrade.Features = types.MergeFeatures(oldUpgrade.Features, newUpgrade.Features)
 
 	space.Set(ctx, []byte(paramKey), newUpgrade)
 	k.spaces[subspaceName] = space
@@ -164,6 +178,7 @@ func handleUpgradeAfterUpdate(ctx sdk.Ctx, aclKey string, paramValue interface{}
 		}
 		codec.UpgradeHeight = newUpgrade.Height
 		codec.OldUpgradeHeight = newUpgrade.OldUpgradeHeight
+		codec.UpgradeFeatureMap = newUpgrade.FeatureHeights()
 		ctx.EventManager().EmitEvent(sdk.NewEvent(
 			types.EventUpgrade,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/gov/types"
 	"github.com/stretchr/testify/assert"
@@ -37,3 +38,33 @@ func TestModifyParam(t *testing.T) {
 		),
 	)
 }
+
+func TestHandleFeatureUpgrade(t *testing.T) {
+	defer func(h, o int64, f map[string]int64) {
+		codec.UpgradeHeight, codec.OldUpgradeHeight, codec.UpgradeFeatureMap = h, o, f
+	}(codec.UpgradeHeight, codec.OldUpgradeHeight, codec.UpgradeFeatureMap)
+	var aclKey = types.NewACLKey(types.ModuleName, string(types.UpgradeKey))
+	ctx, k := createTestKeeperAndContext(t, false)
+	ctx = ctx.WithBlockHeight(30100)
+	owner := k.GetACL(ctx).GetOwner(aclKey)
+	res := k.HandleUpgrade(ctx, aclKey, types.NewUpgrade(30100, "6.0.0"), owner)
+	assert.Zero(t, res.Code)
+	// the feature upgrade keeps the upgrade height and version
+	res = k.HandleUpgrade(ctx, aclKey, types.NewFeatureUpgrade(30200, "KEY"), owner)
+	assert.Zero(t, res.Code)
+	assert.Equal(t, types.Upgrade{Height: 30100, Version: "6.0.0", Features: []string{"KEY:30200"}}, k.GetUpgrade(ctx))
+	assert.Equal(t, int64(30100), codec.UpgradeHeight)
+	assert.Equal(t, map[string]int64{"KEY": 30200}, codec.UpgradeFeatureMap)
+	assert.False(t, k.cdc.IsAfterFeatureUpgrade("KEY", 30199))
+	assert.True(t, k.cdc.IsAfterFeatureUpgrade("KEY", 30200))
+	// the next upgrades keep the features
+	res = k.HandleUpgrade(ctx, aclKey, types.NewUpgrade(30300, "6.1.0"), owner)
+	assert.Zero(t, res.Code)
+	assert.Equal(t, []string{"KEY:30200"}, k.GetUpgrade(ctx).Features)
+	assert.Equal(t, map[string]int64{"KEY": 30200}, codec.UpgradeFeatureMap)
+	// an active feature can't be rescheduled
+	ctx = ctx.WithBlockHeight(30200)
+	res = k.HandleUpgrade(ctx, aclKey, types.NewFeatureUpgrade(30400, "KEY"), owner)
+	assert.Equal(t, types.CodeInvalidUpgradeFeature, res.Code)
+	assert.Equal(t, []string{"KEY:30200"}, k.GetUpgrade(ctx).Features)
+}
@@ -18,6 +18,7 @@ const (
 	CodeZeroHeightUpgrade             sdk.CodeType = 9
 	CodeEmptyVersionUpgrade           sdk.CodeType = 10
 	CodeUnauthorizedHeightParamChange sdk.CodeType = 11
+	CodeInvalidUpgradeFeature         sdk.CodeType = 12
 )
 
 func ErrZeroHeightUpgrade(codespace sdk.CodespaceType) sdk.Error {
@@ -50,6 +51,10 @@ func ErrUnauthorizedHeightParamChange(codespace sdk.CodespaceType, height int64,
 		fmt.Sprintf("the param change is unathorized: Wait For Upgrade Height %v to change param %s", height, param))
 }
 
+func ErrInvalidUpgradeFeature(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidUpgradeFeature, "invalid upgrade feature: "+err.Error())
+}
+
 // ErrUnknownSubspace returns an unknown subspace error.
 func ErrUnknownSubspace(codespace sdk.CodespaceType, space string) sdk.Error {
 	return sdk.NewError(codespace, CodeUnknownSubspace, fmt.Sprintf("unknown subspace %s", space))
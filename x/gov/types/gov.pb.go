@@ -210,9 +210,10 @@ func (*MsgUpgrade) XXX_MessageName() string {
 }
 
 type Upgrade struct {
-	Height           int64  `protobuf:"varint,1,opt,name=height,proto3" json:"Height"`
-	Version          string `protobuf:"bytes,2,opt,name=version,proto3" json:"Version"`
-	OldUpgradeHeight int64  `protobuf:"varint,3,opt,name=oldUpgradeHeight,proto3" json:"OldUpgradeHeight,omitempty"`
+	Height           int64    `protobuf:"varint,1,opt,name=height,proto3" json:"Height"`
+	Version          string   `protobuf:"bytes,2,opt,name=version,proto3" json:"Version"`
+	OldUpgradeHeight int64    `protobuf:"varint,3,opt,name=oldUpgradeHeight,proto3" json:"OldUpgradeHeight,omitempty"`
+	Features         []string `protobuf:"bytes,4,rep,name=features,proto3" json:"Features,omitempty"`
 }
 
 func (m *Upgrade) Reset()         { *m = Upgrade{} }
@@ -269,6 +270,13 @@ func (m *Upgrade) GetOldUpgradeHeight() int64 {
 	return 0
 }
 
+func (m *Upgrade) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 type ACLPair struct {
 	Key  string                                            `protobuf:"bytes,1,opt,name=key,proto3" json:"acl_key"`
 	Addr github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=addr,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
@@ -332,42 +340,43 @@ func init() {
 func init() { proto.RegisterFile("x/gov/gov.proto", fileDescriptor_8366cfab811ef854) }
 
 var fileDescriptor_8366cfab811ef854 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x93, 0x90, 0x90, 0x97, 0xd2, 0xa0, 0x13, 0x43, 0x54, 0x09, 0x5f, 0x15, 0x09, 0xa9,
-	0x08, 0x6a, 0xf3, 0x63, 0x82, 0x89, 0x38, 0x20, 0x51, 0xa0, 0x6a, 0x65, 0x95, 0x0e, 0x59, 0xaa,
-	0x4b, 0x72, 0xbd, 0x58, 0x89, 0x7d, 0xd6, 0xf9, 0x12, 0x9a, 0x85, 0x99, 0x91, 0x3f, 0x01, 0xb1,
-	0xf1, 0x9f, 0x74, 0xac, 0x98, 0x10, 0x83, 0x85, 0x92, 0xcd, 0x7f, 0x02, 0x13, 0xba, 0xf3, 0xb9,
-	0x20, 0x60, 0xa8, 0xd4, 0x0e, 0xd6, 0x9d, 0xbe, 0xf7, 0xee, 0xfb, 0xde, 0xfb, 0xfc, 0xee, 0xa0,
-	0x75, 0xe2, 0x32, 0x3e, 0x57, 0x9f, 0x13, 0x0b, 0x2e, 0x39, 0xba, 0x76, 0xe2, 0x30, 0x3e, 0xdf,
-	0xb8, 0xc5, 0x38, 0xe3, 0x1a, 0x71, 0xd5, 0x2e, 0x0f, 0x76, 0xbe, 0x5a, 0xb0, 0xbe, 0x9b, 0xb0,
-	0xde, 0x98, 0x44, 0x8c, 0xee, 0x13, 0x41, 0x42, 0x34, 0x80, 0xe6, 0xb1, 0xe0, 0x61, 0x77, 0x34,
-	0x12, 0x34, 0x49, 0xda, 0xd6, 0xa6, 0xb5, 0xb5, 0xe6, 0x3d, 0xcb, 0x52, 0x5c, 0x27, 0x39, 0xf4,
-	0x33, 0xc5, 0x0f, 0x59, 0x20, 0xc7, 0xb3, 0x81, 0x33, 0xe4, 0xa1, 0x1b, 0xf3, 0x89, 0xdc, 0x8e,
-	0xa8, 0x7c, 0xc7, 0xc5, 0xc4, 0x8d, 0xf9, 0x70, 0x42, 0xe5, 0xf6, 0x90, 0x0b, 0xea, 0xca, 0x45,
-	0x4c, 0x13, 0xc7, 0xf0, 0xf8, 0x7f, 0x92, 0xa2, 0xbb, 0x70, 0x3d, 0x56, 0x62, 0xaf, 0xe9, 0xa2,
-	0x5d, 0xde, 0xb4, 0xb6, 0x1a, 0xde, 0x8d, 0x2c, 0xc5, 0x0d, 0x8d, 0x1d, 0x4d, 0xe8, 0xc2, 0x3f,
-	0x0f, 0xa3, 0x7b, 0x26, 0xf5, 0x90, 0x4c, 0xdb, 0x15, 0x5d, 0x4b, 0x2b, 0x4b, 0x71, 0x33, 0x4f,
-	0x9d, 0x93, 0xe9, 0x8c, 0xfa, 0xe7, 0x09, 0x4f, 0xab, 0x1f, 0x3e, 0x63, 0xab, 0xb3, 0x2c, 0xeb,
-	0xa6, 0x9e, 0x77, 0xf7, 0x0e, 0x04, 0x89, 0x92, 0x63, 0x2a, 0x10, 0xfb, 0x5f, 0x53, 0x2f, 0xb2,
-	0x14, 0xaf, 0x29, 0xf8, 0xe8, 0xea, 0x3a, 0x23, 0xd0, 0x90, 0xbc, 0x90, 0x29, 0x6b, 0x99, 0x5e,
-	0x96, 0x62, 0x90, 0xfc, 0x72, 0x22, 0xbf, 0x59, 0x51, 0x1f, 0x6a, 0x24, 0xe4, 0xb3, 0x48, 0x6a,
-	0x3f, 0x1a, 0x9e, 0x77, 0x9a, 0xe2, 0xd2, 0xf7, 0x14, 0x3f, 0xb8, 0x38, 0xab, 0x17, 0xb0, 0x9d,
-	0x48, 0x66, 0x29, 0x36, 0x4c, 0xbe, 0x59, 0x51, 0x07, 0x6a, 0x64, 0x28, 0x03, 0x1e, 0xb5, 0xab,
-	0x9a, 0x1b, 0x74, 0x8e, 0x46, 0x7c, 0xb3, 0x1a, 0x93, 0xbf, 0x58, 0x00, 0xbb, 0x09, 0x7b, 0x1b,
-	0x33, 0x41, 0x46, 0x14, 0xf5, 0xa1, 0x18, 0x8f, 0x2b, 0x9b, 0x98, 0xe2, 0x34, 0x7a, 0x02, 0xf5,
-	0x59, 0x2e, 0xa3, 0x1d, 0x6d, 0x3e, 0x5a, 0x77, 0xf4, 0x4c, 0x3b, 0x46, 0xdc, 0x6b, 0x29, 0x07,
-	0x94, 0x9e, 0x49, 0xf3, 0x8b, 0x8d, 0xa9, 0xf5, 0x93, 0x05, 0xf5, 0xa2, 0xd0, 0x0e, 0xd4, 0xc6,
-	0x34, 0x60, 0x63, 0xa9, 0xeb, 0xac, 0xe4, 0x1d, 0xbe, 0xd4, 0x88, 0x6f, 0x22, 0xe8, 0x0e, 0xd4,
-	0xe7, 0x54, 0x24, 0xca, 0x86, 0x7c, 0x3a, 0x9b, 0x8a, 0xfc, 0x30, 0x87, 0xfc, 0x22, 0x86, 0x5e,
-	0xc1, 0x4d, 0x3e, 0x1d, 0x19, 0xe2, 0x9c, 0x42, 0xff, 0x92, 0x8a, 0x67, 0x67, 0x29, 0xde, 0xd8,
-	0xfb, 0x2b, 0x76, 0x9f, 0x87, 0x81, 0xa4, 0x61, 0x2c, 0x17, 0xfe, 0x3f, 0xe7, 0x3a, 0xef, 0xa1,
-	0xde, 0xed, 0xbd, 0xd9, 0x27, 0x81, 0x40, 0xb7, 0xa1, 0x32, 0xa1, 0x0b, 0x5d, 0x9e, 0x51, 0x26,
-	0xc3, 0xa9, 0xbe, 0x15, 0x0a, 0x47, 0x07, 0x50, 0x55, 0xc6, 0x98, 0xe1, 0xba, 0xbc, 0xcd, 0x9a,
-	0xcd, 0xdb, 0x39, 0x5d, 0xda, 0xd6, 0xd9, 0xd2, 0xb6, 0x7e, 0x2c, 0x6d, 0xeb, 0xe3, 0xca, 0x2e,
-	0x9d, 0xad, 0xec, 0xd2, 0xb7, 0x95, 0x5d, 0xea, 0xbb, 0x17, 0xa1, 0xcc, 0x1f, 0x1d, 0x4d, 0x3c,
-	0xa8, 0xe9, 0xa7, 0xe5, 0xf1, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x45, 0xb8, 0x4a, 0x58, 0x8a,
-	0x04, 0x00, 0x00,
+	// 573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0x6e, 0xda, 0xfe, 0x9a, 0xd5, 0xdd, 0x6f, 0x43, 0x16, 0x42, 0xd1, 0x24, 0xe2, 0xaa, 0x12,
+	0x52, 0x11, 0x2c, 0x81, 0x71, 0x82, 0x13, 0x4d, 0x01, 0x31, 0x60, 0xda, 0x14, 0x8d, 0x1d, 0x7a,
+	0x99, 0xdc, 0xd6, 0x75, 0xa3, 0x36, 0x71, 0xe4, 0xb8, 0x65, 0xbd, 0x70, 0xe6, 0xc8, 0x67, 0xe0,
+	0xc6, 0x37, 0xd9, 0x71, 0x42, 0x1c, 0x10, 0x07, 0x0b, 0xb5, 0xb7, 0x7c, 0x04, 0x4e, 0x28, 0x8e,
+	0xdb, 0x8d, 0x3f, 0x87, 0x49, 0xdb, 0x21, 0xb2, 0xf5, 0xbc, 0xaf, 0x9f, 0xe7, 0x7d, 0x1f, 0xbf,
+	0x31, 0xd8, 0x3c, 0x71, 0x29, 0x9b, 0x66, 0x9f, 0x13, 0x73, 0x26, 0x18, 0xfc, 0xef, 0xc4, 0xa1,
+	0x6c, 0xba, 0x75, 0x93, 0x32, 0xca, 0x14, 0xe2, 0x66, 0xbb, 0x3c, 0xd8, 0xf8, 0x62, 0x80, 0x8d,
+	0xbd, 0x84, 0xb6, 0x87, 0x38, 0xa2, 0xe4, 0x00, 0x73, 0x1c, 0xc2, 0x2e, 0xa8, 0x0d, 0x38, 0x0b,
+	0x5b, 0xfd, 0x3e, 0x27, 0x49, 0x62, 0x19, 0x75, 0xa3, 0xb9, 0xee, 0x3d, 0x4d, 0x25, 0x32, 0x71,
+	0x0e, 0xfd, 0x94, 0xe8, 0x21, 0x0d, 0xc4, 0x70, 0xd2, 0x75, 0x7a, 0x2c, 0x74, 0x63, 0x36, 0x12,
+	0xdb, 0x11, 0x11, 0xef, 0x18, 0x1f, 0xb9, 0x31, 0xeb, 0x8d, 0x88, 0xd8, 0xee, 0x31, 0x4e, 0x5c,
+	0x31, 0x8b, 0x49, 0xe2, 0x68, 0x1e, 0xff, 0x22, 0x29, 0xbc, 0x0b, 0xd6, 0xe2, 0x4c, 0xec, 0x35,
+	0x99, 0x59, 0xc5, 0xba, 0xd1, 0xac, 0x7a, 0xff, 0xa7, 0x12, 0x55, 0x15, 0x76, 0x3c, 0x22, 0x33,
+	0x7f, 0x15, 0x86, 0xf7, 0x74, 0xea, 0x11, 0x1e, 0x5b, 0x25, 0x55, 0xcb, 0x66, 0x2a, 0x51, 0x2d,
+	0x4f, 0x9d, 0xe2, 0xf1, 0x84, 0xf8, 0xab, 0x84, 0x27, 0xe5, 0x0f, 0x9f, 0x90, 0xd1, 0x98, 0x17,
+	0x55, 0x53, 0xcf, 0x5a, 0xfb, 0x87, 0x1c, 0x47, 0xc9, 0x80, 0x70, 0x48, 0xff, 0xd5, 0xd4, 0xf3,
+	0x54, 0xa2, 0xf5, 0x0c, 0x3e, 0xbe, 0xbe, 0xce, 0x30, 0xa8, 0x0a, 0xb6, 0x94, 0x29, 0x2a, 0x99,
+	0x76, 0x2a, 0x11, 0x10, 0xec, 0x6a, 0x22, 0xe7, 0xac, 0xb0, 0x03, 0x2a, 0x38, 0x64, 0x93, 0x48,
+	0x28, 0x3f, 0xaa, 0x9e, 0x77, 0x2a, 0x51, 0xe1, 0xbb, 0x44, 0x0f, 0x2e, 0xcf, 0xea, 0x05, 0x74,
+	0x37, 0x12, 0xa9, 0x44, 0x9a, 0xc9, 0xd7, 0x2b, 0x6c, 0x80, 0x0a, 0xee, 0x89, 0x80, 0x45, 0x56,
+	0x59, 0x71, 0x03, 0x95, 0xa3, 0x10, 0x5f, 0xaf, 0xda, 0xe4, 0xcf, 0x06, 0x00, 0x7b, 0x09, 0x7d,
+	0x1b, 0x53, 0x8e, 0xfb, 0x04, 0x76, 0x80, 0x89, 0x7f, 0x33, 0xf7, 0xea, 0x13, 0xb3, 0x3c, 0x0d,
+	0x1f, 0x03, 0x73, 0x92, 0xcb, 0x28, 0x47, 0x6b, 0x3b, 0x1b, 0x8e, 0x9a, 0x69, 0x47, 0x8b, 0x7b,
+	0x9b, 0x99, 0x03, 0x99, 0x9e, 0x4e, 0xf3, 0x97, 0x1b, 0x5d, 0xeb, 0x57, 0x03, 0x98, 0xcb, 0x42,
+	0x1b, 0xa0, 0x32, 0x24, 0x01, 0x1d, 0x0a, 0x55, 0x67, 0x29, 0xef, 0xf0, 0xa5, 0x42, 0x7c, 0x1d,
+	0x81, 0x77, 0x80, 0x39, 0x25, 0x3c, 0xc9, 0x6c, 0xc8, 0xa7, 0xb3, 0x96, 0x91, 0x1f, 0xe5, 0x90,
+	0xbf, 0x8c, 0xc1, 0x57, 0xe0, 0x06, 0x1b, 0xf7, 0x35, 0x71, 0x4e, 0xa1, 0xae, 0xa4, 0xe4, 0xd9,
+	0xa9, 0x44, 0x5b, 0xfb, 0x7f, 0xc4, 0xee, 0xb3, 0x30, 0x10, 0x24, 0x8c, 0xc5, 0xcc, 0xff, 0xeb,
+	0x1c, 0xdc, 0x01, 0x6b, 0x03, 0x82, 0xc5, 0x84, 0x93, 0xc4, 0x2a, 0xd7, 0x4b, 0xcd, 0xaa, 0x77,
+	0x2b, 0x95, 0x08, 0xbe, 0xd0, 0xd8, 0x85, 0xb3, 0xab, 0xbc, 0xc6, 0x7b, 0x60, 0xb6, 0xda, 0x6f,
+	0x0e, 0x70, 0xc0, 0xe1, 0x6d, 0x50, 0x1a, 0x91, 0x99, 0x65, 0x9c, 0x57, 0x8b, 0x7b, 0x63, 0xf5,
+	0x27, 0x65, 0x38, 0x3c, 0x04, 0xe5, 0xcc, 0x4c, 0xab, 0x78, 0x4d, 0x57, 0xa3, 0xd8, 0xbc, 0xdd,
+	0xd3, 0xb9, 0x6d, 0x9c, 0xcd, 0x6d, 0xe3, 0xc7, 0xdc, 0x36, 0x3e, 0x2e, 0xec, 0xc2, 0xd9, 0xc2,
+	0x2e, 0x7c, 0x5b, 0xd8, 0x85, 0x8e, 0x7b, 0x19, 0xca, 0xfc, 0xa1, 0x52, 0xc4, 0xdd, 0x8a, 0x7a,
+	0x8e, 0x1e, 0xfd, 0x1a, 0x00, 0x82, 0xb5, 0x6d, 0xea, 0xbe, 0x04, 0x00, 0x00,
 }
 
 func (m *MsgChangeParam) Marshal() (dAtA []byte, err error) {
@@ -528,6 +537,15 @@ func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.OldUpgradeHeight != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.OldUpgradeHeight))
 		i--
@@ -671,6 +689,12 @@ func (m *Upgrade) Size() (n int) {
 	if m.OldUpgradeHeight != 0 {
 		n += 1 + sovGov(uint64(m.OldUpgradeHeight))
 	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1256,6 +1280,38 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
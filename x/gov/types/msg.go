@@ -167,5 +167,8 @@ func (msg MsgUpgrade) ValidateBasic() sdk.Error {
 	if msg.Upgrade.UpgradeVersion() == "" {
 		return ErrZeroHeightUpgrade(ModuleName)
 	}
+	if err := msg.Upgrade.ValidateFeatures(); err != nil {
+		return ErrInvalidUpgradeFeature(ModuleName, err)
+	}
 	return nil
 }
@@ -109,4 +109,13 @@ func TestMsgUpgrade_ValidateBasic(t *testing.T) {
 		},
 	}
 	assert.NotNil(t, m.ValidateBasic())
+	m = MsgUpgrade{
+		Address: getRandomValidatorAddress(),
+		Upgrade: NewFeatureUpgrade(100, "OADDR"),
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.Upgrade.Features = []string{"OADDR:0"}
+	assert.NotNil(t, m.ValidateBasic())
+	m.Upgrade.Features = nil
+	assert.NotNil(t, m.ValidateBasic())
 }
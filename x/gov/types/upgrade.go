@@ -1,8 +1,19 @@
 package types
 
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FeatureUpgradeVersion - the version of the upgrades that only activate features (the upgrade height and version are kept)
+const FeatureUpgradeVersion = "FEATURE"
+
 //type Upgrade struct {
 //	Height  int64  `json:"Height"`
 //	Version string `json:"Version"`
+//	Features []string `json:"Features"` // <key>:<height>
 //}
 
 func NewUpgrade(height int64, version string) Upgrade {
@@ -12,6 +23,15 @@ func NewUpgrade(height int64, version string) Upgrade {
 	}
 }
 
+// "NewFeatureUpgrade" - an upgrade activating the feature at the height
+func NewFeatureUpgrade(height int64, key string) Upgrade {
+	return Upgrade{
+		Height:   height,
+		Version:  FeatureUpgradeVersion,
+		Features: []string{NewFeature(key, height)},
+	}
+}
+
 func (u Upgrade) UpgradeHeight() int64 {
 	return u.Height
 }
@@ -19,3 +39,61 @@ func (u Upgrade) UpgradeHeight() int64 {
 func (u Upgrade) UpgradeVersion() string {
 	return u.Version
 }
+
+// "FeatureHeights" - the activation heights of the features by key
+func (u Upgrade) FeatureHeights() map[string]int64 {
+	heights := make(map[string]int64, len(u.Features))
+	for _, feature := range u.Features {
+		key, height, err := ParseFeature(feature)
+		if err != nil {
+			continue
+		}
+		heights[key] = height
+	}
+	return heights
+}
+
+// "ValidateFeatures" - validates the features of the upgrade
+func (u Upgrade) ValidateFeatures() error {
+	if u.Version == FeatureUpgradeVersion && len(u.Features) == 0 {
+		return fmt.Errorf("the %s upgrade has no features", FeatureUpgradeVersion)
+	}
+	for _, feature := range u.Features {
+		if _, _, err := ParseFeature(feature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// "NewFeature" - the feature of an upgrade: <key>:<height>
+func NewFeature(key string, height int64) string {
+	return fmt.Sprintf("%s:%d", key, height)
+}
+
+// "ParseFeature" - parses the feature of an upgrade: <key>:<height>
+func ParseFeature(feature string) (key string, height int64, err error) {
+	i := strings.LastIndex(feature, ":")
+	if i <= 0 {
+		return "", 0, fmt.Errorf("invalid feature %q: expected <key>:<height>", feature)
+	}
+	height, err = strconv.ParseInt(feature[i+1:], 10, 64)
+	if err != nil || height <= 0 {
+		return "", 0, fmt.Errorf("invalid height of the feature %q", feature)
+	}
+	return feature[:i], height, nil
+}
+
+// "MergeFeatures" - the features of the previous upgrade updated with the new ones (sorted by key)
+func MergeFeatures(previous, features []string) []string {
+	heights := Upgrade{Features: previous}.FeatureHeights()
+	for key, height := range (Upgrade{Features: features}).FeatureHeights() {
+		heights[key] = height
+	}
+	merged := make([]string, 0, len(heights))
+	for key, height := range heights {
+		merged = append(merged, NewFeature(key, height))
+	}
+	sort.Strings(merged)
+	return merged
+}
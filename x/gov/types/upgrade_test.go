@@ -0,0 +1,23 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFeature(t *testing.T) {
+	key, height, err := ParseFeature(NewFeature("OADDR", 100))
+	assert.Nil(t, err)
+	assert.Equal(t, "OADDR", key)
+	assert.Equal(t, int64(100), height)
+	for _, feature := range []string{"", "OADDR", ":100", "OADDR:", "OADDR:0", "OADDR:-1", "OADDR:height"} {
+		_, _, err := ParseFeature(feature)
+		assert.NotNil(t, err, feature)
+	}
+}
+
+func TestMergeFeatures(t *testing.T) {
+	assert.Equal(t, []string{}, MergeFeatures(nil, nil))
+	assert.Equal(t, []string{"A:10", "B:30", "C:40"}, MergeFeatures([]string{"B:20", "A:10"}, []string{"C:40", "B:30"}))
+}
@@ -36,6 +36,7 @@ func handleStake(ctx sdk.Ctx, msg types.MsgStake, k keeper.Keeper) sdk.Result {
 	addr := pk.Address()
 	// create validator object using the message fields
 	validator := types.NewValidator(sdk.Address(addr), pk, msg.Chains, msg.ServiceUrl, sdk.ZeroInt())
+	validator.OutputAddress = msg.OutputAddress
 	// check if they can stake
 	if err := k.ValidateValidatorStaking(ctx, validator, msg.Value); err != nil {
 		return err.Result()
@@ -70,6 +71,10 @@ func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginUnstake, k keeper.Keep
 	if !found {
 		return types.ErrNoValidatorFound(k.Codespace()).Result()
 	}
+	// the validator or its output address may unstake
+	if !validator.IsOwner(msg.GetSigner()) {
+		return types.ErrUnauthorizedSigner(k.Codespace()).Result()
+	}
 	if err := k.ValidateValidatorBeginUnstaking(ctx, validator); err != nil {
 		return err.Result()
 	}
@@ -81,12 +86,12 @@ func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginUnstake, k keeper.Keep
 		sdk.NewEvent(
 			types.EventTypeWaitingToBeginUnstaking,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.GetSigner().String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.GetSigner().String()),
 		),
 	})
 	return sdk.Result{Events: ctx.EventManager().Events()}
@@ -93,3 +93,23 @@ func Test_handleMsgUnjail(t *testing.T) {
 		})
 	}
 }
+
+func Test_handleMsgBeginUnstakeByOutputAddress(t *testing.T) {
+	context, _, k := createTestInput(t, true)
+	validator := getStakedValidator()
+	validator.OutputAddress = getRandomValidatorAddress()
+	k.SetValidator(context, validator)
+	// an unrelated signer can't unstake the validator
+	res := handleMsgBeginUnstake(context, types.MsgBeginUnstake{Address: validator.Address, Signer: getRandomValidatorAddress()}, k)
+	if res.Code != types.CodeUnauthorizedSigner {
+		t.Errorf("handleMsgBeginUnstake() code = %v, want %v", res.Code, types.CodeUnauthorizedSigner)
+	}
+	// the output address can
+	res = handleMsgBeginUnstake(context, types.MsgBeginUnstake{Address: validator.Address, Signer: validator.OutputAddress}, k)
+	if !res.IsOK() {
+		t.Errorf("handleMsgBeginUnstake() = %v, want OK", res.Log)
+	}
+	if !k.IsWaitingValidator(context, validator.Address) {
+		t.Errorf("the validator should be waiting to begin unstaking")
+	}
+}
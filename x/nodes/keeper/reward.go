@@ -8,11 +8,12 @@ import (
 )
 
 // RewardForRelays - Award coins to an address (will be called at the beginning of the next block)
+// the coins are sent to the output address of the validator (if any)
 func (k Keeper) RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Address) sdk.BigInt {
 	coins := k.RelaysToTokensMultiplier(ctx).Mul(relays)
 	toNode, toFeeCollector := k.NodeReward(ctx, coins)
 	if toNode.IsPositive() {
-		k.mint(ctx, toNode, address)
+		k.mint(ctx, toNode, k.outputAddress(ctx, address))
 	}
 	if toFeeCollector.IsPositive() {
 		k.mint(ctx, toFeeCollector, k.getFeePool(ctx).GetAddress())
@@ -44,12 +45,21 @@ func (k Keeper) blockReward(ctx sdk.Ctx, previousProposer sdk.Address) {
 	if err != nil {
 		ctx.Logger().Error(fmt.Sprintf("unable to send %s cut of block reward to the dao: %s, at height %d", daoCut.String(), err.Error(), ctx.BlockHeight()))
 	}
-	err = k.AccountKeeper.SendCoins(ctx, feeAddr, previousProposer, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, proposerCut)))
+	err = k.AccountKeeper.SendCoins(ctx, feeAddr, k.outputAddress(ctx, previousProposer), sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, proposerCut)))
 	if err != nil {
 		ctx.Logger().Error(fmt.Sprintf("unable to send %s cut of block reward to the proposer: %s, at height %d", proposerCut.String(), err.Error(), ctx.BlockHeight()))
 	}
 }
 
+// "outputAddress" - returns the address that receives the coins of the validator (the address itself if not a validator)
+func (k Keeper) outputAddress(ctx sdk.Ctx, address sdk.Address) sdk.Address {
+	validator, found := k.GetValidator(ctx, address)
+	if !found {
+		return address
+	}
+	return validator.GetOutputAddress()
+}
+
 // "mint" - takes an amount and mints it to the node staking pool, then sends the coins to the address
 func (k Keeper) mint(ctx sdk.Ctx, amount sdk.BigInt, address sdk.Address) sdk.Result {
 	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
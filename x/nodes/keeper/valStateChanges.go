@@ -7,6 +7,7 @@ import (
 	"github.com/tendermint/tendermint/libs/strings"
 	"time"
 
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/crypto"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -114,11 +115,19 @@ func (k Keeper) ValidateValidatorStaking(ctx sdk.Ctx, validator types.Validator,
 	if int64(len(validator.Chains)) > k.MaxChains(ctx) {
 		return types.ErrTooManyChains(types.ModuleName)
 	}
+	// the output address (non custodial staking) is only allowed after its feature upgrade
+	if !validator.OutputAddress.Empty() && !k.Cdc.IsAfterFeatureUpgrade(codec.OutputAddressFeatureKey, ctx.BlockHeight()) {
+		return types.ErrOutputAddressNotAllowed(k.codespace)
+	}
 	// check to see if teh public key has already been register for that validator
 	val, found := k.GetValidator(ctx, validator.Address)
 	if found {
 		// edit stake in 6.X upgrade
 		if ctx.IsAfterUpgradeHeight() && val.IsStaked() {
+			// the output address may be set once but never changed
+			if !val.OutputAddress.Empty() && !validator.OutputAddress.Empty() && !val.OutputAddress.Equals(validator.OutputAddress) {
+				return types.ErrOutputAddressEdit(k.codespace)
+			}
 			return k.ValidateEditStake(ctx, val, amount)
 		}
 		if !val.IsUnstaked() { // unstaking or already staked but before the upgrade
@@ -224,6 +233,10 @@ func (k Keeper) EditStakeValidator(ctx sdk.Ctx, currentValidator, updatedValidat
 	currentValidator.Chains = updatedValidator.Chains
 	// update service url
 	currentValidator.ServiceURL = updatedValidator.ServiceURL
+	// set the output address (if not already set)
+	if currentValidator.OutputAddress.Empty() {
+		currentValidator.OutputAddress = updatedValidator.OutputAddress
+	}
 	// delete the validator from the staking set
 	k.deleteValidatorFromStakingSet(ctx, origValForDeletion)
 	// delete the validator from each individual chains set
@@ -328,7 +341,7 @@ func (k Keeper) FinishUnstakingValidator(ctx sdk.Ctx, validator types.Validator)
 	k.deleteUnstakingValidator(ctx, validator)
 	// amount unstaked = stakedTokens
 	amount := validator.StakedTokens
-	// send the tokens from staking module account to validator account
+	// send the tokens from staking module account to validator (output) account
 	err := k.coinsFromStakedToUnstaked(ctx, validator)
 	if err != nil {
 		k.Logger(ctx).Error(err.Error())
@@ -450,3 +450,39 @@ func TestKeeper_WaitToBeginUnstakingValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_StakeWithOutputAddress(t *testing.T) {
+	defer func(f map[string]int64) { codec.UpgradeFeatureMap = f }(codec.UpgradeFeatureMap)
+	codec.UpgradeFeatureMap = map[string]int64{codec.OutputAddressFeatureKey: 30025}
+	context, _, keeper := createTestInput(t, true)
+	stakeAmount := sdk.NewInt(100000000000)
+	validator := getUnstakedValidator()
+	validator.StakedTokens = sdk.ZeroInt()
+	validator.OutputAddress = getRandomValidatorAddress()
+	// not allowed before the output address feature
+	assert.Equal(t, types.ErrOutputAddressNotAllowed("pos"), keeper.ValidateValidatorStaking(context, validator, stakeAmount))
+	context = context.WithBlockHeight(30025)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, validator.Address, stakeAmount)
+	assert.Nil(t, keeper.ValidateValidatorStaking(context, validator, stakeAmount))
+	assert.Nil(t, keeper.StakeValidator(context, validator, stakeAmount))
+	// the output address can't be changed
+	edited := validator
+	edited.OutputAddress = getRandomValidatorAddress()
+	assert.Equal(t, types.ErrOutputAddressEdit("pos"), keeper.ValidateValidatorStaking(context, edited, stakeAmount))
+	// an edit stake without output address keeps it
+	edited.OutputAddress = nil
+	assert.Nil(t, keeper.ValidateValidatorStaking(context, edited, stakeAmount))
+	assert.Nil(t, keeper.StakeValidator(context, edited, stakeAmount))
+	got, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.Equal(t, validator.OutputAddress, got.OutputAddress)
+	// the relay rewards go to the output address
+	reward := keeper.RewardForRelays(context, sdk.NewInt(100), validator.Address)
+	assert.Equal(t, reward, keeper.GetBalance(context, validator.OutputAddress))
+	assert.True(t, keeper.GetBalance(context, validator.Address).IsZero())
+	// the unstaked tokens go to the output address
+	keeper.FinishUnstakingValidator(context, got.UpdateStatus(sdk.Unstaking))
+	assert.Equal(t, reward.Add(stakeAmount), keeper.GetBalance(context, validator.OutputAddress))
+	assert.True(t, keeper.GetBalance(context, validator.Address).IsZero())
+}
@@ -33,6 +33,11 @@ const (
 	CodeTooManyChains            CodeType          = 120
 	CodeStateConvertError        CodeType          = 121
 	CodeMinimumEditStake         CodeType          = 122
+	CodeInvalidOutputAddress     CodeType          = 123
+	CodeUnauthorizedSigner       CodeType          = 124
+	CodeOutputAddressEdit        CodeType          = 125
+	CodeOutputAddressNotAllowed  CodeType          = 126
+	CodeInvalidSigner            CodeType          = 127
 )
 
 func ErrTooManyChains(codespace sdk.CodespaceType) sdk.Error {
@@ -75,6 +80,26 @@ func ErrMinimumStake(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeMinimumStake, "validator isn't staking above the minimum")
 }
 
+func ErrInvalidOutputAddress(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidOutputAddress, "the output address is not valid")
+}
+
+func ErrOutputAddressNotAllowed(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeOutputAddressNotAllowed, "the output address is not allowed before the upgrade")
+}
+
+func ErrInvalidSigner(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSigner, "the signer address is not valid")
+}
+
+func ErrUnauthorizedSigner(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorizedSigner, "the signer is neither the validator nor its output address")
+}
+
+func ErrOutputAddressEdit(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeOutputAddressEdit, "the output address of the validator cannot be changed")
+}
+
 func ErrMinimumEditStake(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeMinimumEditStake, "validator must edit stake with a stake greater than or equal to current stake")
 }
@@ -13,6 +13,8 @@ var (
 	_ sdk.ProtoMsg = &MsgUnjail{}
 	_ sdk.ProtoMsg = &MsgSend{}
 	_ sdk.ProtoMsg = &MsgStake{}
+	_ sdk.FeatureMsg = MsgBeginUnstake{}
+	_ sdk.FeatureMsg = MsgStake{}
 )
 
 const (
@@ -25,7 +27,11 @@ const (
 //----------------------------------------------------------------------------------------------------------------------
 
 // GetSigners return address(es) that must sign over msg.GetSignBytes()
+// the unstake may be signed by the output address of the validator
 func (msg MsgBeginUnstake) GetSigner() sdk.Address {
+	if !msg.Signer.Empty() {
+		return msg.Signer
+	}
 	return msg.Address
 }
 
@@ -44,9 +50,20 @@ func (msg MsgBeginUnstake) ValidateBasic() sdk.Error {
 	if msg.Address.Empty() {
 		return ErrNilValidatorAddr(DefaultCodespace)
 	}
+	if !msg.Signer.Empty() && len(msg.Signer) != sdk.AddrLen {
+		return ErrInvalidSigner(DefaultCodespace)
+	}
 	return nil
 }
 
+// WithoutInactiveFeatures drops the signer before the output address feature
+func (msg MsgBeginUnstake) WithoutInactiveFeatures(height int64) sdk.ProtoMsg {
+	if !ModuleCdc.IsAfterFeatureUpgrade(codec.OutputAddressFeatureKey, height) {
+		msg.Signer = nil
+	}
+	return &msg
+}
+
 // Route provides router key for msg
 func (msg MsgBeginUnstake) Route() string { return RouterKey }
 
@@ -141,10 +158,11 @@ var _ codec.ProtoMarshaler = &MsgStake{}
 
 // MsgStake - struct for staking transactions
 type MsgStake struct {
-	PublicKey  crypto.PublicKey `json:"public_key" yaml:"public_key"`
-	Chains     []string         `json:"chains" yaml:"chains"`
-	Value      sdk.BigInt       `json:"value" yaml:"value"`
-	ServiceUrl string           `json:"service_url" yaml:"service_url"`
+	PublicKey     crypto.PublicKey `json:"public_key" yaml:"public_key"`
+	Chains        []string         `json:"chains" yaml:"chains"`
+	Value         sdk.BigInt       `json:"value" yaml:"value"`
+	ServiceUrl    string           `json:"service_url" yaml:"service_url"`
+	OutputAddress sdk.Address      `json:"output_address,omitempty" yaml:"output_address"` // optional, receives the rewards and the unstaked tokens
 }
 
 func (msg *MsgStake) Marshal() ([]byte, error) {
@@ -178,10 +196,11 @@ func (msg *MsgStake) Unmarshal(data []byte) error {
 		return err
 	}
 	newMsg := MsgStake{
-		PublicKey:  pk,
-		Chains:     m.Chains,
-		Value:      m.Value,
-		ServiceUrl: m.ServiceUrl,
+		PublicKey:     pk,
+		Chains:        m.Chains,
+		Value:         m.Value,
+		ServiceUrl:    m.ServiceUrl,
+		OutputAddress: m.OutputAddress,
 	}
 	*msg = newMsg
 	return nil
@@ -223,9 +242,20 @@ func (msg MsgStake) ValidateBasic() sdk.Error {
 	if err := ValidateServiceURL(msg.ServiceUrl); err != nil {
 		return err
 	}
+	if !msg.OutputAddress.Empty() && len(msg.OutputAddress) != sdk.AddrLen {
+		return ErrInvalidOutputAddress(DefaultCodespace)
+	}
 	return nil
 }
 
+// WithoutInactiveFeatures drops the output address before its feature
+func (msg MsgStake) WithoutInactiveFeatures(height int64) sdk.ProtoMsg {
+	if !ModuleCdc.IsAfterFeatureUpgrade(codec.OutputAddressFeatureKey, height) {
+		msg.OutputAddress = nil
+	}
+	return &msg
+}
+
 // Route provides router key for msg
 func (msg MsgStake) Route() string { return RouterKey }
 
@@ -246,6 +276,9 @@ func (msg *MsgStake) XXX_MessageName() string {
 }
 
 func (msg MsgStake) String() string {
+	if !msg.OutputAddress.Empty() {
+		return fmt.Sprintf("Public Key: %s\nChains: %s\nValue: %s\nOutput Address: %s\n", msg.PublicKey.RawString(), msg.Chains, msg.Value.String(), msg.OutputAddress)
+	}
 	return fmt.Sprintf("Public Key: %s\nChains: %s\nValue: %s\n", msg.PublicKey.RawString(), msg.Chains, msg.Value.String())
 }
 
@@ -261,10 +294,11 @@ func (msg MsgStake) ToProto() MsgProtoStake {
 		pkbz = msg.PublicKey.RawBytes()
 	}
 	return MsgProtoStake{
-		Publickey:  pkbz,
-		Chains:     msg.Chains,
-		Value:      msg.Value,
-		ServiceUrl: msg.ServiceUrl,
+		Publickey:     pkbz,
+		Chains:        msg.Chains,
+		Value:         msg.Value,
+		ServiceUrl:    msg.ServiceUrl,
+		OutputAddress: msg.OutputAddress,
 	}
 }
 
@@ -26,10 +26,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgProtoStake struct {
-	Publickey  []byte                                           `protobuf:"bytes,1,opt,name=Publickey,proto3" json:"public_key" yaml:"public_key"`
-	Chains     []string                                         `protobuf:"bytes,2,rep,name=Chains,proto3" json:"chains" yaml:"chains"`
-	Value      github_com_pokt_network_pocket_core_types.BigInt `protobuf:"bytes,3,opt,name=value,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"value" yaml:"value"`
-	ServiceUrl string                                           `protobuf:"bytes,4,opt,name=ServiceUrl,proto3" json:"service_url" yaml:"service_url"`
+	Publickey     []byte                                            `protobuf:"bytes,1,opt,name=Publickey,proto3" json:"public_key" yaml:"public_key"`
+	Chains        []string                                          `protobuf:"bytes,2,rep,name=Chains,proto3" json:"chains" yaml:"chains"`
+	Value         github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,3,opt,name=value,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"value" yaml:"value"`
+	ServiceUrl    string                                            `protobuf:"bytes,4,opt,name=ServiceUrl,proto3" json:"service_url" yaml:"service_url"`
+	OutputAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,5,opt,name=OutputAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"output_address,omitempty" yaml:"output_address"`
 }
 
 func (m *MsgProtoStake) Reset()         { *m = MsgProtoStake{} }
@@ -71,6 +72,7 @@ func (*MsgProtoStake) XXX_MessageName() string {
 
 type MsgBeginUnstake struct {
 	Address github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=Address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"validator_address" yaml:"validator_address"`
+	Signer  github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=Signer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"signer_address,omitempty" yaml:"signer_address"`
 }
 
 func (m *MsgBeginUnstake) Reset()         { *m = MsgBeginUnstake{} }
@@ -217,42 +219,46 @@ func init() {
 func init() { proto.RegisterFile("x/nodes/msg.proto", fileDescriptor_0de9b62fa75e413f) }
 
 var fileDescriptor_0de9b62fa75e413f = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xbf, 0x8b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x39, 0x4d, 0xc8, 0x5c, 0xa2, 0x66, 0xb5, 0x08, 0x0a, 0x3b, 0x61, 0x45, 0x48,
-	0x73, 0x59, 0xe5, 0xba, 0x74, 0xb7, 0xa2, 0x20, 0x12, 0x38, 0x37, 0x46, 0x44, 0x84, 0xb0, 0xd9,
-	0xcc, 0xcd, 0xad, 0xfb, 0x63, 0xc2, 0xce, 0x24, 0x26, 0x8d, 0x58, 0x5e, 0x69, 0xa7, 0x65, 0xb0,
-	0xf0, 0x6f, 0x39, 0xbb, 0x2b, 0xc5, 0x62, 0x90, 0xa4, 0x91, 0x2d, 0xb7, 0x14, 0x0b, 0xc9, 0xce,
-	0xc6, 0xdd, 0xc0, 0x15, 0xc7, 0x5d, 0xb7, 0xef, 0xfb, 0xf6, 0xcd, 0xe7, 0x3b, 0x5f, 0x78, 0x03,
-	0xeb, 0x33, 0x3d, 0xa0, 0x23, 0xcc, 0x74, 0x9f, 0x91, 0xf6, 0x38, 0xa4, 0x9c, 0x2a, 0xe5, 0x59,
-	0x3b, 0x91, 0xee, 0xde, 0x21, 0x94, 0xd0, 0x44, 0xd3, 0xd7, 0x5f, 0xb2, 0xad, 0x7d, 0x2f, 0xc2,
-	0x5a, 0x97, 0x91, 0xc3, 0x75, 0xd1, 0xe3, 0x96, 0x8b, 0x95, 0x03, 0x58, 0x39, 0x9c, 0x0c, 0x3d,
-	0xc7, 0x76, 0xf1, 0xbc, 0x01, 0x9a, 0xa0, 0x55, 0x35, 0xee, 0x47, 0x02, 0xc1, 0x71, 0x22, 0x0e,
-	0x5c, 0x3c, 0x8f, 0x05, 0xaa, 0xcf, 0x2d, 0xdf, 0xeb, 0x68, 0x99, 0xa6, 0x99, 0xd9, 0x94, 0xb2,
-	0x0f, 0x4b, 0x8f, 0x8f, 0x2d, 0x27, 0x60, 0x8d, 0x62, 0x73, 0xa7, 0x55, 0x31, 0xee, 0x45, 0x02,
-	0x95, 0xec, 0x44, 0x89, 0x05, 0xaa, 0xc9, 0x59, 0x59, 0x6b, 0x66, 0xfa, 0xab, 0x42, 0xe0, 0xf5,
-	0xa9, 0xe5, 0x4d, 0x70, 0x63, 0xa7, 0x09, 0x5a, 0x15, 0xe3, 0xc5, 0xa9, 0x40, 0x85, 0x9f, 0x02,
-	0x3d, 0x24, 0x0e, 0x3f, 0x9e, 0x0c, 0xdb, 0x36, 0xf5, 0xf5, 0x31, 0x75, 0xf9, 0x5e, 0x80, 0xf9,
-	0x7b, 0x1a, 0xba, 0xfa, 0x98, 0xda, 0x2e, 0xe6, 0x7b, 0x36, 0x0d, 0xb1, 0xce, 0xe7, 0x63, 0xcc,
-	0xda, 0x86, 0x43, 0x9e, 0x05, 0x3c, 0x12, 0x48, 0x1e, 0x14, 0x0b, 0x54, 0x95, 0xa8, 0xa4, 0xd4,
-	0x4c, 0x29, 0x2b, 0x4f, 0x20, 0xec, 0xe1, 0x70, 0xea, 0xd8, 0xb8, 0x1f, 0x7a, 0x8d, 0x6b, 0x09,
-	0xed, 0x41, 0x24, 0xd0, 0x2e, 0x93, 0xea, 0x60, 0x12, 0x7a, 0xb1, 0x40, 0x8a, 0x9c, 0xcd, 0x89,
-	0x9a, 0x99, 0x1b, 0xec, 0xdc, 0x3a, 0x59, 0xa0, 0xc2, 0x97, 0x05, 0x02, 0xbf, 0x17, 0x08, 0x9c,
-	0x7c, 0x45, 0x40, 0xfb, 0x06, 0xe0, 0xcd, 0x2e, 0x23, 0x06, 0x26, 0x4e, 0xd0, 0x0f, 0x58, 0x92,
-	0xe6, 0x47, 0x00, 0xcb, 0x07, 0xa3, 0x51, 0x88, 0x19, 0x4b, 0xc3, 0x3c, 0x8a, 0x04, 0xaa, 0x4f,
-	0x2d, 0xcf, 0x19, 0x59, 0x9c, 0x86, 0x03, 0x4b, 0x36, 0x63, 0x81, 0x1a, 0xff, 0xcd, 0x6e, 0xb7,
-	0xb4, 0x3f, 0x02, 0x3d, 0xba, 0x78, 0x0a, 0x29, 0xcd, 0xdc, 0x60, 0xcf, 0x31, 0xfa, 0x19, 0xc0,
-	0x4a, 0x97, 0x91, 0x7e, 0xf0, 0xce, 0x72, 0x3c, 0x65, 0x06, 0x6b, 0xaf, 0x36, 0xcc, 0xf5, 0x4c,
-	0xea, 0xd3, 0x8c, 0x04, 0x2a, 0x67, 0xee, 0x6e, 0x48, 0x77, 0x57, 0xf3, 0xb4, 0x0d, 0x3a, 0xc7,
-	0xd9, 0xdf, 0x22, 0x2c, 0x77, 0x19, 0xe9, 0xe1, 0x60, 0xa4, 0x7c, 0x80, 0xbb, 0x4f, 0x43, 0xea,
-	0x6f, 0xa7, 0xf7, 0x36, 0x12, 0xa8, 0x7a, 0x14, 0x52, 0x3f, 0x17, 0xdc, 0x6d, 0x69, 0x2d, 0xaf,
-	0x5e, 0xd2, 0x5f, 0x1e, 0xa8, 0x4c, 0x61, 0xe5, 0x25, 0xdd, 0xd0, 0x8b, 0x09, 0xfd, 0xf5, 0x7a,
-	0x11, 0x38, 0xcd, 0xb1, 0xd3, 0x45, 0xc8, 0xb4, 0x4b, 0x92, 0x33, 0x94, 0xe2, 0xc2, 0x92, 0xe5,
-	0xd3, 0x49, 0xc0, 0xd3, 0x4d, 0xe8, 0x5d, 0x61, 0x13, 0xd2, 0x93, 0xb2, 0xad, 0x93, 0xb5, 0x66,
-	0xa6, 0x8d, 0x4e, 0x35, 0x1f, 0xbf, 0xf1, 0xfc, 0x74, 0xa9, 0x82, 0xb3, 0xa5, 0x0a, 0x7e, 0x2d,
-	0x55, 0xf0, 0x69, 0xa5, 0x16, 0xce, 0x56, 0x6a, 0xe1, 0xc7, 0x4a, 0x2d, 0xbc, 0xb9, 0xd0, 0x95,
-	0x36, 0xaf, 0x4f, 0x62, 0x62, 0x58, 0x4a, 0x5e, 0x98, 0xfd, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x2b, 0x08, 0xf7, 0xa5, 0x95, 0x04, 0x00, 0x00,
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6b, 0x13, 0x41,
+	0x18, 0xcd, 0xa4, 0x36, 0x21, 0xd3, 0x44, 0xed, 0xa8, 0xb0, 0x28, 0x64, 0xca, 0x8a, 0xd0, 0x83,
+	0x4d, 0x94, 0xde, 0x7a, 0x6b, 0x44, 0x41, 0x24, 0x58, 0x37, 0x56, 0x44, 0x84, 0xb2, 0x4d, 0xa6,
+	0xd3, 0xed, 0xfe, 0x98, 0x65, 0x67, 0x36, 0x36, 0x17, 0x11, 0x4f, 0x3d, 0xf6, 0xa6, 0xc7, 0xe2,
+	0xd5, 0x7f, 0xa4, 0xc7, 0x1e, 0x45, 0x64, 0x90, 0xf6, 0x22, 0x7b, 0xcc, 0x51, 0x3c, 0x48, 0x66,
+	0x36, 0xee, 0x2e, 0x14, 0x29, 0xcd, 0x6d, 0xbe, 0xf7, 0xcd, 0x37, 0xef, 0xcd, 0xfb, 0xe0, 0xc1,
+	0xc5, 0xfd, 0x76, 0xc0, 0x06, 0x84, 0xb7, 0x7d, 0x4e, 0x5b, 0x61, 0xc4, 0x04, 0x43, 0xd5, 0xfd,
+	0x96, 0x82, 0x6e, 0xdf, 0xa4, 0x8c, 0x32, 0x85, 0xb5, 0x27, 0x27, 0xdd, 0x36, 0x7f, 0xcc, 0xc1,
+	0x46, 0x97, 0xd3, 0x8d, 0x49, 0xd1, 0x13, 0xb6, 0x4b, 0xd0, 0x3a, 0xac, 0x6d, 0xc4, 0xdb, 0x9e,
+	0xd3, 0x77, 0xc9, 0xc8, 0x00, 0x4b, 0x60, 0xb9, 0xde, 0xb9, 0x9b, 0x48, 0x0c, 0x43, 0x05, 0x6e,
+	0xb9, 0x64, 0x34, 0x96, 0x78, 0x71, 0x64, 0xfb, 0xde, 0x9a, 0x99, 0x61, 0xa6, 0x95, 0x4d, 0xa1,
+	0x55, 0x58, 0x79, 0xb4, 0x6b, 0x3b, 0x01, 0x37, 0xca, 0x4b, 0x73, 0xcb, 0xb5, 0xce, 0x9d, 0x44,
+	0xe2, 0x4a, 0x5f, 0x21, 0x63, 0x89, 0x1b, 0x7a, 0x56, 0xd7, 0xa6, 0x95, 0x5e, 0x45, 0x14, 0xce,
+	0x0f, 0x6d, 0x2f, 0x26, 0xc6, 0xdc, 0x12, 0x58, 0xae, 0x75, 0x5e, 0x1c, 0x4b, 0x5c, 0xfa, 0x2e,
+	0xf1, 0x03, 0xea, 0x88, 0xdd, 0x78, 0xbb, 0xd5, 0x67, 0x7e, 0x3b, 0x64, 0xae, 0x58, 0x09, 0x88,
+	0x78, 0xc7, 0x22, 0xb7, 0x1d, 0xb2, 0xbe, 0x4b, 0xc4, 0x4a, 0x9f, 0x45, 0xa4, 0x2d, 0x46, 0x21,
+	0xe1, 0xad, 0x8e, 0x43, 0x9f, 0x06, 0x22, 0x91, 0x58, 0x3f, 0x34, 0x96, 0xb8, 0xae, 0xa9, 0x54,
+	0x69, 0x5a, 0x1a, 0x46, 0x8f, 0x21, 0xec, 0x91, 0x68, 0xe8, 0xf4, 0xc9, 0x66, 0xe4, 0x19, 0x57,
+	0x14, 0xdb, 0xbd, 0x44, 0xe2, 0x05, 0xae, 0xd1, 0xad, 0x38, 0xf2, 0xc6, 0x12, 0x23, 0x3d, 0x9b,
+	0x03, 0x4d, 0x2b, 0x37, 0x88, 0x0e, 0x01, 0x6c, 0x3c, 0x8f, 0x45, 0x18, 0x8b, 0xf5, 0xc1, 0x20,
+	0x22, 0x9c, 0x1b, 0xf3, 0xca, 0xac, 0xbd, 0x44, 0x62, 0x83, 0xa9, 0xc6, 0x96, 0xad, 0x3b, 0xf7,
+	0x99, 0xef, 0x08, 0xe2, 0x87, 0x62, 0x62, 0xdd, 0x2d, 0xfd, 0x6e, 0xf1, 0x86, 0xf9, 0x5b, 0xe2,
+	0x87, 0x17, 0xff, 0x69, 0xca, 0x68, 0x15, 0x05, 0xac, 0x5d, 0x3f, 0x38, 0xc2, 0xa5, 0xcf, 0x47,
+	0x18, 0xfc, 0x3a, 0xc2, 0xe0, 0xe0, 0x0b, 0x06, 0xe6, 0xd7, 0x32, 0xbc, 0xd6, 0xe5, 0xb4, 0x43,
+	0xa8, 0x13, 0x6c, 0x06, 0x5c, 0x2d, 0xf8, 0x03, 0x80, 0xd5, 0xa9, 0x64, 0xbd, 0xdf, 0x9d, 0x44,
+	0xe2, 0xc5, 0xa1, 0xed, 0x39, 0x03, 0x5b, 0xb0, 0x68, 0xaa, 0x69, 0x2c, 0xb1, 0xf1, 0xcf, 0xbf,
+	0x62, 0xeb, 0x92, 0x72, 0xa7, 0xb4, 0xe8, 0x23, 0x80, 0x95, 0x9e, 0x43, 0x03, 0x12, 0x19, 0xe5,
+	0xcc, 0x34, 0xae, 0x90, 0xff, 0x99, 0x56, 0xbc, 0x71, 0x49, 0x15, 0x29, 0xf3, 0x39, 0x6e, 0x7d,
+	0x02, 0xb0, 0xd6, 0xe5, 0x74, 0x33, 0xd8, 0xb3, 0x1d, 0x0f, 0xed, 0xc3, 0xc6, 0xab, 0xe9, 0xc7,
+	0x27, 0xb3, 0xa9, 0x59, 0x56, 0x22, 0x71, 0x35, 0xb3, 0xe8, 0xaa, 0x56, 0x36, 0xe3, 0x1e, 0x0b,
+	0x44, 0xe7, 0x28, 0xfb, 0x53, 0x86, 0xd5, 0x2e, 0xa7, 0x3d, 0x12, 0x0c, 0xd0, 0x7b, 0xb8, 0xf0,
+	0x24, 0x62, 0x7e, 0x71, 0x85, 0x6f, 0x13, 0x89, 0xeb, 0x3b, 0x11, 0xf3, 0x73, 0xdb, 0xbb, 0xa1,
+	0xa5, 0xe5, 0xd1, 0x4b, 0xea, 0xcb, 0x13, 0xa2, 0x21, 0xac, 0xbd, 0x64, 0x53, 0x76, 0xbd, 0xbe,
+	0xd7, 0x93, 0x80, 0x10, 0x2c, 0xc7, 0x9d, 0x06, 0x84, 0x60, 0x33, 0x32, 0x67, 0x54, 0xc8, 0x85,
+	0x15, 0xdb, 0x67, 0x71, 0x20, 0xd2, 0x84, 0xe8, 0xcd, 0x90, 0x10, 0xe9, 0x4b, 0x59, 0x1a, 0xe9,
+	0xda, 0xb4, 0xd2, 0xc6, 0x5a, 0x3d, 0x6f, 0x7f, 0xe7, 0xd9, 0xf1, 0x69, 0x13, 0x9c, 0x9c, 0x36,
+	0xc1, 0xcf, 0xd3, 0x26, 0x38, 0x3c, 0x6b, 0x96, 0x4e, 0xce, 0x9a, 0xa5, 0x6f, 0x67, 0xcd, 0xd2,
+	0x9b, 0x0b, 0x7d, 0x69, 0x9a, 0xca, 0x4a, 0xc4, 0x76, 0x45, 0x25, 0xef, 0xea, 0xdf, 0x01, 0x00,
+	0x70, 0x3c, 0x9b, 0xbd, 0xad, 0x05, 0x00, 0x00,
 }
 
 func (this *MsgProtoStake) Equal(that interface{}) bool {
@@ -291,6 +297,9 @@ func (this *MsgProtoStake) Equal(that interface{}) bool {
 	if this.ServiceUrl != that1.ServiceUrl {
 		return false
 	}
+	if !bytes.Equal(this.OutputAddress, that1.OutputAddress) {
+		return false
+	}
 	return true
 }
 func (this *MsgBeginUnstake) Equal(that interface{}) bool {
@@ -315,6 +324,9 @@ func (this *MsgBeginUnstake) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Address, that1.Address) {
 		return false
 	}
+	if !bytes.Equal(this.Signer, that1.Signer) {
+		return false
+	}
 	return true
 }
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -391,6 +403,13 @@ func (m *MsgProtoStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OutputAddress) > 0 {
+		i -= len(m.OutputAddress)
+		copy(dAtA[i:], m.OutputAddress)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.OutputAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.ServiceUrl) > 0 {
 		i -= len(m.ServiceUrl)
 		copy(dAtA[i:], m.ServiceUrl)
@@ -447,6 +466,13 @@ func (m *MsgBeginUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -567,6 +593,10 @@ func (m *MsgProtoStake) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsg(uint64(l))
 	}
+	l = len(m.OutputAddress)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
 	return n
 }
 
@@ -580,6 +610,10 @@ func (m *MsgBeginUnstake) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsg(uint64(l))
 	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
 	return n
 }
 
@@ -782,6 +816,40 @@ func (m *MsgProtoStake) Unmarshal(dAtA []byte) error {
 			}
 			m.ServiceUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputAddress = append(m.OutputAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OutputAddress == nil {
+				m.OutputAddress = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsg(dAtA[iNdEx:])
@@ -869,6 +937,40 @@ func (m *MsgBeginUnstake) Unmarshal(dAtA []byte) error {
 				m.Address = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsg(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 )
@@ -778,3 +779,87 @@ func TestMsgUnjail_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgBeginUnstake_OutputSigner(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	_, _ = rand.Read(pub[:])
+	va := sdk.Address(pub.Address())
+	_, _ = rand.Read(pub[:])
+	output := sdk.Address(pub.Address())
+	msg := MsgBeginUnstake{Address: va, Signer: output}
+	if got := msg.GetSigner(); !reflect.DeepEqual(got, output) {
+		t.Errorf("GetSigner() = %v, want %v", got, output)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		t.Errorf("ValidateBasic() = %v", err)
+	}
+	msg.Signer = sdk.Address("short")
+	if err := msg.ValidateBasic(); !reflect.DeepEqual(err, ErrInvalidSigner(DefaultCodespace)) {
+		t.Errorf("ValidateBasic() = %v, want %v", err, ErrInvalidSigner(DefaultCodespace))
+	}
+	// the sign bytes of a msg without signer are unchanged
+	legacy := MsgBeginUnstake{Address: va}
+	if got := string(legacy.GetSignBytes()); got != fmt.Sprintf(`{"type":"pos/MsgBeginUnstake","value":{"validator_address":"%s"}}`, va) {
+		t.Errorf("GetSignBytes() = %s", got)
+	}
+}
+
+func TestMsgStake_OutputAddress(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	_, _ = rand.Read(pub[:])
+	msg := MsgStake{
+		PublicKey:  pub,
+		Chains:     []string{"0001"},
+		Value:      sdk.NewInt(10),
+		ServiceUrl: "https://www.pokt.network:443",
+	}
+	// the output address is omitted from the sign bytes when empty
+	bz := msg.GetSignBytes()
+	msg.OutputAddress = sdk.Address(pub.Address())
+	if reflect.DeepEqual(bz, msg.GetSignBytes()) {
+		t.Errorf("GetSignBytes() should include the output address")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		t.Errorf("ValidateBasic() = %v", err)
+	}
+	// proto round trip
+	p, err := msg.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got MsgStake
+	if err := got.Unmarshal(p); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.OutputAddress, msg.OutputAddress) {
+		t.Errorf("Unmarshal() output address = %v, want %v", got.OutputAddress, msg.OutputAddress)
+	}
+	msg.OutputAddress = sdk.Address("short")
+	if err := msg.ValidateBasic(); !reflect.DeepEqual(err, ErrInvalidOutputAddress(DefaultCodespace)) {
+		t.Errorf("ValidateBasic() = %v, want %v", err, ErrInvalidOutputAddress(DefaultCodespace))
+	}
+}
+
+func TestMsg_WithoutInactiveFeatures(t *testing.T) {
+	defer func(f map[string]int64) { codec.UpgradeFeatureMap = f }(codec.UpgradeFeatureMap)
+	codec.UpgradeFeatureMap = map[string]int64{codec.OutputAddressFeatureKey: 100}
+	var pub crypto.Ed25519PublicKey
+	_, _ = rand.Read(pub[:])
+	output := sdk.Address(pub.Address())
+	unstake := MsgBeginUnstake{Address: sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address()), Signer: output}
+	stake := MsgStake{PublicKey: pub, Chains: []string{"0001"}, Value: sdk.NewInt(10), OutputAddress: output}
+	// the fields are dropped before the feature, as the binaries before it do
+	if got := unstake.WithoutInactiveFeatures(99).(*MsgBeginUnstake); !got.Signer.Empty() || !got.Address.Equals(unstake.Address) {
+		t.Errorf("WithoutInactiveFeatures() = %v, want no signer", got)
+	}
+	if got := stake.WithoutInactiveFeatures(99).(*MsgStake); !got.OutputAddress.Empty() || !reflect.DeepEqual(got.Chains, stake.Chains) {
+		t.Errorf("WithoutInactiveFeatures() = %v, want no output address", got)
+	}
+	// and kept after
+	if got := unstake.WithoutInactiveFeatures(100).(*MsgBeginUnstake); !reflect.DeepEqual(*got, unstake) {
+		t.Errorf("WithoutInactiveFeatures() = %v, want %v", got, unstake)
+	}
+	if got := stake.WithoutInactiveFeatures(100).(*MsgStake); !reflect.DeepEqual(*got, stake) {
+		t.Errorf("WithoutInactiveFeatures() = %v, want %v", got, stake)
+	}
+}
@@ -39,6 +39,7 @@ type ProtoValidator struct {
 	ServiceURL              string                                            `protobuf:"bytes,6,opt,name=ServiceURL,proto3" json:"service_url"`
 	StakedTokens            github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,7,opt,name=StakedTokens,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"tokens"`
 	UnstakingCompletionTime time.Time                                         `protobuf:"bytes,8,opt,name=UnstakingCompletionTime,proto3,stdtime" json:"unstaking_time" yaml:"unstaking_time"`
+	OutputAddress           github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,9,opt,name=OutputAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"output_address,omitempty" yaml:"output_address"`
 }
 
 func (m *ProtoValidator) Reset()         { *m = ProtoValidator{} }
@@ -170,52 +171,54 @@ func init() {
 func init() { proto.RegisterFile("x/nodes/nodes.proto", fileDescriptor_63cb49073b61e33a) }
 
 var fileDescriptor_63cb49073b61e33a = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x4f, 0xdb, 0x48,
-	0x14, 0xc6, 0xe3, 0x85, 0x24, 0x30, 0x89, 0x58, 0xad, 0x03, 0x5a, 0x0b, 0xad, 0x32, 0x91, 0xf7,
-	0xb0, 0xb9, 0x10, 0xef, 0x2e, 0xa7, 0x22, 0x55, 0x2a, 0xe6, 0x52, 0x4a, 0x0f, 0xc8, 0x40, 0x0f,
-	0x5c, 0x2c, 0xc7, 0x9e, 0x38, 0x53, 0xdb, 0x33, 0x91, 0x67, 0xdc, 0x92, 0xff, 0xa0, 0xbd, 0x71,
-	0xe4, 0x98, 0x3f, 0x87, 0x23, 0x97, 0x4a, 0x55, 0x0f, 0x53, 0x04, 0x97, 0x2a, 0xc7, 0x1c, 0x7b,
-	0xaa, 0x3c, 0xe3, 0x34, 0x09, 0x4a, 0x2b, 0xd4, 0x0b, 0xf6, 0xfc, 0xde, 0xf7, 0xde, 0x37, 0xf8,
-	0x7d, 0x0a, 0x68, 0x5c, 0x58, 0x84, 0x06, 0x88, 0xa9, 0xbf, 0x9d, 0x41, 0x4a, 0x39, 0xd5, 0xab,
-	0x17, 0x1d, 0x79, 0xdc, 0xde, 0x0c, 0x69, 0x48, 0x25, 0xb3, 0xf2, 0x37, 0x55, 0xde, 0x86, 0x21,
-	0xa5, 0x61, 0x8c, 0x2c, 0x79, 0xea, 0x66, 0x3d, 0x8b, 0xe3, 0x04, 0x31, 0xee, 0x25, 0x83, 0x42,
-	0xd0, 0x7c, 0x28, 0x08, 0xb2, 0xd4, 0xe3, 0x98, 0x12, 0x55, 0x37, 0x6f, 0x57, 0xc1, 0xc6, 0x71,
-	0xfe, 0xf6, 0xca, 0x8b, 0x71, 0xe0, 0x71, 0x9a, 0xea, 0x31, 0xa8, 0xee, 0x07, 0x41, 0x8a, 0x18,
-	0x33, 0xb4, 0x96, 0xd6, 0xae, 0xdb, 0xce, 0x58, 0xc0, 0xaa, 0xa7, 0xd0, 0x44, 0xc0, 0x8d, 0xa1,
-	0x97, 0xc4, 0x7b, 0x66, 0x01, 0xcc, 0xaf, 0x02, 0xfe, 0x17, 0x62, 0xde, 0xcf, 0xba, 0x1d, 0x9f,
-	0x26, 0xd6, 0x80, 0x46, 0x7c, 0x87, 0x20, 0xfe, 0x96, 0xa6, 0x91, 0x35, 0xa0, 0x7e, 0x84, 0xf8,
-	0x8e, 0x4f, 0x53, 0x64, 0xf1, 0xe1, 0x00, 0xb1, 0x4e, 0x31, 0xd9, 0x99, 0x5a, 0xe8, 0xfb, 0x60,
-	0xfd, 0x38, 0xeb, 0xc6, 0xd8, 0x3f, 0x42, 0x43, 0xe3, 0x37, 0xe9, 0xf7, 0xf7, 0x58, 0x40, 0x30,
-	0x90, 0xd0, 0x8d, 0xd0, 0x70, 0x22, 0xe0, 0x1f, 0xca, 0x72, 0xc6, 0x4c, 0x67, 0xd6, 0xa5, 0x9b,
-	0xa0, 0xf2, 0xda, 0xc3, 0x31, 0x0a, 0x8c, 0x95, 0x96, 0xd6, 0x5e, 0xb3, 0xc1, 0x58, 0xc0, 0x82,
-	0x38, 0xc5, 0x33, 0xd7, 0x30, 0xee, 0xf1, 0x8c, 0x19, 0xab, 0x2d, 0xad, 0x5d, 0x56, 0x1a, 0x45,
-	0x9c, 0xe2, 0x99, 0x6b, 0x0e, 0xfa, 0x1e, 0x26, 0xcc, 0x28, 0xb7, 0x56, 0xda, 0xeb, 0x4a, 0xe3,
-	0x4b, 0xe2, 0x14, 0x15, 0xdd, 0x02, 0xe0, 0x04, 0xa5, 0x6f, 0xb0, 0x8f, 0xce, 0x9c, 0x97, 0x46,
-	0xa5, 0xa5, 0xb5, 0xd7, 0xed, 0xdf, 0xc7, 0x02, 0xd6, 0x98, 0xa2, 0x6e, 0x96, 0xc6, 0xce, 0x9c,
-	0x44, 0xef, 0x81, 0xfa, 0x09, 0xf7, 0x22, 0x14, 0x9c, 0xd2, 0x08, 0x11, 0x66, 0x54, 0x65, 0x8b,
-	0x7d, 0x2d, 0x60, 0xe9, 0x93, 0x80, 0xff, 0x3e, 0xfe, 0xcb, 0xd9, 0x38, 0x3c, 0x24, 0x3c, 0xbf,
-	0x12, 0x97, 0x93, 0x9c, 0x85, 0xb9, 0xfa, 0x7b, 0x0d, 0xfc, 0x79, 0x46, 0x18, 0xf7, 0x22, 0x4c,
-	0xc2, 0x03, 0x9a, 0x0c, 0x62, 0x94, 0xaf, 0xf9, 0x14, 0x27, 0xc8, 0x58, 0x6b, 0x69, 0xed, 0xda,
-	0xff, 0xdb, 0x1d, 0x95, 0x85, 0xce, 0x34, 0x0b, 0x9d, 0xd3, 0x69, 0x58, 0xec, 0xdd, 0xfc, 0x3e,
-	0x63, 0x01, 0x37, 0xb2, 0xe9, 0x08, 0x37, 0x4f, 0xd2, 0x44, 0xc0, 0x2d, 0xf5, 0xe9, 0x17, 0xb9,
-	0x79, 0xf9, 0x19, 0x6a, 0xce, 0x8f, 0xfc, 0xf6, 0xea, 0xef, 0x46, 0xb0, 0x74, 0x35, 0x82, 0xda,
-	0x97, 0x11, 0xd4, 0xcc, 0x0f, 0xab, 0x60, 0xf3, 0x7b, 0xba, 0x4e, 0x70, 0x48, 0x30, 0x09, 0x0f,
-	0x49, 0x8f, 0xea, 0xe7, 0x60, 0x9a, 0xaa, 0x22, 0x68, 0xcf, 0xe6, 0x82, 0xf6, 0x8b, 0xb1, 0x2a,
-	0xba, 0xf5, 0x17, 0xa0, 0xce, 0xb8, 0x97, 0x72, 0xb7, 0x8f, 0x70, 0xd8, 0xe7, 0x32, 0x59, 0x2b,
-	0xf6, 0x3f, 0x63, 0x01, 0x17, 0xf8, 0x44, 0xc0, 0x86, 0xfa, 0x07, 0xe7, 0xa9, 0xe9, 0xd4, 0xe4,
-	0xf1, 0xb9, 0x3c, 0xe9, 0x4f, 0x41, 0xf9, 0x90, 0x04, 0xe8, 0x42, 0xc6, 0xab, 0x18, 0x82, 0x73,
-	0xe0, 0xd2, 0x5e, 0x8f, 0xa1, 0xb9, 0x21, 0xf3, 0xd4, 0x74, 0x54, 0x97, 0x4e, 0x40, 0x5d, 0x85,
-	0xd0, 0xcd, 0x08, 0xc7, 0xb1, 0x0c, 0xe0, 0xcf, 0xb7, 0x61, 0x15, 0xdb, 0x58, 0xe8, 0x9b, 0xb9,
-	0xcc, 0x53, 0xb5, 0x89, 0x9a, 0x42, 0x67, 0x39, 0xd1, 0x13, 0xb0, 0x95, 0x60, 0xc6, 0x50, 0xe0,
-	0x76, 0x63, 0xea, 0x47, 0xcc, 0xf5, 0x69, 0x46, 0x38, 0x4a, 0x8d, 0xb2, 0xbc, 0xfe, 0x93, 0xb1,
-	0x80, 0xcb, 0x05, 0x13, 0x01, 0xff, 0x52, 0x0e, 0x4b, 0xcb, 0xa6, 0xd3, 0x50, 0xdc, 0x96, 0xf8,
-	0x40, 0xd1, 0xdc, 0xae, 0xb8, 0xd0, 0x03, 0xbb, 0xca, 0xcc, 0x6e, 0xa9, 0x60, 0x66, 0xb7, 0xb4,
-	0x6c, 0x3a, 0x0d, 0xc5, 0x17, 0xec, 0xf6, 0xd6, 0xae, 0x46, 0xb0, 0x94, 0xe7, 0xca, 0x3e, 0xba,
-	0xbe, 0x6b, 0x6a, 0x37, 0x77, 0x4d, 0xed, 0xf6, 0xae, 0xa9, 0x5d, 0xde, 0x37, 0x4b, 0x37, 0xf7,
-	0xcd, 0xd2, 0xc7, 0xfb, 0x66, 0xe9, 0xfc, 0x51, 0xc1, 0x99, 0xfe, 0xda, 0xca, 0x00, 0x75, 0x2b,
-	0x72, 0x0d, 0xbb, 0xdf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x98, 0x6e, 0x33, 0x85, 0x05, 0x00,
-	0x00,
+	// 739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x3f, 0x6f, 0xc3, 0x44,
+	0x18, 0xc6, 0x63, 0xd2, 0x24, 0xcd, 0x25, 0x14, 0xe1, 0xb4, 0xc2, 0xaa, 0x50, 0x2e, 0x32, 0x03,
+	0x19, 0x68, 0x0c, 0x74, 0xa2, 0x12, 0x12, 0x75, 0x17, 0x4a, 0x91, 0xa8, 0xdc, 0x96, 0xa1, 0x8b,
+	0xe5, 0xd8, 0x17, 0xe7, 0xea, 0x3f, 0x67, 0xf9, 0xce, 0xd0, 0x7c, 0x03, 0xd8, 0x3a, 0x76, 0xcc,
+	0xc7, 0xe9, 0xd8, 0x05, 0x09, 0x31, 0x1c, 0xa8, 0x5d, 0x90, 0xc7, 0xb0, 0x31, 0x21, 0xdf, 0x39,
+	0x24, 0xae, 0x82, 0xa8, 0xba, 0xc4, 0xbe, 0xdf, 0xfb, 0xdc, 0xfb, 0xbc, 0xba, 0x7b, 0x22, 0x83,
+	0xde, 0xad, 0x11, 0x13, 0x0f, 0x51, 0xf9, 0x3b, 0x4a, 0x52, 0xc2, 0x88, 0xda, 0xba, 0x1d, 0x89,
+	0xe5, 0xfe, 0xae, 0x4f, 0x7c, 0x22, 0x98, 0x51, 0xbc, 0xc9, 0xf2, 0x3e, 0xf4, 0x09, 0xf1, 0x43,
+	0x64, 0x88, 0xd5, 0x38, 0x9b, 0x18, 0x0c, 0x47, 0x88, 0x32, 0x27, 0x4a, 0x4a, 0x41, 0xff, 0xa5,
+	0xc0, 0xcb, 0x52, 0x87, 0x61, 0x12, 0xcb, 0xba, 0xfe, 0x57, 0x03, 0xec, 0x9c, 0x17, 0x6f, 0xdf,
+	0x3b, 0x21, 0xf6, 0x1c, 0x46, 0x52, 0x35, 0x04, 0xad, 0x63, 0xcf, 0x4b, 0x11, 0xa5, 0x9a, 0x32,
+	0x50, 0x86, 0x5d, 0xd3, 0xca, 0x39, 0x6c, 0x39, 0x12, 0x2d, 0x38, 0xdc, 0x99, 0x39, 0x51, 0x78,
+	0xa4, 0x97, 0x40, 0xff, 0x9b, 0xc3, 0xcf, 0x7c, 0xcc, 0xa6, 0xd9, 0x78, 0xe4, 0x92, 0xc8, 0x48,
+	0x48, 0xc0, 0x0e, 0x62, 0xc4, 0x7e, 0x24, 0x69, 0x60, 0x24, 0xc4, 0x0d, 0x10, 0x3b, 0x70, 0x49,
+	0x8a, 0x0c, 0x36, 0x4b, 0x10, 0x1d, 0x95, 0x9d, 0xad, 0xa5, 0x85, 0x7a, 0x0c, 0xda, 0xe7, 0xd9,
+	0x38, 0xc4, 0xee, 0x19, 0x9a, 0x69, 0xef, 0x08, 0xbf, 0x8f, 0x72, 0x0e, 0x41, 0x22, 0xa0, 0x1d,
+	0xa0, 0xd9, 0x82, 0xc3, 0xf7, 0xa5, 0xe5, 0x8a, 0xe9, 0xd6, 0x6a, 0x97, 0xaa, 0x83, 0xe6, 0x8d,
+	0x83, 0x43, 0xe4, 0x69, 0xf5, 0x81, 0x32, 0xdc, 0x36, 0x41, 0xce, 0x61, 0x49, 0xac, 0xf2, 0x59,
+	0x68, 0x28, 0x73, 0x58, 0x46, 0xb5, 0xad, 0x81, 0x32, 0x6c, 0x48, 0x8d, 0x24, 0x56, 0xf9, 0x2c,
+	0x34, 0x27, 0x53, 0x07, 0xc7, 0x54, 0x6b, 0x0c, 0xea, 0xc3, 0xb6, 0xd4, 0xb8, 0x82, 0x58, 0x65,
+	0x45, 0x35, 0x00, 0xb8, 0x40, 0xe9, 0x0f, 0xd8, 0x45, 0x57, 0xd6, 0xb7, 0x5a, 0x73, 0xa0, 0x0c,
+	0xdb, 0xe6, 0x7b, 0x39, 0x87, 0x1d, 0x2a, 0xa9, 0x9d, 0xa5, 0xa1, 0xb5, 0x26, 0x51, 0x27, 0xa0,
+	0x7b, 0xc1, 0x9c, 0x00, 0x79, 0x97, 0x24, 0x40, 0x31, 0xd5, 0x5a, 0x62, 0x8b, 0xf9, 0xc0, 0x61,
+	0xed, 0x37, 0x0e, 0x3f, 0x7d, 0xfd, 0xc9, 0x99, 0xd8, 0x3f, 0x8d, 0x59, 0x31, 0x12, 0x13, 0x9d,
+	0xac, 0x4a, 0x5f, 0xf5, 0x67, 0x05, 0x7c, 0x70, 0x15, 0x53, 0xe6, 0x04, 0x38, 0xf6, 0x4f, 0x48,
+	0x94, 0x84, 0xa8, 0xb8, 0xe6, 0x4b, 0x1c, 0x21, 0x6d, 0x7b, 0xa0, 0x0c, 0x3b, 0x9f, 0xef, 0x8f,
+	0x64, 0x16, 0x46, 0xcb, 0x2c, 0x8c, 0x2e, 0x97, 0x61, 0x31, 0x0f, 0x8b, 0x79, 0x72, 0x0e, 0x77,
+	0xb2, 0x65, 0x0b, 0xbb, 0x48, 0xd2, 0x82, 0xc3, 0x3d, 0x79, 0xf4, 0x55, 0xae, 0xdf, 0xfd, 0x0e,
+	0x15, 0xeb, 0xbf, 0xfc, 0xd4, 0x3b, 0x05, 0xbc, 0xfb, 0x5d, 0xc6, 0x92, 0x8c, 0x2d, 0x83, 0xd4,
+	0x16, 0x17, 0x7b, 0x93, 0x73, 0xa8, 0x11, 0x51, 0xb0, 0xcb, 0xf8, 0x7c, 0x42, 0x22, 0xcc, 0x50,
+	0x94, 0xb0, 0xd9, 0xca, 0xab, 0xaa, 0x78, 0x63, 0xc0, 0xaa, 0x03, 0x1c, 0x75, 0x7f, 0x9a, 0xc3,
+	0xda, 0xfd, 0x1c, 0x2a, 0x7f, 0xce, 0xa1, 0xa2, 0xff, 0xb2, 0x05, 0x76, 0xff, 0x0d, 0xfc, 0x05,
+	0xf6, 0x63, 0x1c, 0xfb, 0xa7, 0xf1, 0x84, 0xa8, 0xd7, 0xa0, 0xe5, 0x54, 0xb2, 0xff, 0xd5, 0x5a,
+	0xf6, 0xdf, 0x98, 0xf4, 0x72, 0xb7, 0xfa, 0x0d, 0xe8, 0x52, 0xe6, 0xa4, 0xcc, 0x9e, 0x22, 0xec,
+	0x4f, 0x99, 0x08, 0x7b, 0xdd, 0xfc, 0x38, 0xe7, 0xb0, 0xc2, 0x17, 0x1c, 0xf6, 0xe4, 0x39, 0xac,
+	0x53, 0xdd, 0xea, 0x88, 0xe5, 0xd7, 0x62, 0xa5, 0x7e, 0x09, 0x1a, 0xa7, 0xb1, 0x87, 0x6e, 0xb5,
+	0xfa, 0xaa, 0x09, 0x2e, 0x80, 0x4d, 0x26, 0x13, 0x8a, 0xd6, 0x9a, 0xac, 0x53, 0xdd, 0x92, 0xbb,
+	0xd4, 0x18, 0x74, 0xe5, 0xff, 0xc2, 0xce, 0x62, 0x86, 0x43, 0x6d, 0xeb, 0x7f, 0x03, 0x62, 0x94,
+	0x01, 0xa9, 0xec, 0x5b, 0xb9, 0xac, 0x53, 0x19, 0x8e, 0x8e, 0x44, 0x57, 0x05, 0x51, 0x23, 0xb0,
+	0x17, 0x61, 0x4a, 0x91, 0x67, 0x8f, 0x43, 0xe2, 0x06, 0xd4, 0x76, 0x49, 0x16, 0x33, 0x94, 0x6a,
+	0x0d, 0x31, 0xfe, 0x17, 0x39, 0x87, 0x9b, 0x05, 0x0b, 0x0e, 0x3f, 0x94, 0x0e, 0x1b, 0xcb, 0xba,
+	0xd5, 0x93, 0xdc, 0x14, 0xf8, 0x44, 0xd2, 0xc2, 0xae, 0x1c, 0xe8, 0x85, 0x5d, 0x73, 0x65, 0xb7,
+	0x51, 0xb0, 0xb2, 0xdb, 0x58, 0xd6, 0xad, 0x9e, 0xe4, 0x15, 0xbb, 0xa3, 0xed, 0xfb, 0x39, 0xac,
+	0x15, 0xb9, 0x32, 0xcf, 0x1e, 0x9e, 0xfa, 0xca, 0xe3, 0x53, 0x5f, 0xf9, 0xe3, 0xa9, 0xaf, 0xdc,
+	0x3d, 0xf7, 0x6b, 0x8f, 0xcf, 0xfd, 0xda, 0xaf, 0xcf, 0xfd, 0xda, 0xf5, 0xab, 0x82, 0xb3, 0xfc,
+	0x00, 0x88, 0x00, 0x8d, 0x9b, 0xe2, 0x1a, 0x0e, 0xff, 0x19, 0x00, 0xb5, 0x03, 0x41, 0x5b, 0x18,
+	0x06, 0x00, 0x00,
 }
 
 func (this *ProtoValidator) Equal(that interface{}) bool {
@@ -266,6 +269,9 @@ func (this *ProtoValidator) Equal(that interface{}) bool {
 	if !this.UnstakingCompletionTime.Equal(that1.UnstakingCompletionTime) {
 		return false
 	}
+	if !bytes.Equal(this.OutputAddress, that1.OutputAddress) {
+		return false
+	}
 	return true
 }
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -327,6 +333,13 @@ func (m *ProtoValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OutputAddress) > 0 {
+		i -= len(m.OutputAddress)
+		copy(dAtA[i:], m.OutputAddress)
+		i = encodeVarintNodes(dAtA, i, uint64(len(m.OutputAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnstakingCompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnstakingCompletionTime):])
 	if err1 != nil {
 		return 0, err1
@@ -496,6 +509,10 @@ func (m *ProtoValidator) Size() (n int) {
 	n += 1 + l + sovNodes(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnstakingCompletionTime)
 	n += 1 + l + sovNodes(uint64(l))
+	l = len(m.OutputAddress)
+	if l > 0 {
+		n += 1 + l + sovNodes(uint64(l))
+	}
 	return n
 }
 
@@ -799,6 +816,40 @@ func (m *ProtoValidator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputAddress = append(m.OutputAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OutputAddress == nil {
+				m.OutputAddress = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNodes(dAtA[iNdEx:])
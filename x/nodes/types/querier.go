@@ -34,6 +34,7 @@ type QueryValidatorsParams struct {
 	Blockchain    string          `json:"blockchain"`
 	Page          int             `json:"page"`
 	Limit         int             `json:"per_page"`
+	OutputAddress sdk.Address     `json:"output_address,omitempty"` // the address receiving the rewards and the unstaked tokens
 }
 
 // "IsValid" - Checks that the validator is valid for the options passed
@@ -67,6 +68,11 @@ func (opts QueryValidatorsParams) IsValid(val Validator) bool {
 			return false
 		}
 	}
+	if !opts.OutputAddress.Empty() {
+		if !val.GetOutputAddress().Equals(opts.OutputAddress) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -16,14 +16,15 @@ import (
 )
 
 type Validator struct {
-	Address                 sdk.Address      `json:"address" yaml:"address"`               // address of the validator; hex encoded in JSON
-	PublicKey               crypto.PublicKey `json:"public_key" yaml:"public_key"`         // the consensus public key of the validator; hex encoded in JSON
-	Jailed                  bool             `json:"jailed" yaml:"jailed"`                 // has the validator been jailed from staked status?
-	Status                  sdk.StakeStatus  `json:"status" yaml:"status"`                 // validator status (staked/unstaking/unstaked)
-	Chains                  []string         `json:"chains" yaml:"chains"`                 // validator non native blockchains
-	ServiceURL              string           `json:"service_url" yaml:"service_url"`       // url where the pocket service api is hosted
-	StakedTokens            sdk.BigInt       `json:"tokens" yaml:"tokens"`                 // tokens staked in the network
-	UnstakingCompletionTime time.Time        `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the validator to complete unstaking
+	Address                 sdk.Address      `json:"address" yaml:"address"`                         // address of the validator; hex encoded in JSON
+	PublicKey               crypto.PublicKey `json:"public_key" yaml:"public_key"`                   // the consensus public key of the validator; hex encoded in JSON
+	Jailed                  bool             `json:"jailed" yaml:"jailed"`                           // has the validator been jailed from staked status?
+	Status                  sdk.StakeStatus  `json:"status" yaml:"status"`                           // validator status (staked/unstaking/unstaked)
+	Chains                  []string         `json:"chains" yaml:"chains"`                           // validator non native blockchains
+	ServiceURL              string           `json:"service_url" yaml:"service_url"`                 // url where the pocket service api is hosted
+	StakedTokens            sdk.BigInt       `json:"tokens" yaml:"tokens"`                           // tokens staked in the network
+	UnstakingCompletionTime time.Time        `json:"unstaking_time" yaml:"unstaking_time"`           // if unstaking, min time for the validator to complete unstaking
+	OutputAddress           sdk.Address      `json:"output_address,omitempty" yaml:"output_address"` // optional address that receives the rewards and the unstaked tokens
 }
 
 // NewValidator - initialize a new validator
@@ -160,12 +161,29 @@ func (v *Validator) Unmarshal(data []byte) error {
 	return err
 }
 
+// GetOutputAddress returns the address that receives the rewards and the unstaked tokens of the validator
+func (v Validator) GetOutputAddress() sdk.Address {
+	if v.OutputAddress.Empty() {
+		return v.Address
+	}
+	return v.OutputAddress
+}
+
+// IsOwner returns true if the address is the validator or its output address (both may unstake the validator)
+func (v Validator) IsOwner(addr sdk.Address) bool {
+	return v.Address.Equals(addr) || (!v.OutputAddress.Empty() && v.OutputAddress.Equals(addr))
+}
+
 // String returns a human readable string representation of a validator.
 func (v Validator) String() string {
+	var output string
+	if !v.OutputAddress.Empty() {
+		output = fmt.Sprintf("\nOutput Address:\t\t%s", v.OutputAddress)
+	}
 	return fmt.Sprintf("Address:\t\t%s\nPublic Key:\t\t%s\nJailed:\t\t\t%v\nStatus:\t\t\t%s\nTokens:\t\t\t%s\n"+
-		"ServiceUrl:\t\t%s\nChains:\t\t\t%v\nUnstaking Completion Time:\t\t%v"+
+		"ServiceUrl:\t\t%s\nChains:\t\t\t%v\nUnstaking Completion Time:\t\t%v%s"+
 		"\n----\n",
-		v.Address, v.PublicKey.RawString(), v.Jailed, v.Status, v.StakedTokens, v.ServiceURL, v.Chains, v.UnstakingCompletionTime,
+		v.Address, v.PublicKey.RawString(), v.Jailed, v.Status, v.StakedTokens, v.ServiceURL, v.Chains, v.UnstakingCompletionTime, output,
 	)
 }
 
@@ -188,6 +206,7 @@ func (v Validator) MarshalJSON() ([]byte, error) {
 		Chains:                  v.Chains,
 		StakedTokens:            v.StakedTokens,
 		UnstakingCompletionTime: v.UnstakingCompletionTime,
+		OutputAddress:           v.OutputAddress,
 	})
 }
 
@@ -210,6 +229,7 @@ func (v *Validator) UnmarshalJSON(data []byte) error {
 		StakedTokens:            bv.StakedTokens,
 		Status:                  bv.Status,
 		UnstakingCompletionTime: bv.UnstakingCompletionTime,
+		OutputAddress:           bv.OutputAddress,
 	}
 	return nil
 }
@@ -229,6 +249,7 @@ func (v ProtoValidator) FromProto() (Validator, error) {
 		Chains:                  v.Chains,
 		StakedTokens:            v.StakedTokens,
 		UnstakingCompletionTime: v.UnstakingCompletionTime,
+		OutputAddress:           v.OutputAddress,
 	}, nil
 }
 
@@ -243,18 +264,20 @@ func (v Validator) ToProto() ProtoValidator {
 		Chains:                  v.Chains,
 		StakedTokens:            v.StakedTokens,
 		UnstakingCompletionTime: v.UnstakingCompletionTime,
+		OutputAddress:           v.OutputAddress,
 	}
 }
 
 type JSONValidator struct {
-	Address                 sdk.Address     `json:"address" yaml:"address"`               // address of the validator; hex encoded in JSON
-	PublicKey               string          `json:"public_key" yaml:"public_key"`         // the consensus public key of the validator; hex encoded in JSON
-	Jailed                  bool            `json:"jailed" yaml:"jailed"`                 // has the validator been jailed from staked status?
-	Status                  sdk.StakeStatus `json:"status" yaml:"status"`                 // validator status (staked/unstaking/unstaked)
-	Chains                  []string        `json:"chains" yaml:"chains"`                 // validator non native blockchains
-	ServiceURL              string          `json:"service_url" yaml:"service_url"`       // url where the pocket service api is hosted
-	StakedTokens            sdk.BigInt      `json:"tokens" yaml:"tokens"`                 // tokens staked in the network
-	UnstakingCompletionTime time.Time       `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the validator to complete unstaking
+	Address                 sdk.Address     `json:"address" yaml:"address"`                         // address of the validator; hex encoded in JSON
+	PublicKey               string          `json:"public_key" yaml:"public_key"`                   // the consensus public key of the validator; hex encoded in JSON
+	Jailed                  bool            `json:"jailed" yaml:"jailed"`                           // has the validator been jailed from staked status?
+	Status                  sdk.StakeStatus `json:"status" yaml:"status"`                           // validator status (staked/unstaking/unstaked)
+	Chains                  []string        `json:"chains" yaml:"chains"`                           // validator non native blockchains
+	ServiceURL              string          `json:"service_url" yaml:"service_url"`                 // url where the pocket service api is hosted
+	StakedTokens            sdk.BigInt      `json:"tokens" yaml:"tokens"`                           // tokens staked in the network
+	UnstakingCompletionTime time.Time       `json:"unstaking_time" yaml:"unstaking_time"`           // if unstaking, min time for the validator to complete unstaking
+	OutputAddress           sdk.Address     `json:"output_address,omitempty" yaml:"output_address"` // optional address that receives the rewards and the unstaked tokens
 }
 
 // Validators is a collection of Validator
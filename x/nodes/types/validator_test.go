@@ -1307,3 +1307,45 @@ func TestValidator_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestValidator_OutputAddress(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	_, _ = rand.Read(pub[:])
+	v := NewValidator(sdk.Address(pub.Address()), pub, []string{"0001"}, "https://www.pokt.network:443", sdk.NewInt(10))
+	if !v.GetOutputAddress().Equals(v.Address) {
+		t.Errorf("GetOutputAddress() = %v, want the validator address %v", v.GetOutputAddress(), v.Address)
+	}
+	_, _ = rand.Read(pub[:])
+	output := sdk.Address(pub.Address())
+	if v.IsOwner(output) {
+		t.Errorf("IsOwner() = true for a random address")
+	}
+	v.OutputAddress = output
+	if !v.GetOutputAddress().Equals(output) || !v.IsOwner(output) || !v.IsOwner(v.Address) {
+		t.Errorf("the output address %v should receive the coins and own the validator", output)
+	}
+	// proto round trip
+	bz, err := v.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromProto Validator
+	if err := fromProto.Unmarshal(bz); err != nil {
+		t.Fatal(err)
+	}
+	if !fromProto.OutputAddress.Equals(output) {
+		t.Errorf("Unmarshal() output address = %v, want %v", fromProto.OutputAddress, output)
+	}
+	// json round trip
+	j, err := v.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromJSON Validator
+	if err := fromJSON.UnmarshalJSON(j); err != nil {
+		t.Fatal(err)
+	}
+	if !fromJSON.OutputAddress.Equals(output) {
+		t.Errorf("UnmarshalJSON() output address = %v, want %v", fromJSON.OutputAddress, output)
+	}
+}
@@ -101,6 +101,9 @@ func processSelf(ctx sdk.Ctx, k keeper.Keeper, signer sdk.Address, header types.
 		}
 		if !tokens.IsZero() {
 			types.GlobalServiceMetric().AddUPOKTEarnedFor(header.Chain, float64(tokens.Int64()))
+			types.RecordOutcome(header, evidenceType, types.OutcomeProven, tokens, "")
+		} else {
+			types.RecordOutcome(header, evidenceType, types.OutcomeRejected, tokens, "the proof was rejected")
 		}
 	}
 }
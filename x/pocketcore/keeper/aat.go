@@ -28,3 +28,24 @@ func AATGeneration(appPubKey string, clientPubKey string, key crypto.PrivateKey)
 	aat.ApplicationSignature = hex.EncodeToString(sig)
 	return aat, nil
 }
+
+// "DelegatedAATGeneration" - Generates an application authentication token signed by a gateway on behalf of the application.
+// The contract is that the application delegated the gateway (its public key) on chain before the session of the relays.
+func DelegatedAATGeneration(appPubKey string, clientPubKey string, gatewayKey crypto.PrivateKey) (pc.AAT, sdk.Error) {
+	// create the aat object
+	aat := pc.AAT{
+		Version:              pc.SupportedTokenVersions[0],
+		ApplicationPublicKey: appPubKey,
+		ClientPublicKey:      clientPubKey,
+		ApplicationSignature: "",
+		GatewayPublicKey:     gatewayKey.PublicKey().RawString(),
+	}
+	// the gateway signs the aat
+	sig, err := gatewayKey.Sign(aat.Hash())
+	if err != nil {
+		return pc.AAT{}, pc.NewSignatureError(pc.ModuleName, err)
+	}
+	// stringify the signature into hex
+	aat.ApplicationSignature = hex.EncodeToString(sig)
+	return aat, nil
+}
@@ -12,12 +12,11 @@ import (
 	"github.com/tendermint/tendermint/rpc/client"
 )
 
-// "SendClaimTx" - Automatically sends a claim of work/challenge based on relays or challenges stored.
-func (k Keeper) SendClaimTx(ctx sdk.Ctx, keeper Keeper, n client.Client, claimTx func(pk crypto.PrivateKey, cliCtx util.CLIContext, txBuilder auth.TxBuilder, header pc.SessionHeader, totalProofs int64, root pc.HashRange, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)) {
-	// get the private val key (main) account from the keybase
-	kp, err := k.GetPKFromFile(ctx)
-	if err != nil {
-		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the private key from file for the claim transaction:\n%s", err.Error()))
+// "ScheduleClaims" - Schedules a claim job for every piece of evidence (work/challenge) stored that can be claimed.
+func (k Keeper) ScheduleClaims(ctx sdk.Ctx) {
+	// get the self address
+	addr := k.GetSelfAddress(ctx)
+	if addr == nil {
 		return
 	}
 	// retrieve the iterator to go through each piece of evidence in storage
@@ -40,10 +39,11 @@ func (k Keeper) SendClaimTx(ctx sdk.Ctx, keeper Keeper, n client.Client, claimTx
 			continue
 		}
 		// if the evidence length is less than minimum, it would not satisfy our merkle tree needs
-		if evidence.NumOfProofs < keeper.MinimumNumberOfProofs(sessionCtx) {
+		if evidence.NumOfProofs < k.MinimumNumberOfProofs(sessionCtx) {
 			if err := pc.DeleteEvidence(evidence.SessionHeader, evidenceType); err != nil {
 				ctx.Logger().Debug(err.Error())
 			}
+			pc.RecordOutcome(evidence.SessionHeader, evidenceType, pc.OutcomeRejected, sdk.ZeroInt(), "below the minimum number of proofs")
 			continue
 		}
 		if ctx.BlockHeight() <= evidence.SessionBlockHeight+k.BlocksPerSession(sessionCtx)-1 { // ensure session is over
@@ -56,10 +56,11 @@ func (k Keeper) SendClaimTx(ctx sdk.Ctx, keeper Keeper, n client.Client, claimTx
 			if err := pc.DeleteEvidence(evidence.SessionHeader, evidenceType); err != nil {
 				ctx.Logger().Debug(err.Error())
 			}
+			pc.RecordOutcome(evidence.SessionHeader, evidenceType, pc.OutcomeRejected, sdk.ZeroInt(), "the blockchain is not pocket supported")
 			continue
 		}
 		// check the current state to see if the unverified evidence has already been sent and processed (if so, then skip this evidence)
-		if _, found := k.GetClaim(ctx, sdk.Address(kp.PublicKey().Address()), evidence.SessionHeader, evidenceType); found {
+		if _, found := k.GetClaim(ctx, addr, evidence.SessionHeader, evidenceType); found {
 			continue
 		}
 		// if the claim is mature, delete it because we cannot submit a mature claim
@@ -67,21 +68,31 @@ func (k Keeper) SendClaimTx(ctx sdk.Ctx, keeper Keeper, n client.Client, claimTx
 			if err := pc.DeleteEvidence(evidence.SessionHeader, evidenceType); err != nil {
 				ctx.Logger().Debug(err.Error())
 			}
+			pc.RecordOutcome(evidence.SessionHeader, evidenceType, pc.OutcomeExpired, sdk.ZeroInt(), "the claim window passed")
 			continue
 		}
-		// generate the merkle root for this evidence
-		root := evidence.GenerateMerkleRoot(evidence.SessionHeader.SessionBlockHeight)
-		// generate the auto txbuilder and clictx
-		txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, &pc.MsgClaim{}, n, kp, k)
-		if err != nil {
-			ctx.Logger().Error(fmt.Sprintf("an error occured creating the tx builder for the claim tx:\n%s", err.Error()))
-			return
-		}
-		// send in the evidence header, the total relays completed, and the merkle root (ensures data integrity)
-		if _, err := claimTx(kp, cliCtx, txBuilder, evidence.SessionHeader, evidence.NumOfProofs, root, evidenceType); err != nil {
-			ctx.Logger().Error(fmt.Sprintf("an error occured executing the claim transaciton: \n%s", err.Error()))
-		}
+		// the claim must be submitted before it is mature
+		deadline := evidence.SessionBlockHeight + k.ClaimSubmissionWindow(sessionCtx)*k.BlocksPerSession(sessionCtx)
+		pc.ScheduleJob(pc.NewJob(pc.ClaimJob, evidence.SessionHeader, evidenceType, ctx.BlockHeight(), deadline))
+	}
+}
+
+// "sendClaim" - Sends the claim tx of the job, returns the tx response and the total proofs claimed
+func (k Keeper) sendClaim(ctx sdk.Ctx, kp crypto.Signer, n client.Client, job pc.Job, claimTx func(pk crypto.Signer, cliCtx util.CLIContext, txBuilder auth.TxBuilder, header pc.SessionHeader, totalProofs int64, root pc.HashRange, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)) (*sdk.TxResponse, int64, error) {
+	evidence, err := pc.GetEvidence(job.SessionHeader, job.EvidenceType, sdk.ZeroInt())
+	if err != nil || evidence.NumOfProofs == 0 {
+		return nil, 0, fmt.Errorf("the evidence for the claim was not found")
 	}
+	// generate the merkle root for this evidence
+	root := evidence.GenerateMerkleRoot(evidence.SessionHeader.SessionBlockHeight)
+	// generate the auto txbuilder and clictx
+	txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, &pc.MsgClaim{}, n, kp, k)
+	if err != nil {
+		return nil, 0, fmt.Errorf("an error occured creating the tx builder for the claim tx: %s", err.Error())
+	}
+	// send in the evidence header, the total relays completed, and the merkle root (ensures data integrity)
+	res, err := claimTx(kp, cliCtx, txBuilder, evidence.SessionHeader, evidence.NumOfProofs, root, job.EvidenceType)
+	return res, evidence.NumOfProofs, err
 }
 
 // "ValidateClaim" - Validates a claim message and returns an sdk error if invalid
@@ -316,6 +327,9 @@ func (k Keeper) DeleteExpiredClaims(ctx sdk.Ctx) {
 		// if more sessions has passed than the expiration of the claim's genesis, delete it from the set
 		if msg.ExpirationHeight <= ctx.BlockHeight() {
 			_ = store.Delete(iterator.Key())
+			if msg.FromAddress.Equals(k.GetSelfAddress(ctx)) {
+				pc.RecordOutcome(msg.SessionHeader, msg.EvidenceType, pc.OutcomeExpired, sdk.ZeroInt(), "the claim expired without a proof")
+			}
 		}
 	}
 }
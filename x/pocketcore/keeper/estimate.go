@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/pokt-network/pocket-core/types"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// "EstimateClaims" - Estimates the payout of every piece of evidence (work/challenge) stored; read only, nothing is sent
+func (k Keeper) EstimateClaims(ctx sdk.Ctx) pc.ClaimEstimates {
+	res := pc.ClaimEstimates{
+		Height:     ctx.BlockHeight(),
+		Estimates:  make([]pc.ClaimEstimate, 0),
+		NodeReward: sdk.ZeroInt(),
+		Fees:       sdk.ZeroInt(),
+		NetReward:  sdk.ZeroInt(),
+	}
+	// the self address is only needed to look up the claims already submitted
+	addr := k.GetSelfAddress(ctx)
+	// the reward is minted (and split) with the params at the time of the proof
+	multiplier := k.posKeeper.RelaysToTokensMultiplier(ctx)
+	daoAllocation, proposerAllocation := k.posKeeper.DAOAllocation(ctx), k.posKeeper.ProposerAllocation(ctx)
+	claimFee, proofFee := k.authKeeper.GetFee(ctx, &pc.MsgClaim{}), k.authKeeper.GetFee(ctx, &pc.MsgProof{})
+	// retrieve the iterator to go through each piece of evidence in storage
+	iter := pc.EvidenceIterator()
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		evidence := iter.Value()
+		estimate := k.estimateClaim(ctx, addr, evidence, multiplier, daoAllocation, proposerAllocation, claimFee, proofFee)
+		if estimate.Status == pc.ClaimStatusClaimable || estimate.Status == pc.ClaimStatusClaimed {
+			res.NodeReward = res.NodeReward.Add(estimate.NodeReward)
+			res.Fees = res.Fees.Add(estimate.ClaimFee).Add(estimate.ProofFee)
+		}
+		res.Estimates = append(res.Estimates, estimate)
+	}
+	res.NetReward = res.NodeReward.Sub(res.Fees)
+	return res
+}
+
+// "estimateClaim" - Estimates the payout of a single piece of evidence
+func (k Keeper) estimateClaim(ctx sdk.Ctx, addr sdk.Address, evidence pc.Evidence, multiplier sdk.BigInt, daoAllocation, proposerAllocation int64, claimFee, proofFee sdk.BigInt) pc.ClaimEstimate {
+	estimate := pc.NewClaimEstimate(evidence)
+	estimate.ClaimFee, estimate.ProofFee = claimFee, proofFee
+	// the session params (like the claim checks), fall back to the latest ones if the session is not committed yet
+	var sessionCtx sdk.Ctx = ctx
+	if c, err := ctx.PrevCtx(evidence.SessionHeader.SessionBlockHeight); err == nil {
+		sessionCtx = c
+	}
+	blocksPerSession := k.BlocksPerSession(sessionCtx)
+	estimate.MinimumProofs = k.MinimumNumberOfProofs(sessionCtx)
+	estimate.ClaimDeadlineHeight = evidence.SessionHeader.SessionBlockHeight + k.ClaimSubmissionWindow(sessionCtx)*blocksPerSession
+	estimate.ClaimMaturityHeight = estimate.ClaimDeadlineHeight + 1
+	// challenges burn the tokens of the servicer and only reward a hundredth of the proofs
+	relays := evidence.NumOfProofs
+	if evidence.EvidenceType == pc.ChallengeEvidence {
+		relays = relays / 100
+	}
+	estimate.Reward, estimate.DAOAllocation, estimate.ProposerAllocation, estimate.NodeReward = pc.EstimateReward(relays, multiplier, daoAllocation, proposerAllocation)
+	var claimed bool
+	if addr != nil {
+		_, claimed = k.GetClaim(ctx, addr, evidence.SessionHeader, evidence.EvidenceType)
+	}
+	switch {
+	case claimed:
+		// the claim fee is already paid
+		estimate.Status = pc.ClaimStatusClaimed
+		estimate.ClaimFee = sdk.ZeroInt()
+	case evidence.NumOfProofs < estimate.MinimumProofs:
+		estimate.Status = pc.ClaimStatusBelowMinimum
+	case !k.IsPocketSupportedBlockchain(sessionCtx, evidence.SessionHeader.Chain):
+		estimate.Status = pc.ClaimStatusUnsupported
+	case ctx.BlockHeight() > estimate.ClaimDeadlineHeight:
+		estimate.Status = pc.ClaimStatusExpired
+	case ctx.BlockHeight() <= evidence.SessionHeader.SessionBlockHeight+blocksPerSession-1:
+		estimate.Status = pc.ClaimStatusOngoing
+	}
+	estimate.NetReward = estimate.NodeReward.Sub(estimate.ClaimFee).Sub(estimate.ProofFee)
+	estimate.Profitable = estimate.NetReward.IsPositive()
+	return estimate
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_EstimateClaims(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	types.ClearEvidence()
+	defer types.ClearEvidence()
+	_, header, _ := simulateRelays(t, keeper, &ctx, 10)
+	res := keeper.EstimateClaims(ctx)
+	assert.Len(t, res.Estimates, 1)
+	estimate := res.Estimates[0]
+	assert.Equal(t, header, estimate.SessionHeader)
+	assert.Equal(t, "relay", estimate.EvidenceType)
+	assert.Equal(t, int64(10), estimate.TotalProofs)
+	assert.Equal(t, keeper.MinimumNumberOfProofs(ctx), estimate.MinimumProofs)
+	assert.Equal(t, estimate.ClaimDeadlineHeight+1, estimate.ClaimMaturityHeight)
+	// the reward is split like the nodes module does
+	multiplier := keeper.posKeeper.RelaysToTokensMultiplier(ctx)
+	assert.Equal(t, multiplier.MulRaw(10), estimate.Reward)
+	assert.Equal(t, estimate.Reward, estimate.NodeReward.Add(estimate.DAOAllocation).Add(estimate.ProposerAllocation))
+	assert.Equal(t, keeper.authKeeper.GetFee(ctx, &types.MsgClaim{}), estimate.ClaimFee)
+	assert.Equal(t, keeper.authKeeper.GetFee(ctx, &types.MsgProof{}), estimate.ProofFee)
+	assert.Equal(t, estimate.NodeReward.Sub(estimate.ClaimFee).Sub(estimate.ProofFee), estimate.NetReward)
+	assert.Equal(t, estimate.NetReward.IsPositive(), estimate.Profitable)
+	// read only: the evidence is still there
+	_, err := types.GetEvidence(header, types.RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+}
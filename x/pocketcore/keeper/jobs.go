@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
+	"github.com/pokt-network/pocket-core/x/auth/util"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/tendermint/tendermint/rpc/client"
+)
+
+// "ProcessJobs" - Attempts every due claim and proof job, confirms the submitted ones and prunes the final ones
+func (k Keeper) ProcessJobs(ctx sdk.Ctx, n client.Client,
+	claimTx func(pk crypto.Signer, cliCtx util.CLIContext, txBuilder auth.TxBuilder, header pc.SessionHeader, totalProofs int64, root pc.HashRange, evidenceType pc.EvidenceType) (*sdk.TxResponse, error),
+	proofTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, merkleProof pc.MerkleProof, leafNode pc.Proof, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)) {
+	// get the signer of the private val key (main) account
+	kp, err := k.GetSigner(ctx)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the signer for the claim and proof jobs:\n%s", err.Error()))
+		return
+	}
+	jobs, err := pc.GetJobs("", "")
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the claim and proof jobs:\n%s", err.Error()))
+		return
+	}
+	height := ctx.BlockHeight()
+	for i := range jobs {
+		job := &jobs[i]
+		switch {
+		case job.State.IsFinal():
+			// final jobs are kept (for the rpc) until their deadline passed
+			if height > job.DeadlineHeight {
+				if err := pc.DeleteJob(*job); err != nil {
+					ctx.Logger().Error(fmt.Sprintf("unable to delete the %s job: %s", job.Kind, err.Error()))
+				}
+			}
+			continue
+		case !job.IsDue(height):
+			continue
+		case job.State == pc.JobSubmitted:
+			k.confirmJob(ctx, n, kp, job)
+		default:
+			k.attemptJob(ctx, n, kp, job, claimTx, proofTx)
+		}
+		if job.State == pc.JobExpired {
+			ctx.Logger().Error(fmt.Sprintf("the %s job for app: %s, at sessionHeight: %d expired after %d attempts: %s", job.Kind, job.SessionHeader.ApplicationPubKey, job.SessionHeader.SessionBlockHeight, job.Attempts, job.LastError))
+			pc.RecordOutcome(job.SessionHeader, job.EvidenceType, pc.OutcomeExpired, sdk.ZeroInt(), fmt.Sprintf("the %s was not confirmed before its deadline", job.Kind))
+		}
+		if err := pc.SetJob(*job); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("unable to update the %s job: %s", job.Kind, err.Error()))
+		}
+	}
+	pc.ReportJobs(jobs)
+}
+
+// "attemptJob" - broadcasts the claim or proof tx of the job
+func (k Keeper) attemptJob(ctx sdk.Ctx, n client.Client, kp crypto.Signer, job *pc.Job,
+	claimTx func(pk crypto.Signer, cliCtx util.CLIContext, txBuilder auth.TxBuilder, header pc.SessionHeader, totalProofs int64, root pc.HashRange, evidenceType pc.EvidenceType) (*sdk.TxResponse, error),
+	proofTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, merkleProof pc.MerkleProof, leafNode pc.Proof, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)) {
+	height := ctx.BlockHeight()
+	if height > job.DeadlineHeight {
+		job.State = pc.JobExpired
+		return
+	}
+	var res *sdk.TxResponse
+	var totalProofs int64
+	var err error
+	switch job.Kind {
+	case pc.ClaimJob:
+		// the claim may already be in the world state (e.g. sent before a restart)
+		if _, found := k.GetClaim(ctx, sdk.Address(kp.PublicKey().Address()), job.SessionHeader, job.EvidenceType); found {
+			job.State = pc.JobConfirmed
+			return
+		}
+		res, totalProofs, err = k.sendClaim(ctx, kp, n, *job, claimTx)
+	case pc.ProofJob:
+		res, err = k.sendProof(ctx, kp, n, *job, proofTx)
+	default:
+		err = fmt.Errorf("unknown job kind: %s", job.Kind)
+	}
+	if err == nil && res != nil && res.Code != 0 {
+		err = fmt.Errorf("the %s tx was rejected with code %d: %s", job.Kind, res.Code, res.RawLog)
+	}
+	if err == nil && res == nil {
+		err = fmt.Errorf("no response for the %s tx", job.Kind)
+	}
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured executing the %s transaction (attempt %d):\n%s", job.Kind, job.Attempts+1, err.Error()))
+		job.Failed(height, err)
+		return
+	}
+	job.Submitted(height, res.TxHash)
+	switch job.Kind {
+	case pc.ClaimJob:
+		pc.RecordClaim(job.SessionHeader, job.EvidenceType, totalProofs, res.TxHash)
+	case pc.ProofJob:
+		pc.RecordProof(job.SessionHeader, job.EvidenceType, res.TxHash)
+	}
+}
+
+// "confirmJob" - looks up the tx of a submitted job, confirms it if included or schedules a retry
+func (k Keeper) confirmJob(ctx sdk.Ctx, n client.Client, kp crypto.Signer, job *pc.Job) {
+	height := ctx.BlockHeight()
+	hash, err := hex.DecodeString(job.TxHash)
+	if err == nil {
+		res, er := n.Tx(hash, false)
+		if er == nil && res != nil {
+			if res.TxResult.Code == 0 {
+				job.State = pc.JobConfirmed
+				return
+			}
+			job.Failed(height, fmt.Errorf("the %s tx failed with code %d: %s", job.Kind, res.TxResult.Code, res.TxResult.Log))
+			return
+		}
+	}
+	// the tx may not be indexed, so check the world state as well
+	_, found := k.GetClaim(ctx, sdk.Address(kp.PublicKey().Address()), job.SessionHeader, job.EvidenceType)
+	if job.Kind == pc.ClaimJob && found {
+		job.State = pc.JobConfirmed
+		return
+	}
+	if job.Kind == pc.ProofJob && !found {
+		// a claim is deleted when proven but also when expired, so without its tx the proof is not confirmed;
+		// it can not be sent again either
+		job.Failed(height, fmt.Errorf("the %s tx %s was not found and its claim is gone", job.Kind, job.TxHash))
+		job.State = pc.JobExpired
+		return
+	}
+	if height-job.SubmittedHeight >= pc.JobConfirmationBlocks {
+		job.Failed(height, fmt.Errorf("the %s tx %s was not included after %d blocks", job.Kind, job.TxHash, pc.JobConfirmationBlocks))
+		return
+	}
+	job.NextAttemptHeight = height + 1
+}
@@ -17,7 +17,7 @@ func (k Keeper) GetNode(ctx sdk.Ctx, address sdk.Address) (n exported.ValidatorI
 }
 
 func (k Keeper) GetSelfAddress(ctx sdk.Ctx) sdk.Address {
-	kp, err := k.GetPKFromFile(ctx)
+	kp, err := k.GetSigner(ctx)
 	if err != nil {
 		ctx.Logger().Error("Unable to retrieve selfAddress: " + err.Error())
 		return nil
@@ -25,13 +25,14 @@ func (k Keeper) GetSelfAddress(ctx sdk.Ctx) sdk.Address {
 	return sdk.Address(kp.PublicKey().Address())
 }
 
-func (k Keeper) GetSelfPrivKey(ctx sdk.Ctx) (crypto.PrivateKey, sdk.Error) {
-	// get the private key from the private validator file
-	pk, er := k.GetPKFromFile(ctx)
+// "GetSelfSigner" - Returns the signer of the servicer key (self node)
+func (k Keeper) GetSelfSigner(ctx sdk.Ctx) (crypto.Signer, sdk.Error) {
+	// get the signer (remote or the private validator file)
+	s, er := pc.GetSigner()
 	if er != nil {
-		return nil, pc.NewKeybaseError(pc.ModuleName, er)
+		return nil, er
 	}
-	return pk, nil
+	return s, nil
 }
 
 // "GetSelfNode" - Gets self node (private val key) from the world state
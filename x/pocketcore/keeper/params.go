@@ -59,6 +59,12 @@ func (k Keeper) MinimumNumberOfProofs(ctx sdk.Ctx) (res int64) {
 	return
 }
 
+// "StateHistory" - Returns the number of blocks of state history (PrevCtx) needed to serve and settle the sessions:
+// the claim submission window and the claim expiration of a session, plus the session itself and one of margin
+func (k Keeper) StateHistory(ctx sdk.Ctx) int64 {
+	return (k.ClaimSubmissionWindow(ctx) + k.ClaimExpiration(ctx) + 2) * k.BlocksPerSession(ctx)
+}
+
 // "GetParams" - Returns all module parameters in a `Params` struct
 func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 	return types.Params{
@@ -54,6 +54,12 @@ func TestKeeper_ClaimSubmissionWindow(t *testing.T) {
 	assert.Equal(t, types.DefaultClaimSubmissionWindow, proofWaiting)
 }
 
+func TestKeeper_StateHistory(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	expected := (types.DefaultClaimSubmissionWindow + types.DefaultClaimExpiration + 2) * int64(nodeTypes.DefaultSessionBlocktime)
+	assert.Equal(t, expected, keeper.StateHistory(ctx))
+}
+
 func TestKeeper_SupportedBlockchains(t *testing.T) {
 	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
 	supportedBlockchains := keeper.SupportedBlockchains(ctx)
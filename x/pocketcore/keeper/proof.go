@@ -14,15 +14,13 @@ import (
 	"reflect"
 )
 
-// auto sends a proof transaction for the claim
-func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, proofTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, merkleProof pc.MerkleProof, leafNode pc.Proof, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)) {
-	kp, err := k.GetPKFromFile(ctx)
-	if err != nil {
-		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the pk from the file for the Proof Transaction:\n%v", err))
+// "ScheduleProofs" - Schedules a proof job for every mature claim of this node
+func (k Keeper) ScheduleProofs(ctx sdk.Ctx) {
+	// get the self address
+	addr := k.GetSelfAddress(ctx)
+	if addr == nil {
 		return
 	}
-	// get the self address
-	addr := sdk.Address(kp.PublicKey().Address())
 	// get all mature (waiting period has passed) claims for your address
 	claims, err := k.GetMatureClaims(ctx, addr)
 	if err != nil {
@@ -37,7 +35,9 @@ func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, proofTx func(cliCtx ut
 			ctx.Logger().Info(fmt.Sprintf("the evidence object for evidence is not found, ignoring pending claim for app: %s, at sessionHeight: %d", claim.SessionHeader.ApplicationPubKey, claim.SessionHeader.SessionBlockHeight))
 			continue
 		}
-		if ctx.BlockHeight()-claim.SessionHeader.SessionBlockHeight > int64(pc.GlobalPocketConfig.MaxClaimAgeForProofRetry) {
+		maxAgeHeight := claim.SessionHeader.SessionBlockHeight + int64(pc.GlobalPocketConfig.MaxClaimAgeForProofRetry)
+		if ctx.BlockHeight() > maxAgeHeight {
+			pc.RecordOutcome(claim.SessionHeader, claim.EvidenceType, pc.OutcomeExpired, sdk.ZeroInt(), "older than the max claim age for proof retry")
 			err := pc.DeleteEvidence(claim.SessionHeader, claim.EvidenceType)
 			ctx.Logger().Error(fmt.Sprintf("deleting evidence older than MaxClaimAgeForProofRetry"))
 			if err != nil {
@@ -51,6 +51,8 @@ func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, proofTx func(cliCtx ut
 			if err != nil {
 				ctx.Logger().Error(fmt.Sprintf("could not delete evidence is not sealed, could cause a relay leak: %s", err.Error()))
 			}
+			pc.RecordOutcome(claim.SessionHeader, claim.EvidenceType, pc.OutcomeRejected, sdk.ZeroInt(), "the evidence is not sealed")
+			continue
 		}
 		if evidence.NumOfProofs != claim.TotalProofs {
 			err := pc.DeleteEvidence(claim.SessionHeader, claim.EvidenceType)
@@ -58,46 +60,58 @@ func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, proofTx func(cliCtx ut
 			if err != nil {
 				ctx.Logger().Error(fmt.Sprintf("evidence num of proofs does not equal claim total proofs... possible relay leak: %s", err.Error()))
 			}
-		}
-		// get the session context
-		sessionCtx, err := ctx.PrevCtx(claim.SessionHeader.SessionBlockHeight)
-		if err != nil {
-			ctx.Logger().Info(fmt.Sprintf("could not get Session Context, ignoring pending claim for app: %s, at sessionHeight: %d", claim.SessionHeader.ApplicationPubKey, claim.SessionHeader.SessionBlockHeight))
+			pc.RecordOutcome(claim.SessionHeader, claim.EvidenceType, pc.OutcomeRejected, sdk.ZeroInt(), "the evidence does not match the claim")
 			continue
 		}
-		// generate the needed pseudorandom index using the information found in the first transaction
-		index, err := k.getPseudorandomIndex(ctx, claim.TotalProofs, claim.SessionHeader, sessionCtx)
-		if err != nil {
-			ctx.Logger().Error(err.Error())
-			continue
+		// the proof must be submitted before the claim expires (and before the evidence is too old)
+		deadline := claim.ExpirationHeight - 1
+		if deadline > maxAgeHeight {
+			deadline = maxAgeHeight
 		}
-		// get the merkle proof object for the pseudorandom index
-		mProof, leaf := evidence.GenerateMerkleProof(claim.SessionHeader.SessionBlockHeight, int(index))
-		// if prevalidation on, then pre-validate
-		if pc.GlobalPocketConfig.ProofPrevalidation {
-			// validate level count on claim by total relays
-			levelCount := len(mProof.HashRanges)
-			if levelCount != int(math.Ceil(math.Log2(float64(claim.TotalProofs)))) {
-				ctx.Logger().Error(fmt.Sprintf("produced invalid proof for pending claim for app: %s, at sessionHeight: %d, level count", claim.SessionHeader.ApplicationPubKey, claim.SessionHeader.SessionBlockHeight))
-				continue
-			}
-			if !mProof.Validate(claim.SessionHeader.SessionBlockHeight, claim.MerkleRoot, leaf, levelCount) {
-				ctx.Logger().Error(fmt.Sprintf("produced invalid proof for pending claim for app: %s, at sessionHeight: %d", claim.SessionHeader.ApplicationPubKey, claim.SessionHeader.SessionBlockHeight))
-				continue
-			}
-		}
-		// generate the auto txbuilder and clictx
-		txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, &pc.MsgProof{}, n, kp, k)
-		if err != nil {
-			ctx.Logger().Error(fmt.Sprintf("an error occured in the transaction process of the Proof Transaction:\n%v", err))
-			return
+		pc.ScheduleJob(pc.NewJob(pc.ProofJob, claim.SessionHeader, claim.EvidenceType, ctx.BlockHeight(), deadline))
+	}
+}
+
+// "sendProof" - Sends the proof tx of the job
+func (k Keeper) sendProof(ctx sdk.Ctx, kp crypto.Signer, n client.Client, job pc.Job, proofTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, merkleProof pc.MerkleProof, leafNode pc.Proof, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)) (*sdk.TxResponse, error) {
+	claim, found := k.GetClaim(ctx, sdk.Address(kp.PublicKey().Address()), job.SessionHeader, job.EvidenceType)
+	if !found {
+		return nil, fmt.Errorf("the claim to prove was not found")
+	}
+	evidence, err := pc.GetEvidence(claim.SessionHeader, claim.EvidenceType, sdk.ZeroInt())
+	if err != nil || evidence.Proofs == nil || len(evidence.Proofs) == 0 {
+		return nil, fmt.Errorf("the evidence for the proof was not found")
+	}
+	// get the session context
+	sessionCtx, err := ctx.PrevCtx(claim.SessionHeader.SessionBlockHeight)
+	if err != nil {
+		return nil, fmt.Errorf("could not get Session Context for app: %s, at sessionHeight: %d", claim.SessionHeader.ApplicationPubKey, claim.SessionHeader.SessionBlockHeight)
+	}
+	// generate the needed pseudorandom index using the information found in the first transaction
+	index, err := k.getPseudorandomIndex(ctx, claim.TotalProofs, claim.SessionHeader, sessionCtx)
+	if err != nil {
+		return nil, err
+	}
+	// get the merkle proof object for the pseudorandom index
+	mProof, leaf := evidence.GenerateMerkleProof(claim.SessionHeader.SessionBlockHeight, int(index))
+	// if prevalidation on, then pre-validate
+	if pc.GlobalPocketConfig.ProofPrevalidation {
+		// validate level count on claim by total relays
+		levelCount := len(mProof.HashRanges)
+		if levelCount != int(math.Ceil(math.Log2(float64(claim.TotalProofs)))) {
+			return nil, fmt.Errorf("produced invalid proof for pending claim for app: %s, at sessionHeight: %d, level count", claim.SessionHeader.ApplicationPubKey, claim.SessionHeader.SessionBlockHeight)
 		}
-		// send the proof TX
-		_, err = proofTx(cliCtx, txBuilder, mProof, leaf, evidence.EvidenceType)
-		if err != nil {
-			ctx.Logger().Error(err.Error())
+		if !mProof.Validate(claim.SessionHeader.SessionBlockHeight, claim.MerkleRoot, leaf, levelCount) {
+			return nil, fmt.Errorf("produced invalid proof for pending claim for app: %s, at sessionHeight: %d", claim.SessionHeader.ApplicationPubKey, claim.SessionHeader.SessionBlockHeight)
 		}
 	}
+	// generate the auto txbuilder and clictx
+	txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, &pc.MsgProof{}, n, kp, k)
+	if err != nil {
+		return nil, fmt.Errorf("an error occured in the transaction process of the Proof Transaction: %s", err.Error())
+	}
+	// send the proof TX
+	return proofTx(cliCtx, txBuilder, mProof, leaf, evidence.EvidenceType)
 }
 
 func (k Keeper) ValidateProof(ctx sdk.Ctx, proof pc.MsgProof) (servicerAddr sdk.Address, claim pc.MsgClaim, sdkError sdk.Error) {
@@ -151,7 +165,7 @@ func (k Keeper) ValidateProof(ctx sdk.Ctx, proof pc.MsgProof) (servicerAddr sdk.
 		return servicerAddr, claim, pc.NewAppNotFoundError(pc.ModuleName)
 	}
 	// validate the proof depending on the type of proof it is
-	er := proof.GetLeaf().Validate(application.GetChains(), int(k.SessionNodeCount(sessionCtx)), claim.SessionHeader.SessionBlockHeight)
+	er := proof.GetLeaf().Validate(application.GetChains(), application.GetGatewayPublicKeys(), int(k.SessionNodeCount(sessionCtx)), claim.SessionHeader.SessionBlockHeight)
 	if er != nil {
 		return nil, claim, er
 	}
@@ -224,16 +238,13 @@ func (k Keeper) HandleReplayAttack(ctx sdk.Ctx, address sdk.Address, numberOfCha
 	k.posKeeper.BurnForChallenge(ctx, numberOfChallenges.Mul(sdk.NewInt(k.ReplayAttackBurnMultiplier(ctx))), address)
 }
 
-func newTxBuilderAndCliCtx(ctx sdk.Ctx, msg sdk.ProtoMsg, n client.Client, key crypto.PrivateKey, k Keeper) (txBuilder auth.TxBuilder, cliCtx util.CLIContext, err error) {
+func newTxBuilderAndCliCtx(ctx sdk.Ctx, msg sdk.ProtoMsg, n client.Client, key crypto.Signer, k Keeper) (txBuilder auth.TxBuilder, cliCtx util.CLIContext, err error) {
 	// get the from address from the pkf
 	fromAddr := sdk.Address(key.PublicKey().Address())
 	// create a client context for sending
 	cliCtx = util.NewCLIContext(n, fromAddr, "").WithCodec(k.Cdc).WithHeight(ctx.BlockHeight())
-	pk, err := k.GetPKFromFile(ctx)
-	if err != nil {
-		return txBuilder, cliCtx, err
-	}
-	cliCtx.PrivateKey = pk
+	// sign with the servicer key
+	cliCtx.PrivateKey = key
 	// broadcast synchronously
 	cliCtx.BroadcastMode = util.BroadcastSync
 	// get the account to ensure balance
@@ -27,6 +27,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		// endpoint allowing a client to submit a challenge for an invalid relay-response
 		case types.QueryChallenge:
 			return queryChallenge(ctx, req, k)
+		// endpoint allowing a node to retrieve the sessions it belongs to
+		case types.QueryNodeSessions:
+			return queryNodeSessions(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown pocketcore query endpoint")
 		}
@@ -99,6 +102,27 @@ func queryDispatch(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Er
 	return res, nil
 }
 
+// "queryNodeSessions" - Is a handler for the node sessions query
+// The node sessions query allows a node to retrieve the sessions it belongs to (current and previous session)
+func queryNodeSessions(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	// unmarshal data into a query params object
+	var params types.QueryNodeSessionsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	response, er := k.NodeSessions(ctx, params.Address, params.Page, params.PerPage)
+	if er != nil {
+		return nil, er
+	}
+	// marshals the response data into amino-json
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, response)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
 // "queryParameters" - Is a handler for the parameters query
 // Returns all the parameters in the module
 func queryParameters(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
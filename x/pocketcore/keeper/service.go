@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
 )
@@ -12,12 +13,73 @@ import (
 // "HandleRelay" - Handles an api (read/write) request to a non-native (external) blockchain
 func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk.Error) {
 	relayTimeStart := time.Now()
+	// ensure the validity of the relay
+	pk, maxPossibleRelays, err := k.validateRelay(ctx, &relay)
+	if err != nil {
+		return nil, err
+	}
+	// store the proof before execution, because the proof corresponds to the previous relay
+	relay.Proof.Store(maxPossibleRelays)
+	// attempt to execute (or serve from the relay response cache)
+	respPayload, err := relay.ExecuteWithCache(k.GetHostedBlockchains(), ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not send relay with error: %s", err.Error()))
+		return nil, err
+	}
+	// only the relays served are accounted
+	pc.RecordRelay(relay.Proof.SessionHeader(), pc.RelayEvidence)
+	// generate and sign the response object
+	resp, err := k.signRelayResponse(ctx, pk, respPayload, relay.Proof)
+	if err != nil {
+		return nil, err
+	}
+	// track the relay time
+	relayTime := time.Since(relayTimeStart)
+	// add to metrics
+	pc.GlobalServiceMetric().AddRelayTimingFor(relay.Proof.Blockchain, float64(relayTime.Milliseconds()))
+	pc.GlobalServiceMetric().AddRelayFor(relay.Proof.Blockchain)
+	return resp, nil
+}
+
+// "HandleStreamRelay" - Handles a streaming api request to a non-native (external) blockchain
+// the stream is bound to the relay proof and counts as exactly one relay; the signed response carries the digest of the stream
+func (k Keeper) HandleStreamRelay(ctx sdk.Ctx, relay pc.Relay, streamType pc.StreamType, w pc.RelayStreamWriter) (*pc.RelayResponse, sdk.Error) {
+	relayTimeStart := time.Now()
+	// ensure the validity of the relay
+	pk, maxPossibleRelays, err := k.validateRelay(ctx, &relay)
+	if err != nil {
+		return nil, err
+	}
+	// store the proof before execution, the stream is counted once regardless of its length
+	relay.Proof.Store(maxPossibleRelays)
+	// attempt to execute the stream
+	digest, err := relay.ExecuteStream(k.GetHostedBlockchains(), streamType, w)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not stream relay with error: %s", err.Error()))
+		return nil, err
+	}
+	pc.RecordRelay(relay.Proof.SessionHeader(), pc.RelayEvidence)
+	// generate and sign the response object
+	resp, err := k.signRelayResponse(ctx, pk, digest, relay.Proof)
+	if err != nil {
+		return nil, err
+	}
+	// track the relay time
+	relayTime := time.Since(relayTimeStart)
+	// add to metrics
+	pc.GlobalServiceMetric().AddRelayTimingFor(relay.Proof.Blockchain, float64(relayTime.Milliseconds()))
+	pc.GlobalServiceMetric().AddRelayFor(relay.Proof.Blockchain)
+	return resp, nil
+}
+
+// "validateRelay" - Validates the relay against the latest session, returns the self signer and the max possible relays
+func (k Keeper) validateRelay(ctx sdk.Ctx, relay *pc.Relay) (crypto.Signer, sdk.BigInt, sdk.Error) {
 	// get the latest session block height because this relay will correspond with the latest session
 	sessionBlockHeight := k.GetLatestSessionBlockHeight(ctx)
 	// get self node (your validator) from the current state
-	pk, err := k.GetSelfPrivKey(ctx)
+	pk, err := k.GetSelfSigner(ctx)
 	if err != nil {
-		return nil, err
+		return nil, sdk.ZeroInt(), err
 	}
 	selfAddr := sdk.Address(pk.PublicKey().Address())
 	// retrieve the nonNative blockchains your node is hosting
@@ -44,37 +106,32 @@ func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk
 				),
 			)
 		}
-		return nil, err
-	}
-	// store the proof before execution, because the proof corresponds to the previous relay
-	relay.Proof.Store(maxPossibleRelays)
-	// attempt to execute
-	respPayload, err := relay.Execute(hostedBlockchains)
-	if err != nil {
-		ctx.Logger().Error(fmt.Sprintf("could not send relay with error: %s", err.Error()))
-		return nil, err
+		return nil, sdk.ZeroInt(), err
 	}
+	return pk, maxPossibleRelays, nil
+}
+
+// "signRelayResponse" - Generates the relay response object and signs it with the self signer
+func (k Keeper) signRelayResponse(ctx sdk.Ctx, pk crypto.Signer, response string, proof pc.RelayProof) (*pc.RelayResponse, sdk.Error) {
 	// generate response object
 	resp := &pc.RelayResponse{
-		Response: respPayload,
-		Proof:    relay.Proof,
+		Response: response,
+		Proof:    proof,
 	}
 	// sign the response
 	sig, er := pk.Sign(resp.Hash())
 	if er != nil {
 		ctx.Logger().Error(
 			fmt.Sprintf("could not sign response for address: %s with hash: %v, with error: %s",
-				selfAddr.String(), resp.HashString(), er.Error()),
+				sdk.Address(pk.PublicKey().Address()).String(), resp.HashString(), er.Error()),
 		)
+		if _, ok := pk.(*pc.RemoteSigner); ok {
+			return nil, pc.NewRemoteSignerError(pc.ModuleName, er)
+		}
 		return nil, pc.NewKeybaseError(pc.ModuleName, er)
 	}
 	// attach the signature in hex to the response
 	resp.Signature = hex.EncodeToString(sig)
-	// track the relay time
-	relayTime := time.Since(relayTimeStart)
-	// add to metrics
-	pc.GlobalServiceMetric().AddRelayTimingFor(relay.Proof.Blockchain, float64(relayTime.Milliseconds()))
-	pc.GlobalServiceMetric().AddRelayFor(relay.Proof.Blockchain)
 	return resp, nil
 }
 
@@ -116,7 +173,7 @@ func (k Keeper) HandleChallenge(ctx sdk.Ctx, challenge pc.ChallengeProofInvalidD
 		pc.SetSession(session)
 	}
 	// validate the challenge
-	err := challenge.ValidateLocal(header, app.GetMaxRelays(), app.GetChains(), int(k.SessionNodeCount(sessionCtx)), session.SessionNodes, selfNode)
+	err := challenge.ValidateLocal(header, app.GetMaxRelays(), app.GetChains(), app.GetGatewayPublicKeys(), int(k.SessionNodeCount(sessionCtx)), session.SessionNodes, selfNode, k.GetHostedBlockchains().GetResponseComparison(header.Chain))
 	if err != nil {
 		return nil, err
 	}
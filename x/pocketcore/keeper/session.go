@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/hex"
 	sdk "github.com/pokt-network/pocket-core/types"
+	appexported "github.com/pokt-network/pocket-core/x/apps/exported"
 	"github.com/pokt-network/pocket-core/x/nodes/exported"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 )
@@ -50,6 +51,111 @@ func (k Keeper) HandleDispatch(ctx sdk.Ctx, header types.SessionHeader) (*types.
 	}, BlockHeight: ctx.BlockHeight()}, nil
 }
 
+// "NodeSessions" - Returns the sessions the node belongs to at the current and previous session block heights,
+// for a page of the staked applications (so a query only generates the sessions of a bounded number of applications)
+func (k Keeper) NodeSessions(ctx sdk.Ctx, address sdk.Address, page, perPage int) ([]types.NodeSessions, sdk.Error) {
+	page, perPage = types.NodeSessionsPagination(page, perPage)
+	latestSessionBlockHeight := k.GetLatestSessionBlockHeight(ctx)
+	heights := []int64{latestSessionBlockHeight}
+	if previous := latestSessionBlockHeight - k.posKeeper.BlocksPerSession(ctx); previous > 0 {
+		heights = append(heights, previous)
+	}
+	res := make([]types.NodeSessions, 0, len(heights))
+	for _, sessionBlockHeight := range heights {
+		ns, err := k.nodeSessionsAt(ctx, address, sessionBlockHeight, page, perPage)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, ns)
+	}
+	return res, nil
+}
+
+// "nodeSessionsAt" - Generates the sessions of a page of the staked apps (for the chains of the node) and returns the ones of the node
+// the pages are cached per node and session block height; the generated sessions are not added to the sessions cached to serve
+// the relays, so paging through the apps does not evict them
+func (k Keeper) nodeSessionsAt(ctx sdk.Ctx, address sdk.Address, sessionBlockHeight int64, page, perPage int) (types.NodeSessions, sdk.Error) {
+	if ns, found := types.GetNodeSessions(address, sessionBlockHeight, page, perPage); found {
+		return ns, nil
+	}
+	ns, err := k.newNodeSessionsAt(ctx, address, sessionBlockHeight, page, perPage)
+	if err != nil {
+		return types.NodeSessions{}, err
+	}
+	types.SetNodeSessions(ns, perPage)
+	return ns, nil
+}
+
+// "newNodeSessionsAt" - Generates the page of the sessions of the node at the session block height
+func (k Keeper) newNodeSessionsAt(ctx sdk.Ctx, address sdk.Address, sessionBlockHeight int64, page, perPage int) (types.NodeSessions, sdk.Error) {
+	ns := types.NodeSessions{Address: address, SessionBlockHeight: sessionBlockHeight, Sessions: make([]types.Session, 0), Page: page, TotalPages: 1}
+	// get the session context
+	sessionCtx, er := ctx.PrevCtx(sessionBlockHeight)
+	if er != nil {
+		return types.NodeSessions{}, sdk.ErrInternal(er.Error())
+	}
+	// a node not staked at the start of the session belongs to no session
+	node := k.posKeeper.Validator(sessionCtx, address)
+	if node == nil || !node.IsStaked() {
+		return ns, nil
+	}
+	apps, found := types.GetSessionApps(sessionBlockHeight)
+	if !found {
+		apps = make([]appexported.ApplicationI, 0)
+		for _, app := range k.appKeeper.AllApplications(sessionCtx) {
+			if app.IsStaked() {
+				apps = append(apps, app)
+			}
+		}
+		types.SetSessionApps(sessionBlockHeight, apps)
+	}
+	if len(apps) > perPage {
+		ns.TotalPages = (len(apps) + perPage - 1) / perPage
+	}
+	start, end := (page-1)*perPage, page*perPage
+	if start >= len(apps) {
+		return ns, nil
+	}
+	if end > len(apps) {
+		end = len(apps)
+	}
+	blockHashBz, er := sessionCtx.BlockHash(k.Cdc, sessionCtx.BlockHeight())
+	if er != nil {
+		return types.NodeSessions{}, sdk.ErrInternal(er.Error())
+	}
+	blockHash := hex.EncodeToString(blockHashBz)
+	sessionNodeCount := int(k.SessionNodeCount(sessionCtx))
+	for _, app := range apps[start:end] {
+		for _, chain := range app.GetChains() {
+			if !types.NodeHasChain(chain, node) {
+				continue
+			}
+			header := types.SessionHeader{
+				ApplicationPubKey:  app.GetPublicKey().RawString(),
+				Chain:              chain,
+				SessionBlockHeight: sessionBlockHeight,
+			}
+			// check cache
+			session, found := types.GetSession(header)
+			if !found {
+				var err sdk.Error
+				session, err = types.NewSession(sessionCtx, ctx, k.posKeeper, header, blockHash, sessionNodeCount)
+				if err != nil {
+					// not enough nodes for the chain: no session
+					if err.Code() == types.CodeInsufficientNodesError {
+						continue
+					}
+					return types.NodeSessions{}, err
+				}
+			}
+			if session.SessionNodes.Contains(address) {
+				ns.Sessions = append(ns.Sessions, session)
+			}
+		}
+	}
+	return ns, nil
+}
+
 // "IsSessionBlock" - Returns true if current block, is a session block (beginning of a session)
 func (k Keeper) IsSessionBlock(ctx sdk.Ctx) bool {
 	return ctx.BlockHeight()%k.posKeeper.BlocksPerSession(ctx) == 1
@@ -86,4 +192,6 @@ func (k Keeper) IsPocketSupportedBlockchain(ctx sdk.Ctx, chain string) bool {
 
 func (Keeper) ClearSessionCache() {
 	types.ClearSessionCache()
+	// the node sessions are generated from the same state
+	types.ClearNodeSessionsCache()
 }
@@ -4,8 +4,12 @@ import (
 	"encoding/hex"
 	"testing"
 
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesKeeper "github.com/pokt-network/pocket-core/x/nodes/keeper"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
 func TestKeeper_Dispatch(t *testing.T) {
@@ -44,6 +48,64 @@ func TestKeeper_Dispatch(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestKeeper_NodeSessions(t *testing.T) {
+	ctx, _, _, _, keeper, keys, kb := createTestInput(t, false)
+	types.InitConfig(&types.HostedBlockchains{M: make(map[string]types.HostedBlockchain)}, log.NewNopLogger(), sdk.DefaultTestingPocketConfig())
+	types.ClearSessionCache()
+	types.ClearNodeSessionsCache()
+	defer types.ClearSessionCache()
+	defer types.ClearNodeSessionsCache()
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("PrevCtx", mock.Anything).Return(ctx, nil)
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("Logger").Return(ctx.Logger())
+	kp, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	latest := keeper.GetLatestSessionBlockHeight(ctx)
+	apps := len(keeper.appKeeper.AllApplications(ctx))
+	res, er := keeper.NodeSessions(mockCtx, kp.GetAddress(), 0, 0)
+	assert.Nil(t, er)
+	// the current and previous session
+	assert.Len(t, res, 2)
+	assert.Equal(t, latest, res[0].SessionBlockHeight)
+	assert.Equal(t, latest-keeper.posKeeper.BlocksPerSession(ctx), res[1].SessionBlockHeight)
+	// every app is serviced by every node (5 nodes, 5 session nodes)
+	assert.Equal(t, 1, res[0].Page)
+	assert.Equal(t, 1, res[0].TotalPages)
+	assert.Len(t, res[0].Sessions, apps)
+	for _, s := range res[0].Sessions {
+		assert.True(t, s.SessionNodes.Contains(kp.GetAddress()))
+		assert.Equal(t, latest, s.SessionHeader.SessionBlockHeight)
+		// the generated sessions are not added to the sessions served
+		_, found := types.GetSession(s.SessionHeader)
+		assert.False(t, found)
+	}
+	// the page is cached per node and session block height
+	cached, found := types.GetNodeSessions(kp.GetAddress(), latest, 1, types.DefaultNodeSessionsPerPage)
+	assert.True(t, found)
+	assert.Equal(t, res[0], cached)
+	_, found = types.GetSessionApps(latest)
+	assert.True(t, found)
+	// a page of the apps
+	res, er = keeper.NodeSessions(mockCtx, kp.GetAddress(), 2, 1)
+	assert.Nil(t, er)
+	assert.Equal(t, 2, res[0].Page)
+	assert.Equal(t, apps, res[0].TotalPages)
+	assert.Len(t, res[0].Sessions, 1)
+	// out of the pages
+	res, er = keeper.NodeSessions(mockCtx, kp.GetAddress(), apps+1, 1)
+	assert.Nil(t, er)
+	assert.Empty(t, res[0].Sessions)
+	// a node that is not staked belongs to no session
+	res, er = keeper.NodeSessions(mockCtx, sdk.Address(getRandomPubKey().Address()), 0, 0)
+	assert.Nil(t, er)
+	assert.Len(t, res, 2)
+	assert.Empty(t, res[0].Sessions)
+}
+
 func TestKeeper_IsSessionBlock(t *testing.T) {
 	notSessionContext, _, _, _, keeper, _, _ := createTestInput(t, false)
 	assert.False(t, keeper.IsSessionBlock(notSessionContext.WithBlockHeight(977)))
@@ -60,3 +122,58 @@ func TestKeeper_IsPocketSupportedBlockchain(t *testing.T) {
 	assert.True(t, keeper.IsPocketSupportedBlockchain(ctx, "ethereum"))
 	assert.False(t, keeper.IsPocketSupportedBlockchain(ctx, notSB))
 }
+
+func TestKeeper_SimulateSessions(t *testing.T) {
+	ctx, vals, _, _, keeper, keys, _ := createTestInput(t, false)
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("Logger").Return(ctx.Logger())
+	blocksPerSession := keeper.posKeeper.BlocksPerSession(ctx)
+	latest := keeper.GetLatestSessionBlockHeight(ctx)
+	from := latest - 2*blocksPerSession
+	// only the contexts of the session block heights are used
+	for h := from; h <= latest; h += blocksPerSession {
+		mockCtx.On("PrevCtx", h).Return(ctx.WithBlockHeight(h), nil)
+	}
+	res, er := keeper.SimulateSessions(mockCtx, from, ctx.BlockHeight(), "", "")
+	assert.Nil(t, er)
+	// three session block heights, every app chain has a session
+	apps := keeper.appKeeper.AllApplications(ctx)
+	assert.Equal(t, int64(3*len(apps)), res.Sessions)
+	assert.Len(t, res.AppSessions, 3*len(apps))
+	assert.Equal(t, from, res.AppSessions[0].SessionBlockHeight)
+	assert.Equal(t, latest, res.AppSessions[len(res.AppSessions)-1].SessionBlockHeight)
+	for h := from; h <= latest; h += blocksPerSession {
+		mockCtx.AssertCalled(t, "PrevCtx", h)
+	}
+	// 5 nodes, 5 session nodes: every node is in every session
+	assert.Len(t, res.Nodes, 5)
+	for _, n := range res.Nodes {
+		assert.Equal(t, res.Sessions, n.Selections)
+		assert.InDelta(t, float64(res.Sessions), n.Expected, 1e-9)
+	}
+	assert.InDelta(t, 0, res.Uniformity.ChiSquare, 1e-9)
+	// filter by app
+	appPubKey := apps[0].GetPublicKey().RawString()
+	res, er = keeper.SimulateSessions(mockCtx, from, ctx.BlockHeight(), "", appPubKey)
+	assert.Nil(t, er)
+	assert.Equal(t, int64(3), res.Sessions)
+	for _, as := range res.AppSessions {
+		assert.Equal(t, appPubKey, as.AppPubKey)
+	}
+	// invalid range
+	_, er = keeper.SimulateSessions(mockCtx, ctx.BlockHeight(), from, "", "")
+	assert.NotNil(t, er)
+	// a jailed node leaves fewer eligible nodes than session nodes: the sessions are skipped
+	nk := keeper.posKeeper.(nodesKeeper.Keeper)
+	val := vals[0]
+	val.Jailed = true
+	nk.SetValidator(ctx, val)
+	res, er = keeper.SimulateSessions(mockCtx, from, ctx.BlockHeight(), "", "")
+	assert.Nil(t, er)
+	assert.Zero(t, res.Sessions)
+	assert.Equal(t, int64(3*len(apps)), res.Skipped)
+}
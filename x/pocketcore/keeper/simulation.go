@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// "SimulateSessions" - Replays the session generation of every session block height in [fromHeight, toHeight]
+// (optionally filtered by chain and application) and returns the node selections with their uniformity.
+// The nodes are drawn from and cross checked with the world state of the session block height, as the dispatch
+// at the start of the session does, so a replay does not depend on the nodes jailed or unstaked since
+func (k Keeper) SimulateSessions(ctx sdk.Ctx, fromHeight, toHeight int64, chain, appPubKey string) (types.SessionSimulation, sdk.Error) {
+	if fromHeight < 1 || toHeight < fromHeight || toHeight > ctx.BlockHeight() {
+		return types.SessionSimulation{}, types.NewInvalidBlockHeightError(types.ModuleName)
+	}
+	appSessions := make([]types.AppSessionNodes, 0)
+	selections := make(map[string]int64)
+	expected := make(map[string]float64)
+	var skipped int64
+	// the first session block height of the range
+	blocksPerSession := k.posKeeper.BlocksPerSession(ctx)
+	sessionBlockHeight := ((fromHeight-2+blocksPerSession)/blocksPerSession)*blocksPerSession + 1
+	for sessionBlockHeight <= toHeight {
+		// get the session context
+		sessionCtx, er := ctx.PrevCtx(sessionBlockHeight)
+		if er != nil {
+			return types.SessionSimulation{}, sdk.ErrInternal(er.Error())
+		}
+		blockHashBz, er := sessionCtx.BlockHash(k.Cdc, sessionCtx.BlockHeight())
+		if er != nil {
+			return types.SessionSimulation{}, sdk.ErrInternal(er.Error())
+		}
+		blockHash := hex.EncodeToString(blockHashBz)
+		sessionNodeCount := int(k.SessionNodeCount(sessionCtx))
+		// the nodes eligible for a session of the chain
+		eligible := make(map[string][]sdk.Address)
+		for _, app := range k.appKeeper.AllApplications(sessionCtx) {
+			if !app.IsStaked() || (appPubKey != "" && app.GetPublicKey().RawString() != appPubKey) {
+				continue
+			}
+			for _, c := range app.GetChains() {
+				if chain != "" && c != chain {
+					continue
+				}
+				header := types.SessionHeader{
+					ApplicationPubKey:  app.GetPublicKey().RawString(),
+					Chain:              c,
+					SessionBlockHeight: sessionBlockHeight,
+				}
+				nodes, found := eligible[c]
+				if !found {
+					nodes = k.eligibleSessionNodes(sessionCtx, c)
+					eligible[c] = nodes
+				}
+				// NewSessionNodes only ends once it selected enough eligible nodes
+				if len(nodes) < sessionNodeCount {
+					skipped++
+					continue
+				}
+				session, err := types.NewSession(sessionCtx, sessionCtx, k.posKeeper, header, blockHash, sessionNodeCount)
+				if err != nil {
+					// not enough nodes for the chain: no session
+					if err.Code() == types.CodeInsufficientNodesError {
+						skipped++
+						continue
+					}
+					return types.SessionSimulation{}, err
+				}
+				for _, n := range nodes {
+					expected[n.String()] += float64(len(session.SessionNodes)) / float64(len(nodes))
+				}
+				for _, n := range session.SessionNodes {
+					selections[n.String()]++
+				}
+				appSessions = append(appSessions, types.AppSessionNodes{
+					SessionBlockHeight: sessionBlockHeight,
+					AppPubKey:          header.ApplicationPubKey,
+					Chain:              c,
+					Nodes:              session.SessionNodes,
+				})
+			}
+		}
+		sessionBlockHeight += k.posKeeper.BlocksPerSession(sessionCtx)
+	}
+	return types.NewSessionSimulation(fromHeight, toHeight, chain, appPubKey, appSessions, skipped, selections, expected), nil
+}
+
+// "eligibleSessionNodes" - Returns the nodes of the chain that may be selected for a session (see NewSessionNodes)
+func (k Keeper) eligibleSessionNodes(sessionCtx sdk.Ctx, chain string) []sdk.Address {
+	addrs, _ := k.posKeeper.GetValidatorsByChain(sessionCtx, chain)
+	nodes := make([]sdk.Address, 0, len(addrs))
+	for _, addr := range addrs {
+		node := k.posKeeper.Validator(sessionCtx, addr)
+		if node == nil || node.IsJailed() || !types.NodeHasChain(chain, node) {
+			continue
+		}
+		nodes = append(nodes, addr)
+	}
+	return nodes
+}
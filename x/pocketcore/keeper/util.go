@@ -20,3 +20,12 @@ func (k Keeper) GetPKFromFile(ctx sdk.Ctx) (crypto.PrivateKey, error) {
 	}
 	return pk, nil
 }
+
+// "GetSigner" - Returns the signer of the servicer key (a remote signer or the private key from file)
+func (k Keeper) GetSigner(ctx sdk.Ctx) (crypto.Signer, error) {
+	s, err := types.GetSigner()
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
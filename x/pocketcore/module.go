@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sync/atomic"
 	"time"
 
 	"github.com/pokt-network/pocket-core/codec"
@@ -18,6 +19,8 @@ import (
 var (
 	_ module.AppModule      = AppModule{}
 	_ module.AppModuleBasic = AppModuleBasic{}
+	// set while the claim and proof jobs are processed
+	jobsRunning int32
 )
 
 // "AppModuleBasic" - The fundamental building block of a sdk module
@@ -103,27 +106,42 @@ func (am AppModule) EndBlock(ctx sdk.Ctx, _ abci.RequestEndBlock) []abci.Validat
 	// get self address
 	addr := am.keeper.GetSelfAddress(ctx)
 	if addr != nil {
-		// use the offset as a trigger to see if it's time to attempt to submit proofs
-		if (ctx.BlockHeight()+int64(addr[0]))%blocksPerSession == 1 && ctx.BlockHeight() != 1 {
-			// run go routine because cannot access TmNode during end-block period
-			go func() {
-				// use this sleep timer to bypass the beginBlock lock over transactions
-				time.Sleep(time.Duration(rand.Intn(5000)) * time.Millisecond)
-				s, err := am.keeper.TmNode.Status()
-				if err != nil {
-					ctx.Logger().Error(fmt.Sprintf("could not get status for tendermint node (cannot submit claims/proofs in this state): %s", err.Error()))
-				} else {
-					if !s.SyncInfo.CatchingUp {
-						// auto send the proofs
-						am.keeper.SendClaimTx(ctx, am.keeper, am.keeper.TmNode, ClaimTx)
-						// auto claim the proofs
-						am.keeper.SendProofTx(ctx, am.keeper.TmNode, ProofTx)
-						// clear session cache and db
-						types.ClearSessionCache()
-					}
-				}
-			}()
+		// use the offset as a trigger to see if it's time to schedule claims and proofs
+		schedule := (ctx.BlockHeight()+int64(addr[0]))%blocksPerSession == 1 && ctx.BlockHeight() != 1
+		if !schedule && !types.HasActiveJobs() {
+			return []abci.ValidatorUpdate{}
 		}
+		// run go routine because cannot access TmNode during end-block period
+		go func() {
+			// due jobs are processed every block, but never concurrently; scheduling waits for its turn
+			for !atomic.CompareAndSwapInt32(&jobsRunning, 0, 1) {
+				if !schedule {
+					return
+				}
+				time.Sleep(100 * time.Millisecond)
+			}
+			defer atomic.StoreInt32(&jobsRunning, 0)
+			// use this sleep timer to bypass the beginBlock lock over transactions
+			time.Sleep(time.Duration(rand.Intn(5000)) * time.Millisecond)
+			s, err := am.keeper.TmNode.Status()
+			if err != nil {
+				ctx.Logger().Error(fmt.Sprintf("could not get status for tendermint node (cannot submit claims/proofs in this state): %s", err.Error()))
+				return
+			}
+			if s.SyncInfo.CatchingUp {
+				return
+			}
+			if schedule {
+				// schedule the claims
+				am.keeper.ScheduleClaims(ctx)
+				// schedule the proofs
+				am.keeper.ScheduleProofs(ctx)
+				// clear session cache and db
+				types.ClearSessionCache()
+			}
+			// submit (or confirm) the due claims and proofs
+			am.keeper.ProcessJobs(ctx, am.keeper.TmNode, ClaimTx, ProofTx)
+		}()
 	} else {
 		ctx.Logger().Error("could not get self address in end block")
 	}
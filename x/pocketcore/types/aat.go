@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+
+	sdk "github.com/pokt-network/pocket-core/types"
 )
 
 var (
@@ -54,6 +56,7 @@ func (a AAT) Bytes() []byte {
 		ApplicationPublicKey: a.ApplicationPublicKey,
 		ClientPublicKey:      a.ClientPublicKey,
 		Version:              a.Version,
+		GatewayPublicKey:     a.GatewayPublicKey,
 	})
 	if err != nil {
 		log.Fatal(fmt.Sprintf("an error occured hashing the aat:\n%v", err))
@@ -101,6 +104,12 @@ func (a AAT) ValidateMessage() error {
 	if err := PubKeyVerification(a.ClientPublicKey); err != nil {
 		return err
 	}
+	// check the gateway public key of a delegated aat
+	if a.IsDelegated() {
+		if err := PubKeyVerification(a.GatewayPublicKey); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -109,8 +118,34 @@ func (a AAT) ValidateSignature() error {
 	// check for valid signature
 	messageHash := a.HashString()
 	// verifies the signature with the message of the AAT
-	if err := SignatureVerification(a.ApplicationPublicKey, messageHash, a.ApplicationSignature); err != nil {
+	if err := SignatureVerification(a.SignerPublicKey(), messageHash, a.ApplicationSignature); err != nil {
 		return InvalidTokenSignatureErorr
 	}
 	return nil
 }
+
+// "IsDelegated" - Returns true if the AAT is signed by a gateway on behalf of the application
+func (a AAT) IsDelegated() bool {
+	return a.GatewayPublicKey != ""
+}
+
+// "SignerPublicKey" - Returns the public key that signs the AAT (the gateway of a delegated AAT, else the application)
+func (a AAT) SignerPublicKey() string {
+	if a.IsDelegated() {
+		return a.GatewayPublicKey
+	}
+	return a.ApplicationPublicKey
+}
+
+// "ValidateDelegation" - Confirms the gateway of a delegated AAT is one of the gateways of the application
+func (a AAT) ValidateDelegation(appGatewayPubKeys []string) sdk.Error {
+	if !a.IsDelegated() {
+		return nil
+	}
+	for _, pk := range appGatewayPubKeys {
+		if pk == a.GatewayPublicKey {
+			return nil
+		}
+	}
+	return NewUnauthorizedGatewayError(ModuleName)
+}
@@ -271,3 +271,41 @@ func TestAAT_Validate(t *testing.T) {
 	AAT.ApplicationSignature = hex.EncodeToString(applicationSignature)
 	assert.Nil(t, AAT.Validate())
 }
+
+func TestAAT_Delegated(t *testing.T) {
+	appPrivKey := GetRandomPrivateKey()
+	clientPrivKey := GetRandomPrivateKey()
+	gatewayPrivKey := GetRandomPrivateKey()
+	var AAT = AAT{
+		Version:              "0.0.1",
+		ApplicationPublicKey: appPrivKey.PublicKey().RawString(),
+		ClientPublicKey:      clientPrivKey.PublicKey().RawString(),
+		ApplicationSignature: "",
+	}
+	// the gateway key is not part of a non delegated aat hash
+	hash := AAT.HashString()
+	AAT.GatewayPublicKey = gatewayPrivKey.PublicKey().RawString()
+	assert.True(t, AAT.IsDelegated())
+	assert.NotEqual(t, hash, AAT.HashString())
+	// signed by the application (invalid)
+	appSignature, err := appPrivKey.Sign(AAT.Hash())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	AAT.ApplicationSignature = hex.EncodeToString(appSignature)
+	assert.NotNil(t, AAT.Validate())
+	// signed by the gateway
+	gatewaySignature, err := gatewayPrivKey.Sign(AAT.Hash())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	AAT.ApplicationSignature = hex.EncodeToString(gatewaySignature)
+	assert.Nil(t, AAT.Validate())
+	// the gateway must be delegated by the application
+	assert.NotNil(t, AAT.ValidateDelegation(nil))
+	assert.NotNil(t, AAT.ValidateDelegation([]string{appPrivKey.PublicKey().RawString()}))
+	assert.Nil(t, AAT.ValidateDelegation([]string{AAT.GatewayPublicKey}))
+	// invalid gateway public key
+	AAT.GatewayPublicKey = "abcd"
+	assert.NotNil(t, AAT.ValidateMessage())
+}
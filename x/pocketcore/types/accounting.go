@@ -0,0 +1,443 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+)
+
+var (
+	// local accounting database of the serviced sessions (kept after the evidence is deleted)
+	globalAccountingDB *AccountingDB
+)
+
+// number of shards of the relays counted in memory
+const accountingShards = 32
+
+// "SessionOutcome" - The state of a serviced session in the accounting database
+type SessionOutcome string
+
+const (
+	OutcomeServiced SessionOutcome = "serviced" // relays were served, not claimed yet
+	OutcomeClaimed  SessionOutcome = "claimed"  // the claim tx was sent
+	OutcomeProven   SessionOutcome = "proven"   // the proof was accepted and the node was paid
+	OutcomeExpired  SessionOutcome = "expired"  // the claim or proof window passed
+	OutcomeRejected SessionOutcome = "rejected" // the evidence or the proof was rejected
+)
+
+// "IsValid" - Returns true if the outcome is known
+func (o SessionOutcome) IsValid() bool {
+	switch o {
+	case OutcomeServiced, OutcomeClaimed, OutcomeProven, OutcomeExpired, OutcomeRejected:
+		return true
+	}
+	return false
+}
+
+// "AccountingRecord" - The accounting of a single session (per evidence type) serviced by this node
+type AccountingRecord struct {
+	Chain         string         `json:"chain"`
+	AppPubKey     string         `json:"app_pub_key"`
+	SessionHeight int64          `json:"session_height"`
+	EvidenceType  string         `json:"evidence_type"`
+	Relays        int64          `json:"relays"`         // relays served (and stored as evidence)
+	ClaimedRelays int64          `json:"claimed_relays"` // relays in the claim
+	ClaimTxHash   string         `json:"claim_tx_hash,omitempty"`
+	ProofTxHash   string         `json:"proof_tx_hash,omitempty"`
+	Tokens        sdk.BigInt     `json:"tokens"` // uPOKT earned
+	Outcome       SessionOutcome `json:"outcome"`
+	Reason        string         `json:"reason,omitempty"` // why the session expired or was rejected
+	UpdatedAt     time.Time      `json:"updated_at"`
+}
+
+// "AccountingFilter" - The filter of an accounting query, zero values match everything
+type AccountingFilter struct {
+	Chain         string         `json:"chain,omitempty"`
+	AppPubKey     string         `json:"app_pub_key,omitempty"`
+	SessionHeight int64          `json:"session_height,omitempty"`
+	Outcome       SessionOutcome `json:"outcome,omitempty"`
+}
+
+// "Matches" - Returns true if the record matches the filter
+func (f AccountingFilter) Matches(r AccountingRecord) bool {
+	return (f.Chain == "" || f.Chain == r.Chain) &&
+		(f.AppPubKey == "" || f.AppPubKey == r.AppPubKey) &&
+		(f.SessionHeight == 0 || f.SessionHeight == r.SessionHeight) &&
+		(f.Outcome == "" || f.Outcome == r.Outcome)
+}
+
+// "AccountingSummary" - The totals of the records matching an accounting query
+type AccountingSummary struct {
+	Sessions      int64                    `json:"sessions"`
+	Relays        int64                    `json:"relays"`
+	ClaimedRelays int64                    `json:"claimed_relays"`
+	Tokens        sdk.BigInt               `json:"tokens"`
+	Outcomes      map[SessionOutcome]int64 `json:"outcomes"` // number of sessions per outcome
+}
+
+// "AccountingDB" - The persisted accounting records w/ mutex
+// the relays and the record updates are kept in memory and persisted in batches (periodically and on query),
+// off the relay path and the execution of the blocks
+type AccountingDB struct {
+	DB      db.DB                             // persisted
+	logger  log.Logger                        // logger
+	l       sync.Mutex                        // lock of the persisted records (a single flush at a time)
+	shards  [accountingShards]accountingShard // relays not persisted yet
+	ul      sync.Mutex                        // lock of the updates
+	updates []accountingUpdate                // record updates not persisted yet, in order
+	stop    chan struct{}
+}
+
+// "accountingShard" - The relays counted per session (and evidence type) w/ mutex
+type accountingShard struct {
+	l      sync.Mutex
+	relays map[string]*relayCount
+}
+
+// "relayCount" - The relays served for a session (and evidence type) since the last flush
+type relayCount struct {
+	header       SessionHeader
+	evidenceType EvidenceType
+	relays       int64
+}
+
+// "accountingUpdate" - A record update not persisted yet
+type accountingUpdate struct {
+	header       SessionHeader
+	evidenceType EvidenceType
+	f            func(r *AccountingRecord)
+}
+
+// the interval the accounting kept in memory is persisted at
+const accountingFlushInterval = 10 * time.Second
+
+// "NewAccountingDB" - Returns an accounting database persisted in d
+func NewAccountingDB(d db.DB, logger log.Logger) *AccountingDB {
+	return &AccountingDB{DB: d, logger: logger}
+}
+
+// "InitAccounting" - Initializes the accounting database and persists it periodically
+func InitAccounting(dir, name string, options config.LevelDBOptions, logger log.Logger) {
+	d, err := sdk.NewLevelDB(name, dir, options.ToGoLevelDBOpts())
+	if err != nil {
+		panic(fmt.Sprintf("unable to open the accounting database: %s", err.Error()))
+	}
+	globalAccountingDB = NewAccountingDB(d, logger)
+	globalAccountingDB.start(accountingFlushInterval)
+}
+
+// "accountingKey" - chain/app/height/type; the height is zero padded so records iterate in session order
+func accountingKey(header SessionHeader, evidenceType EvidenceType) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d/%d", header.Chain, header.ApplicationPubKey, header.SessionBlockHeight, evidenceType))
+}
+
+// "accountingPrefix" - returns the key prefix of the records matching the filter (chain, then app)
+func accountingPrefix(filter AccountingFilter) []byte {
+	switch {
+	case filter.Chain == "":
+		return nil
+	case filter.AppPubKey == "":
+		return []byte(filter.Chain + "/")
+	default:
+		return []byte(filter.Chain + "/" + filter.AppPubKey + "/")
+	}
+}
+
+// "start" - persists the accounting kept in memory every interval, so a crash only loses the last interval
+func (a *AccountingDB) start(interval time.Duration) {
+	a.stop = make(chan struct{})
+	stop := a.stop
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if err := a.Flush(); err != nil {
+					a.logger.Error(fmt.Sprintf("unable to persist the accounting: %s", err.Error()))
+				}
+			}
+		}
+	}()
+}
+
+// "addRelay" - counts a relay of the session in memory, only its shard is locked
+func (a *AccountingDB) addRelay(header SessionHeader, evidenceType EvidenceType) {
+	key := accountingKey(header, evidenceType)
+	h := fnv.New32a()
+	_, _ = h.Write(key)
+	shard := &a.shards[h.Sum32()%accountingShards]
+	shard.l.Lock()
+	defer shard.l.Unlock()
+	if shard.relays == nil {
+		shard.relays = make(map[string]*relayCount)
+	}
+	c, ok := shard.relays[string(key)]
+	if !ok {
+		c = &relayCount{header: header, evidenceType: evidenceType}
+		shard.relays[string(key)] = c
+	}
+	c.relays++
+}
+
+// "addUpdate" - queues the update of the record of the session, it is persisted by the next flush
+func (a *AccountingDB) addUpdate(header SessionHeader, evidenceType EvidenceType, f func(r *AccountingRecord)) {
+	a.ul.Lock()
+	defer a.ul.Unlock()
+	a.updates = append(a.updates, accountingUpdate{header: header, evidenceType: evidenceType, f: f})
+}
+
+// "flush" - persists the relays and the updates kept in memory in a single batch; the caller holds the lock of the records
+// the relays are applied before the updates, so a record is complete when its session moves on;
+// what is persisted is only removed from memory once the batch is written, a failed flush is retried by the next one
+func (a *AccountingDB) flush() error {
+	relays := make([][]relayCount, accountingShards)
+	for i := range a.shards {
+		shard := &a.shards[i]
+		shard.l.Lock()
+		for _, c := range shard.relays {
+			relays[i] = append(relays[i], *c)
+		}
+		shard.l.Unlock()
+	}
+	a.ul.Lock()
+	updates := a.updates
+	a.ul.Unlock()
+	// the records updated by the batch
+	records := make(map[string]*AccountingRecord)
+	record := func(header SessionHeader, evidenceType EvidenceType) (*AccountingRecord, error) {
+		key := string(accountingKey(header, evidenceType))
+		if r, ok := records[key]; ok {
+			return r, nil
+		}
+		r, err := a.record(header, evidenceType)
+		if err != nil {
+			return nil, err
+		}
+		records[key] = r
+		return r, nil
+	}
+	for _, shard := range relays {
+		for _, c := range shard {
+			r, err := record(c.header, c.evidenceType)
+			if err != nil {
+				return err
+			}
+			r.Relays += c.relays
+		}
+	}
+	for _, u := range updates {
+		r, err := record(u.header, u.evidenceType)
+		if err != nil {
+			return err
+		}
+		u.f(r)
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	batch := a.DB.NewBatch()
+	defer batch.Close()
+	now := time.Now().UTC()
+	for key, r := range records {
+		r.UpdatedAt = now
+		bz, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		batch.Set([]byte(key), bz)
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	// remove what was persisted, the relays and updates added meanwhile are kept
+	for i, shard := range relays {
+		s := &a.shards[i]
+		s.l.Lock()
+		for _, c := range shard {
+			key := string(accountingKey(c.header, c.evidenceType))
+			if cur, ok := s.relays[key]; ok {
+				if cur.relays -= c.relays; cur.relays <= 0 {
+					delete(s.relays, key)
+				}
+			}
+		}
+		s.l.Unlock()
+	}
+	a.ul.Lock()
+	a.updates = a.updates[len(updates):]
+	a.ul.Unlock()
+	return nil
+}
+
+// "Flush" - Persists the relays and the updates kept in memory
+func (a *AccountingDB) Flush() error {
+	a.l.Lock()
+	defer a.l.Unlock()
+	return a.flush()
+}
+
+// "Stop" - Stops persisting the accounting periodically and persists what is kept in memory
+func (a *AccountingDB) Stop() error {
+	a.l.Lock()
+	defer a.l.Unlock()
+	if a.stop != nil {
+		close(a.stop)
+		a.stop = nil
+	}
+	return a.flush()
+}
+
+// "record" - returns the persisted record of the session (a new one if missing)
+func (a *AccountingDB) record(header SessionHeader, evidenceType EvidenceType) (*AccountingRecord, error) {
+	r := &AccountingRecord{
+		Chain:         header.Chain,
+		AppPubKey:     header.ApplicationPubKey,
+		SessionHeight: header.SessionBlockHeight,
+		EvidenceType:  evidenceTypeName(evidenceType),
+		Tokens:        sdk.ZeroInt(),
+		Outcome:       OutcomeServiced,
+	}
+	bz, err := a.DB.Get(accountingKey(header, evidenceType))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) != 0 {
+		if err := json.Unmarshal(bz, r); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// "Records" - Returns the records matching the filter, newest sessions first
+func (a *AccountingDB) Records(filter AccountingFilter) ([]AccountingRecord, error) {
+	a.l.Lock()
+	defer a.l.Unlock()
+	if err := a.flush(); err != nil {
+		return nil, err
+	}
+	// only the records of the chain (and app) are read
+	it, err := db.IteratePrefix(a.DB, accountingPrefix(filter))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	records := make([]AccountingRecord, 0)
+	for ; it.Valid(); it.Next() {
+		var r AccountingRecord
+		if err := json.Unmarshal(it.Value(), &r); err != nil {
+			return nil, err
+		}
+		if filter.Matches(r) {
+			records = append(records, r)
+		}
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].SessionHeight > records[j].SessionHeight
+	})
+	return records, nil
+}
+
+// "RecordRelay" - Accounts a relay served (and stored as evidence) for the session
+func RecordRelay(header SessionHeader, evidenceType EvidenceType) {
+	if globalAccountingDB == nil {
+		return
+	}
+	globalAccountingDB.addRelay(header, evidenceType)
+}
+
+// "RecordClaim" - Accounts the claim tx sent for the session
+func RecordClaim(header SessionHeader, evidenceType EvidenceType, totalProofs int64, txHash string) {
+	recordAccounting(header, evidenceType, func(r *AccountingRecord) {
+		r.ClaimedRelays = totalProofs
+		r.ClaimTxHash = txHash
+		r.Outcome = OutcomeClaimed
+	})
+}
+
+// "RecordProof" - Accounts the proof tx sent for the session; the outcome is set once the proof is processed
+func RecordProof(header SessionHeader, evidenceType EvidenceType, txHash string) {
+	recordAccounting(header, evidenceType, func(r *AccountingRecord) {
+		r.ProofTxHash = txHash
+	})
+}
+
+// "RecordOutcome" - Accounts the final outcome of the session (and the tokens earned if proven)
+func RecordOutcome(header SessionHeader, evidenceType EvidenceType, outcome SessionOutcome, tokens sdk.BigInt, reason string) {
+	recordAccounting(header, evidenceType, func(r *AccountingRecord) {
+		// a proven session is final
+		if r.Outcome == OutcomeProven {
+			return
+		}
+		r.Outcome = outcome
+		r.Reason = reason
+		r.Tokens = tokens
+	})
+}
+
+// "QueryAccounting" - Returns the accounting records matching the filter (newest sessions first)
+func QueryAccounting(filter AccountingFilter) ([]AccountingRecord, error) {
+	if globalAccountingDB == nil {
+		return nil, fmt.Errorf("the accounting database is not initialized")
+	}
+	return globalAccountingDB.Records(filter)
+}
+
+// "QueryAccountingSummary" - Returns the totals of the accounting records matching the filter
+func QueryAccountingSummary(filter AccountingFilter) (AccountingSummary, error) {
+	records, err := QueryAccounting(filter)
+	if err != nil {
+		return AccountingSummary{}, err
+	}
+	summary := AccountingSummary{Tokens: sdk.ZeroInt(), Outcomes: make(map[SessionOutcome]int64)}
+	for _, r := range records {
+		summary.Sessions++
+		summary.Relays += r.Relays
+		summary.ClaimedRelays += r.ClaimedRelays
+		summary.Tokens = summary.Tokens.Add(r.Tokens)
+		summary.Outcomes[r.Outcome]++
+	}
+	return summary, nil
+}
+
+// "FlushAccounting" - Persists the accounting kept in memory and stops persisting it periodically (on shutdown)
+func FlushAccounting() {
+	if globalAccountingDB == nil {
+		return
+	}
+	if err := globalAccountingDB.Stop(); err != nil {
+		globalAccountingDB.logger.Error(fmt.Sprintf("unable to persist the accounted relays: %s", err.Error()))
+	}
+}
+
+// "recordAccounting" - accounting is best effort and never interrupts servicing; the update is only queued,
+// as it is recorded while the blocks are executed
+func recordAccounting(header SessionHeader, evidenceType EvidenceType, f func(r *AccountingRecord)) {
+	if globalAccountingDB == nil {
+		return
+	}
+	globalAccountingDB.addUpdate(header, evidenceType, f)
+}
+
+// "evidenceTypeName" - returns the name of the evidence type (as used in the receipt queries)
+func evidenceTypeName(et EvidenceType) string {
+	switch et {
+	case RelayEvidence:
+		return "relay"
+	case ChallengeEvidence:
+		return "challenge"
+	default:
+		return "unknown"
+	}
+}
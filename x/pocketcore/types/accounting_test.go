@@ -0,0 +1,168 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestAccounting(t *testing.T) {
+	prev := globalAccountingDB
+	globalAccountingDB = NewAccountingDB(db.NewMemDB(), log.NewNopLogger())
+	defer func() { globalAccountingDB = prev }()
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	appPubKey := getRandomPubKey().RawString()
+	h1 := SessionHeader{ApplicationPubKey: appPubKey, Chain: ethereum, SessionBlockHeight: 1}
+	h2 := SessionHeader{ApplicationPubKey: appPubKey, Chain: bitcoin, SessionBlockHeight: 5}
+	// serve relays
+	for i := 0; i < 3; i++ {
+		RecordRelay(h1, RelayEvidence)
+	}
+	RecordRelay(h2, RelayEvidence)
+	records, err := QueryAccounting(AccountingFilter{})
+	assert.Nil(t, err)
+	assert.Len(t, records, 2)
+	// newest session first
+	assert.Equal(t, int64(5), records[0].SessionHeight)
+	assert.Equal(t, int64(3), records[1].Relays)
+	assert.Equal(t, OutcomeServiced, records[1].Outcome)
+	assert.Equal(t, "relay", records[1].EvidenceType)
+	// claim, prove and get paid for the first session; the second one expires
+	RecordClaim(h1, RelayEvidence, 3, "claimhash")
+	RecordProof(h1, RelayEvidence, "proofhash")
+	RecordOutcome(h1, RelayEvidence, OutcomeProven, sdk.NewInt(300), "")
+	RecordOutcome(h2, RelayEvidence, OutcomeExpired, sdk.ZeroInt(), "the claim window passed")
+	// a proven session is final
+	RecordOutcome(h1, RelayEvidence, OutcomeRejected, sdk.ZeroInt(), "")
+	records, err = QueryAccounting(AccountingFilter{Chain: ethereum})
+	assert.Nil(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, OutcomeProven, records[0].Outcome)
+	assert.Equal(t, int64(3), records[0].ClaimedRelays)
+	assert.Equal(t, "claimhash", records[0].ClaimTxHash)
+	assert.Equal(t, "proofhash", records[0].ProofTxHash)
+	assert.True(t, sdk.NewInt(300).Equal(records[0].Tokens))
+	records, err = QueryAccounting(AccountingFilter{Outcome: OutcomeExpired})
+	assert.Nil(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, bitcoin, records[0].Chain)
+	records, err = QueryAccounting(AccountingFilter{AppPubKey: appPubKey, SessionHeight: 7})
+	assert.Nil(t, err)
+	assert.Len(t, records, 0)
+	summary, err := QueryAccountingSummary(AccountingFilter{AppPubKey: appPubKey})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), summary.Sessions)
+	assert.Equal(t, int64(4), summary.Relays)
+	assert.Equal(t, int64(3), summary.ClaimedRelays)
+	assert.True(t, sdk.NewInt(300).Equal(summary.Tokens))
+	assert.Equal(t, int64(1), summary.Outcomes[OutcomeProven])
+	assert.Equal(t, int64(1), summary.Outcomes[OutcomeExpired])
+}
+
+func TestAccounting_ConcurrentRelays(t *testing.T) {
+	prev := globalAccountingDB
+	d := db.NewMemDB()
+	globalAccountingDB = NewAccountingDB(d, log.NewNopLogger())
+	defer func() { globalAccountingDB = prev }()
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: hex.EncodeToString([]byte{01}), SessionBlockHeight: 1}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				RecordRelay(header, RelayEvidence)
+			}
+		}()
+	}
+	// the relays are only counted in memory
+	bz, err := d.Get(accountingKey(header, RelayEvidence))
+	assert.Nil(t, err)
+	assert.Empty(t, bz)
+	wg.Wait()
+	// and persisted on flush
+	FlushAccounting()
+	bz, err = d.Get(accountingKey(header, RelayEvidence))
+	assert.Nil(t, err)
+	assert.NotEmpty(t, bz)
+	RecordRelay(header, RelayEvidence)
+	records, err := QueryAccounting(AccountingFilter{})
+	assert.Nil(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, int64(1001), records[0].Relays)
+}
+
+// failingDB fails the writes of its batches while fail is set
+type failingDB struct {
+	db.DB
+	fail bool
+}
+
+type failingBatch struct {
+	db.Batch
+	d *failingDB
+}
+
+func (d *failingDB) NewBatch() db.Batch { return failingBatch{Batch: d.DB.NewBatch(), d: d} }
+
+func (b failingBatch) Write() error {
+	if b.d.fail {
+		return errors.New("write failed")
+	}
+	return b.Batch.Write()
+}
+
+func TestAccounting_Flush(t *testing.T) {
+	d := &failingDB{DB: db.NewMemDB(), fail: true}
+	a := NewAccountingDB(d, log.NewNopLogger())
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: hex.EncodeToString([]byte{01}), SessionBlockHeight: 1}
+	other := header
+	other.Chain = hex.EncodeToString([]byte{02})
+	a.addRelay(header, RelayEvidence)
+	a.addRelay(header, RelayEvidence)
+	a.addUpdate(header, RelayEvidence, func(r *AccountingRecord) { r.ClaimTxHash = "claimhash" })
+	// the updates are only queued
+	bz, err := d.Get(accountingKey(header, RelayEvidence))
+	assert.Nil(t, err)
+	assert.Empty(t, bz)
+	// a failed write keeps the relays and the updates for the next flush
+	assert.NotNil(t, a.Flush())
+	a.addRelay(header, RelayEvidence)
+	a.addRelay(other, RelayEvidence)
+	d.fail = false
+	records, err := a.Records(AccountingFilter{Chain: header.Chain})
+	assert.Nil(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, int64(3), records[0].Relays)
+	assert.Equal(t, "claimhash", records[0].ClaimTxHash)
+	// nothing is applied twice
+	assert.Nil(t, a.Flush())
+	records, err = a.Records(AccountingFilter{})
+	assert.Nil(t, err)
+	assert.Len(t, records, 2)
+	for _, r := range records {
+		if r.Chain == header.Chain {
+			assert.Equal(t, int64(3), r.Relays)
+		} else {
+			assert.Equal(t, int64(1), r.Relays)
+		}
+	}
+	// persisted periodically
+	a.start(10 * time.Millisecond)
+	a.addRelay(other, RelayEvidence)
+	assert.Eventually(t, func() bool {
+		bz, err := d.Get(accountingKey(other, RelayEvidence))
+		var r AccountingRecord
+		return err == nil && json.Unmarshal(bz, &r) == nil && r.Relays == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Nil(t, a.Stop())
+}
@@ -29,10 +29,18 @@ func InitConfig(chains *HostedBlockchains, logger log.Logger, c types.Config) {
 		globalEvidenceSealedMap = sync.Map{}
 		globalEvidenceCache.Init(c.PocketConfig.DataDir, c.PocketConfig.EvidenceDBName, c.TendermintConfig.LevelDBOptions, c.PocketConfig.MaxEvidenceCacheEntires)
 		globalSessionCache.Init(c.PocketConfig.DataDir, c.PocketConfig.SessionDBName, c.TendermintConfig.LevelDBOptions, c.PocketConfig.MaxSessionCacheEntries)
+		if c.PocketConfig.AccountingDBName != "" {
+			InitAccounting(c.PocketConfig.DataDir, c.PocketConfig.AccountingDBName, c.TendermintConfig.LevelDBOptions, logger)
+		}
+		if c.PocketConfig.JobsDBName == "" {
+			c.PocketConfig.JobsDBName = types.DefaultJobsDBName
+		}
+		InitJobStore(c.PocketConfig.DataDir, c.PocketConfig.JobsDBName, c.TendermintConfig.LevelDBOptions, logger)
 		InitGlobalServiceMetric(chains, logger, c.PocketConfig.PrometheusAddr, c.PocketConfig.PrometheusMaxOpenfiles)
 	})
 	GlobalPocketConfig = c.PocketConfig
 	SetRPCTimeout(c.PocketConfig.RPCTimeout)
+	InitRelayResponseCache(c.PocketConfig.RelayCacheSize)
 }
 
 func ConvertEvidenceToProto(config types.Config) error {
@@ -94,6 +94,13 @@ const (
 	CodeInvalidExpirationHeightErr       = 88
 	CodeInvalidMerkleRangeError          = 89
 	CodeEvidenceSealed                   = 90
+	CodeStreamExecutionError             = 91
+	CodeNoHealthyBackendError            = 92
+	CodeForbiddenPayloadError            = 93
+	CodeRemoteSignerError                = 94
+	CodeUnauthorizedGatewayError         = 95
+	CodeResponseAgreementError           = 96
+	CodeBatchDeadlineError               = 97
 )
 
 var (
@@ -186,8 +193,43 @@ var (
 	InvalidExpirationHeightErr       = errors.New("the expiration height included in the claim message is invalid (should not be set)")
 	InvalidMerkleRangeError          = errors.New("the merkle hash range is invalid")
 	SealedEvidenceError              = errors.New("the evidence is sealed, either max relays reached or claim already submitted")
+	StreamExecutionError             = errors.New("error executing the streamed relay: ")
+	NoHealthyBackendError            = errors.New("none of the backends of the hosted blockchain are healthy")
+	ForbiddenPayloadError            = errors.New("the relay payload is not allowed by this node: ")
+	RemoteSignerError                = errors.New("the remote signer failed: ")
+	UnauthorizedGatewayError         = errors.New("the gateway that signed the AAT is not delegated by the application")
+	ResponseAgreementError           = errors.New("the minority response agrees with the majority under the response comparison rules of the chain")
+	BatchDeadlineError               = errors.New("the deadline of the batch of relays was reached before the relay ")
 )
 
+func NewBatchDeadlineError(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeBatchDeadlineError, BatchDeadlineError.Error()+reason)
+}
+
+func NewResponseAgreementError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeResponseAgreementError, ResponseAgreementError.Error())
+}
+
+func NewUnauthorizedGatewayError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorizedGatewayError, UnauthorizedGatewayError.Error())
+}
+
+func NewRemoteSignerError(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeRemoteSignerError, RemoteSignerError.Error()+err.Error())
+}
+
+func NewForbiddenPayloadError(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeForbiddenPayloadError, ForbiddenPayloadError.Error()+reason)
+}
+
+func NewNoHealthyBackendError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoHealthyBackendError, NoHealthyBackendError.Error())
+}
+
+func NewStreamExecutionError(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeStreamExecutionError, StreamExecutionError.Error()+err.Error())
+}
+
 func NewSealedEvidenceError(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEvidenceSealed, SealedEvidenceError.Error())
 }
@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// "ClaimStatus" - The status of a piece of evidence in a claim estimate
+type ClaimStatus string
+
+const (
+	ClaimStatusOngoing      ClaimStatus = "ongoing"       // the session is not over, the evidence may still grow
+	ClaimStatusClaimable    ClaimStatus = "claimable"     // the claim can be submitted
+	ClaimStatusClaimed      ClaimStatus = "claimed"       // the claim is in the world state, waiting for the proof
+	ClaimStatusBelowMinimum ClaimStatus = "below_minimum" // not enough proofs to be claimed
+	ClaimStatusUnsupported  ClaimStatus = "unsupported"   // the chain is not pocket supported
+	ClaimStatusExpired      ClaimStatus = "expired"       // the claim window passed
+)
+
+// "ClaimEstimate" - The expected payout of a piece of evidence (work/challenge) if it is claimed and proven
+type ClaimEstimate struct {
+	SessionHeader       SessionHeader `json:"header"`
+	EvidenceType        string        `json:"evidence_type"`
+	Status              ClaimStatus   `json:"status"`
+	TotalProofs         int64         `json:"total_proofs"`
+	MinimumProofs       int64         `json:"minimum_proofs"`
+	ClaimDeadlineHeight int64         `json:"claim_deadline_height"` // the last height the claim may be submitted at
+	ClaimMaturityHeight int64         `json:"claim_maturity_height"` // the first height the proof may be submitted at
+	Reward              sdk.BigInt    `json:"reward"`                // relays * RelaysToTokensMultiplier
+	DAOAllocation       sdk.BigInt    `json:"dao_allocation"`
+	ProposerAllocation  sdk.BigInt    `json:"proposer_allocation"`
+	NodeReward          sdk.BigInt    `json:"node_reward"` // reward - dao and proposer allocations
+	ClaimFee            sdk.BigInt    `json:"claim_fee"`
+	ProofFee            sdk.BigInt    `json:"proof_fee"`
+	NetReward           sdk.BigInt    `json:"net_reward"` // node reward - fees (negative if unprofitable)
+	Profitable          bool          `json:"profitable"`
+}
+
+// "NewClaimEstimate" - Returns a claimable estimate of the evidence, without any reward
+func NewClaimEstimate(evidence Evidence) ClaimEstimate {
+	return ClaimEstimate{
+		SessionHeader:      evidence.SessionHeader,
+		EvidenceType:       evidenceTypeName(evidence.EvidenceType),
+		Status:             ClaimStatusClaimable,
+		TotalProofs:        evidence.NumOfProofs,
+		Reward:             sdk.ZeroInt(),
+		DAOAllocation:      sdk.ZeroInt(),
+		ProposerAllocation: sdk.ZeroInt(),
+		NodeReward:         sdk.ZeroInt(),
+		ClaimFee:           sdk.ZeroInt(),
+		ProofFee:           sdk.ZeroInt(),
+		NetReward:          sdk.ZeroInt(),
+	}
+}
+
+// "ClaimEstimates" - The estimates of every piece of evidence stored and their totals
+type ClaimEstimates struct {
+	Height     int64           `json:"height"`
+	Estimates  []ClaimEstimate `json:"estimates"`
+	NodeReward sdk.BigInt      `json:"node_reward"` // of the claimable and claimed evidence
+	Fees       sdk.BigInt      `json:"fees"`        // still to be paid for the claimable and claimed evidence
+	NetReward  sdk.BigInt      `json:"net_reward"`
+}
+
+// "EstimateReward" - Splits the reward of the relays like the nodes module does (see NodeReward);
+// dao and proposer allocations are percentages
+func EstimateReward(relays int64, relaysToTokensMultiplier sdk.BigInt, daoAllocation, proposerAllocation int64) (reward, toDAO, toProposer, toNode sdk.BigInt) {
+	reward = relaysToTokensMultiplier.Mul(sdk.NewInt(relays))
+	r := reward.ToDec()
+	dao := r.Mul(sdk.NewDec(daoAllocation).QuoInt64(100))
+	proposer := r.Mul(sdk.NewDec(proposerAllocation).QuoInt64(100))
+	// the fee collector truncates the sum of both allocations
+	feesCollected := dao.Add(proposer).TruncateInt()
+	toDAO = dao.TruncateInt()
+	toProposer = feesCollected.Sub(toDAO)
+	toNode = reward.Sub(feesCollected)
+	return
+}
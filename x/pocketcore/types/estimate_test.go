@@ -0,0 +1,16 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateReward(t *testing.T) {
+	reward, toDAO, toProposer, toNode := EstimateReward(100, sdk.NewInt(1000), 10, 1)
+	assert.Equal(t, sdk.NewInt(100000), reward)
+	assert.Equal(t, sdk.NewInt(10000), toDAO)
+	assert.Equal(t, sdk.NewInt(1000), toProposer)
+	assert.Equal(t, sdk.NewInt(89000), toNode)
+}
@@ -20,6 +20,9 @@ type PosKeeper interface {
 	BlocksPerSession(ctx sdk.Ctx) (res int64)
 	StakeDenom(ctx sdk.Ctx) (res string)
 	GetValidatorsByChain(ctx sdk.Ctx, networkID string) (validators []sdk.Address, total int)
+	RelaysToTokensMultiplier(ctx sdk.Ctx) sdk.BigInt
+	DAOAllocation(ctx sdk.Ctx) (res int64)
+	ProposerAllocation(ctx sdk.Ctx) (res int64)
 }
 
 type AppsKeeper interface {
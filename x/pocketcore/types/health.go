@@ -0,0 +1,211 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	DefaultHealthCheckInterval = 10000
+	MinHealthCheckInterval     = 100
+)
+
+// "HealthCheck" - The probe used to determine the health of the backends of a hosted blockchain
+type HealthCheck struct {
+	Method         string `json:"method"`          // json-rpc method used as probe (e.g. eth_blockNumber)
+	Path           string `json:"path"`            // http path used as probe (GET) when no method is set
+	Interval       int64  `json:"interval"`        // time between probes in ms
+	ExpectedResult string `json:"expected_result"` // the result (json-rpc) or body substring (http) expected (optional)
+	MaxBlockLag    int64  `json:"max_block_lag"`   // max blocks behind the highest backend, result is parsed as a block number (optional)
+}
+
+// "Validate" - Validates the health check object
+func (hc HealthCheck) Validate() error {
+	if hc.Method == "" && hc.Path == "" {
+		return fmt.Errorf("either a json-rpc method or an http path is required")
+	}
+	if hc.Interval != 0 && hc.Interval < MinHealthCheckInterval {
+		return fmt.Errorf("the interval must be at least %d ms", MinHealthCheckInterval)
+	}
+	if hc.MaxBlockLag < 0 {
+		return fmt.Errorf("the max block lag cannot be negative")
+	}
+	return nil
+}
+
+// "GetInterval" - Returns the interval between probes
+func (hc HealthCheck) GetInterval() time.Duration {
+	if hc.Interval == 0 {
+		return DefaultHealthCheckInterval * time.Millisecond
+	}
+	return time.Duration(hc.Interval) * time.Millisecond
+}
+
+// "StartHealthChecks" - Starts probing the backends of every hosted blockchain with a health check
+func (c *HostedBlockchains) StartHealthChecks(logger log.Logger) {
+	c.l.Lock()
+	if c.stop != nil {
+		c.l.Unlock()
+		return
+	}
+	c.stop = make(chan struct{})
+	stop := c.stop
+	var chains []HostedBlockchain
+	for _, chain := range c.M {
+		if chain.HealthCheck != nil {
+			chains = append(chains, chain)
+		}
+	}
+	c.l.Unlock()
+	for _, chain := range chains {
+		go func(chain HostedBlockchain) {
+			ticker := time.NewTicker(chain.HealthCheck.GetInterval())
+			defer ticker.Stop()
+			for {
+				c.CheckHealth(chain, logger)
+				select {
+				case <-stop:
+					return
+				case <-ticker.C:
+				}
+			}
+		}(chain)
+	}
+}
+
+// "StopHealthChecks" - Stops probing the backends
+func (c *HostedBlockchains) StopHealthChecks() {
+	c.l.Lock()
+	defer c.l.Unlock()
+	if c.stop != nil {
+		close(c.stop)
+		c.stop = nil
+	}
+}
+
+// "CheckHealth" - Probes every backend of the hosted blockchain once and updates the health state and metrics
+// the result is dropped if the hosted blockchains were swapped during the check
+func (c *HostedBlockchains) CheckHealth(chain HostedBlockchain, logger log.Logger) {
+	if chain.HealthCheck == nil {
+		return
+	}
+	gen := c.generation()
+	backends := chain.GetBackends()
+	heights := make([]int64, len(backends))
+	health := make([]bool, len(backends))
+	var maxHeight int64
+	for i, backend := range backends {
+		height, err := probeBackend(backend, *chain.HealthCheck)
+		if err != nil {
+			logger.Error(fmt.Sprintf("health check failed for backend %d of chain %s: %s", i, chain.ID, err.Error()))
+			continue
+		}
+		health[i], heights[i] = true, height
+		if height > maxHeight {
+			maxHeight = height
+		}
+	}
+	// backends lagging behind the highest backend are unhealthy
+	if chain.HealthCheck.MaxBlockLag > 0 {
+		for i := range backends {
+			if health[i] && maxHeight-heights[i] > chain.HealthCheck.MaxBlockLag {
+				logger.Error(fmt.Sprintf("backend %d of chain %s is %d blocks behind", i, chain.ID, maxHeight-heights[i]))
+				health[i] = false
+			}
+		}
+	}
+	if !c.setHealthAt(gen, chain.ID, health, maxHeight) {
+		logger.Debug(fmt.Sprintf("dropped the health check of chain %s, the hosted chains were swapped", chain.ID))
+		return
+	}
+	if GlobalServiceMetric() != nil {
+		for i := range backends {
+			GlobalServiceMetric().SetBackendHealthFor(chain.ID, i, health[i], heights[i])
+		}
+	}
+}
+
+// "probeBackend" - executes the health probe against a single backend, returns the block height if applicable
+func probeBackend(backend Backend, hc HealthCheck) (height int64, err error) {
+	var req *http.Request
+	url := strings.Trim(backend.URL, `/`)
+	if hc.Method != "" {
+		probe := fmt.Sprintf(`{"jsonrpc":"2.0","method":"%s","params":[],"id":1}`, hc.Method)
+		req, err = http.NewRequest(http.MethodPost, url, bytes.NewBuffer([]byte(probe)))
+		if err != nil {
+			return 0, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+	} else {
+		req, err = http.NewRequest(http.MethodGet, url+"/"+strings.Trim(hc.Path, `/`), nil)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if backend.BasicAuth.Username != "" {
+		req.SetBasicAuth(backend.BasicAuth.Username, backend.BasicAuth.Password)
+	}
+	resp, err := (&http.Client{Timeout: globalRPCTimeout * time.Millisecond}).Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("expected code 200 from health check got %v", resp.StatusCode)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	result := string(body)
+	if hc.Method != "" {
+		var rpcResponse struct {
+			Result json.RawMessage `json:"result"`
+			Error  interface{}     `json:"error"`
+		}
+		if err := json.Unmarshal(body, &rpcResponse); err != nil {
+			return 0, err
+		}
+		if rpcResponse.Error != nil || len(rpcResponse.Result) == 0 {
+			return 0, fmt.Errorf("json-rpc error in health check response: %s", result)
+		}
+		result = strings.Trim(string(rpcResponse.Result), `"`)
+		if hc.ExpectedResult != "" && result != hc.ExpectedResult {
+			return 0, fmt.Errorf("unexpected health check result: %s", result)
+		}
+	} else if hc.ExpectedResult != "" && !strings.Contains(result, hc.ExpectedResult) {
+		return 0, fmt.Errorf("unexpected health check result: %s", result)
+	}
+	if hc.MaxBlockLag > 0 {
+		return parseBlockHeight(strings.Trim(strings.TrimSpace(result), `"`))
+	}
+	// the head is kept when the result is a block number (e.g. eth_blockNumber), the final blocks are cached by it
+	if hc.Method != "" {
+		if height, err := parseBlockHeight(result); err == nil {
+			return height, nil
+		}
+	}
+	return 0, nil
+}
+
+// "parseBlockHeight" - parses a hex (0x prefixed) or decimal block height
+func parseBlockHeight(s string) (int64, error) {
+	h, ok := new(big.Int), false
+	if strings.HasPrefix(s, "0x") {
+		h, ok = h.SetString(strings.TrimPrefix(s, "0x"), 16)
+	} else {
+		h, ok = h.SetString(s, 10)
+	}
+	if !ok || !h.IsInt64() {
+		return 0, fmt.Errorf("unable to parse block height from health check result: %s", s)
+	}
+	return h.Int64(), nil
+}
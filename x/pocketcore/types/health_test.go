@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestHealthCheck_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		hc       HealthCheck
+		hasError bool
+	}{
+		{"Valid json-rpc probe", HealthCheck{Method: "eth_blockNumber", Interval: 1000, MaxBlockLag: 5}, false},
+		{"Valid http probe", HealthCheck{Path: "/health"}, false},
+		{"Invalid no probe", HealthCheck{Interval: 1000}, true},
+		{"Invalid interval", HealthCheck{Method: "eth_blockNumber", Interval: 1}, true},
+		{"Invalid block lag", HealthCheck{Method: "eth_blockNumber", MaxBlockLag: -1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.hasError, tt.hc.Validate() != nil)
+		})
+	}
+}
+
+func TestHostedBlockchains_CheckHealth(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	defer gock.Off()
+	gock.New("https://primary.com").
+		Post("/").
+		Reply(200).
+		BodyString(`{"jsonrpc":"2.0","id":1,"result":"0x64"}`)
+	gock.New("https://secondary.com").
+		Post("/").
+		Reply(200).
+		BodyString(`{"jsonrpc":"2.0","id":1,"result":"0x6e"}`)
+	gock.New("https://tertiary.com").
+		Post("/").
+		Reply(500)
+	chain := HostedBlockchain{
+		ID: ethereum,
+		Backends: []Backend{
+			{URL: "https://primary.com"},
+			{URL: "https://secondary.com"},
+			{URL: "https://tertiary.com"},
+		},
+		HealthCheck: &HealthCheck{Method: "eth_blockNumber", MaxBlockLag: 5},
+	}
+	hb := HostedBlockchains{M: map[string]HostedBlockchain{ethereum: chain}}
+	hb.CheckHealth(chain, log.NewNopLogger())
+	// primary is 10 blocks behind and tertiary is down
+	assert.False(t, hb.IsHealthy(ethereum, 0))
+	assert.True(t, hb.IsHealthy(ethereum, 1))
+	assert.False(t, hb.IsHealthy(ethereum, 2))
+	// the head of the backends
+	assert.Equal(t, int64(0x6e), hb.ChainHeight(ethereum))
+	res, err := hb.GetChainURL(ethereum)
+	assert.Nil(t, err)
+	assert.Equal(t, "https://secondary.com", res)
+}
+
+func TestHostedBlockchains_CheckHealthSwapped(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	hb := &HostedBlockchains{}
+	// the hosted chains are swapped while the backend is probed
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Nil(t, hb.Swap(map[string]HostedBlockchain{ethereum: {ID: ethereum, URL: "https://new.com"}}, log.NewNopLogger()))
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer backend.Close()
+	chain := HostedBlockchain{ID: ethereum, URL: backend.URL, HealthCheck: &HealthCheck{Path: "/health"}}
+	hb.M = map[string]HostedBlockchain{ethereum: chain}
+	hb.CheckHealth(chain, log.NewNopLogger())
+	// the failed probe of the replaced backend does not mark the new one
+	assert.True(t, hb.IsHealthy(ethereum, 0))
+	res, err := hb.GetChainURL(ethereum)
+	assert.Nil(t, err)
+	assert.Equal(t, "https://new.com", res)
+}
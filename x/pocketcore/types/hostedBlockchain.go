@@ -1,14 +1,24 @@
 package types
 
 import (
+	"fmt"
+	"sort"
+	"sync"
+
 	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
 // HostedBlockchain" - An object that represents a local hosted non-native blockchain
 type HostedBlockchain struct {
-	ID        string    `json:"id"`         // network identifier of the hosted blockchain
-	URL       string    `json:"url"`        // url of the hosted blockchain
-	BasicAuth BasicAuth `json:"basic_auth"` // basic http auth optinal
+	ID                 string              `json:"id"`                            // network identifier of the hosted blockchain
+	URL                string              `json:"url"`                           // url of the hosted blockchain
+	BasicAuth          BasicAuth           `json:"basic_auth"`                    // basic http auth optinal
+	Backends           []Backend           `json:"backends,omitempty"`            // ordered failover backends (optional, overrides url)
+	HealthCheck        *HealthCheck        `json:"health_check,omitempty"`        // health probe for the backends (optional)
+	CacheRules         []RelayCacheRule    `json:"cache_rules,omitempty"`         // json-rpc methods whose responses may be cached (optional)
+	PayloadFilter      *PayloadFilter      `json:"payload_filter,omitempty"`      // json-rpc method and REST path allow/deny lists (optional)
+	ResponseComparison *ResponseComparison `json:"response_comparison,omitempty"` // the rules comparing the responses of challenges (optional)
 }
 
 type BasicAuth struct {
@@ -16,26 +26,97 @@ type BasicAuth struct {
 	Password string `json:"password"`
 }
 
+// "Backend" - A single backend url of a hosted blockchain
+type Backend struct {
+	URL       string    `json:"url"`        // url of the backend
+	BasicAuth BasicAuth `json:"basic_auth"` // basic http auth optional
+}
+
+// "GetBackends" - Returns the ordered backends of the hosted blockchain
+func (hb HostedBlockchain) GetBackends() []Backend {
+	if len(hb.Backends) == 0 {
+		return []Backend{{URL: hb.URL, BasicAuth: hb.BasicAuth}}
+	}
+	return hb.Backends
+}
+
 // HostedBlockchains" - An object that represents the local hosted non-native blockchains
 type HostedBlockchains struct {
-	M map[string]HostedBlockchain // M[addr] -> addr, url
+	M      map[string]HostedBlockchain // M[addr] -> addr, url
+	l      sync.RWMutex
+	health map[string][]bool // health[addr] -> healthy per backend (missing means healthy)
+	height map[string]int64  // height[addr] -> the head of the healthy backends (missing means unknown)
+	gen    uint64            // the generation of the chains, incremented by every swap
+	stop   chan struct{}
 }
 
 // "Contains" - Checks to see if the hosted chain is within the HostedBlockchains object
 func (c *HostedBlockchains) Contains(id string) bool {
+	c.l.RLock()
+	defer c.l.RUnlock()
 	// quick map check
 	_, found := c.M[id]
 	return found
 }
 
-// "GetChainURL" - Returns the url or error of the hosted blockchain using the hex network identifier
+// "IDs" - Returns the sorted network identifiers of the hosted blockchains
+func (c *HostedBlockchains) IDs() []string {
+	c.l.RLock()
+	defer c.l.RUnlock()
+	ids := make([]string, 0, len(c.M))
+	for id := range c.M {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// "GetChain" - Returns the hosted blockchain using the hex network identifier
+// the url and basic auth are those of the first healthy backend
 func (c *HostedBlockchains) GetChain(id string) (chain HostedBlockchain, err sdk.Error) {
 	// map check
+	c.l.RLock()
 	res, found := c.M[id]
+	c.l.RUnlock()
 	if !found {
 		return HostedBlockchain{}, NewErrorChainNotHostedError(ModuleName)
 	}
-	return res, nil
+	if len(res.Backends) == 0 && res.HealthCheck == nil {
+		return res, nil
+	}
+	// route to the first healthy backend
+	for i, backend := range res.GetBackends() {
+		if c.IsHealthy(id, i) {
+			res.URL = backend.URL
+			res.BasicAuth = backend.BasicAuth
+			return res, nil
+		}
+	}
+	return HostedBlockchain{}, NewNoHealthyBackendError(ModuleName)
+}
+
+// "CheckPayload" - Checks the payload against the method and path rules of the hosted blockchain (if any)
+func (c *HostedBlockchains) CheckPayload(id string, p Payload) sdk.Error {
+	c.l.RLock()
+	chain, found := c.M[id]
+	c.l.RUnlock()
+	if !found {
+		return NewErrorChainNotHostedError(ModuleName)
+	}
+	if chain.PayloadFilter == nil {
+		return nil
+	}
+	return chain.PayloadFilter.Check(p)
+}
+
+// "GetResponseComparison" - Returns the response comparison rules of the hosted blockchain (nil if none)
+func (c *HostedBlockchains) GetResponseComparison(id string) *ResponseComparison {
+	if c == nil {
+		return nil
+	}
+	c.l.RLock()
+	defer c.l.RUnlock()
+	return c.M[id].ResponseComparison
 }
 
 // "GetChainURL" - Returns the url or error of the hosted blockchain using the hex network identifier
@@ -47,18 +128,129 @@ func (c *HostedBlockchains) GetChainURL(id string) (url string, err sdk.Error) {
 	return chain.URL, nil
 }
 
+// "IsHealthy" - Returns the last known health of a backend; backends never checked are considered healthy
+func (c *HostedBlockchains) IsHealthy(id string, backend int) bool {
+	c.l.RLock()
+	defer c.l.RUnlock()
+	h, found := c.health[id]
+	if !found || backend >= len(h) {
+		return true
+	}
+	return h[backend]
+}
+
+// "SetHealth" - Updates the health of the backends of a hosted blockchain
+func (c *HostedBlockchains) SetHealth(id string, health []bool) {
+	c.l.Lock()
+	defer c.l.Unlock()
+	c.setHealth(id, health)
+}
+
+// "generation" - Returns the generation of the hosted blockchains
+func (c *HostedBlockchains) generation() uint64 {
+	c.l.RLock()
+	defer c.l.RUnlock()
+	return c.gen
+}
+
+// "setHealthAt" - Updates the health and head of the backends if the hosted blockchains are still of the generation
+// the health was checked at; a check started before a swap is dropped, as it probed the replaced backends
+func (c *HostedBlockchains) setHealthAt(gen uint64, id string, health []bool, height int64) bool {
+	c.l.Lock()
+	defer c.l.Unlock()
+	if c.gen != gen {
+		return false
+	}
+	c.setHealth(id, health)
+	if c.height == nil {
+		c.height = make(map[string]int64)
+	}
+	c.height[id] = height
+	return true
+}
+
+// "ChainHeight" - Returns the head of the backends of the hosted blockchain from its last health check (0 if unknown)
+func (c *HostedBlockchains) ChainHeight(id string) int64 {
+	c.l.RLock()
+	defer c.l.RUnlock()
+	return c.height[id]
+}
+
+func (c *HostedBlockchains) setHealth(id string, health []bool) {
+	if c.health == nil {
+		c.health = make(map[string][]bool)
+	}
+	c.health[id] = health
+}
+
+// "Swap" - Atomically replaces the hosted blockchains with the ones in the map and clears the relay response cache
+// the map is validated first; if invalid, the current hosted blockchains are left in place
+func (c *HostedBlockchains) Swap(m map[string]HostedBlockchain, logger log.Logger) error {
+	if err := (&HostedBlockchains{M: m}).Validate(); err != nil {
+		return err
+	}
+	c.StopHealthChecks()
+	c.l.Lock()
+	c.M = m
+	c.health = nil
+	c.height = nil
+	c.gen++
+	c.l.Unlock()
+	// the cached responses were served by the backends of the replaced chains
+	ClearRelayResponseCache()
+	// ensure every new chain has metrics
+	if GlobalServiceMetric() != nil {
+		for id := range m {
+			GlobalServiceMetric().AddChain(id)
+		}
+	}
+	c.StartHealthChecks(logger)
+	return nil
+}
+
 // "Validate" - Validates the hosted blockchain object
 func (c *HostedBlockchains) Validate() error {
+	c.l.RLock()
+	defer c.l.RUnlock()
 	// loop through all of the chains
 	for _, chain := range c.M {
 		// validate not empty
-		if chain.ID == "" || chain.URL == "" {
+		if chain.ID == "" || (chain.URL == "" && len(chain.Backends) == 0) {
 			return NewInvalidHostedChainError(ModuleName)
 		}
 		// validate the merkleHash
 		if err := NetworkIdentifierVerification(chain.ID); err != nil {
 			return err
 		}
+		for _, backend := range chain.Backends {
+			if backend.URL == "" {
+				return NewInvalidHostedChainError(ModuleName)
+			}
+		}
+		// the backends are only failed over on their health, never on the error of a relay (it may not be idempotent)
+		if len(chain.Backends) > 1 && chain.HealthCheck == nil {
+			return fmt.Errorf("%s: %s has %d backends but no health check to fail over", InvalidHostedChainError.Error(), chain.ID, len(chain.Backends))
+		}
+		if chain.HealthCheck != nil {
+			if err := chain.HealthCheck.Validate(); err != nil {
+				return fmt.Errorf("%s: invalid health check for %s: %s", InvalidHostedChainError.Error(), chain.ID, err.Error())
+			}
+		}
+		for _, rule := range chain.CacheRules {
+			if err := rule.Validate(); err != nil {
+				return fmt.Errorf("%s: invalid cache rule for %s: %s", InvalidHostedChainError.Error(), chain.ID, err.Error())
+			}
+		}
+		if chain.PayloadFilter != nil {
+			if err := chain.PayloadFilter.Validate(); err != nil {
+				return fmt.Errorf("%s: invalid payload filter for %s: %s", InvalidHostedChainError.Error(), chain.ID, err.Error())
+			}
+		}
+		if chain.ResponseComparison != nil {
+			if err := chain.ResponseComparison.Validate(); err != nil {
+				return fmt.Errorf("%s: invalid response comparison for %s: %s", InvalidHostedChainError.Error(), chain.ID, err.Error())
+			}
+		}
 	}
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
 func TestHostedBlockchains_GetChainURL(t *testing.T) {
@@ -56,6 +57,15 @@ func TestHostedBlockchains_Validate(t *testing.T) {
 		ID:  hex.EncodeToString([]byte("badlksajfljasdfklj")),
 		URL: url,
 	}
+	HCBackendsNoHealthCheck := HostedBlockchain{
+		ID:       ethereum,
+		Backends: []Backend{{URL: url}, {URL: url}},
+	}
+	HCBackends := HostedBlockchain{
+		ID:          ethereum,
+		Backends:    []Backend{{URL: url}, {URL: url}},
+		HealthCheck: &HealthCheck{Method: "eth_blockNumber"},
+	}
 	tests := []struct {
 		name     string
 		hc       *HostedBlockchains
@@ -76,11 +86,21 @@ func TestHostedBlockchains_Validate(t *testing.T) {
 			hc:       &HostedBlockchains{M: map[string]HostedBlockchain{HCInvalidHash.URL: HCInvalidHash}},
 			hasError: true,
 		},
+		{
+			name:     "Invalid HostedBlockchain, backends without health check",
+			hc:       &HostedBlockchains{M: map[string]HostedBlockchain{HCBackendsNoHealthCheck.ID: HCBackendsNoHealthCheck}},
+			hasError: true,
+		},
 		{
 			name:     "Valid HostedBlockchain",
 			hc:       &HostedBlockchains{M: map[string]HostedBlockchain{testHostedBlockchain.ID: testHostedBlockchain}},
 			hasError: false,
 		},
+		{
+			name:     "Valid HostedBlockchain, backends with health check",
+			hc:       &HostedBlockchains{M: map[string]HostedBlockchain{HCBackends.ID: HCBackends}},
+			hasError: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -88,3 +108,56 @@ func TestHostedBlockchains_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestHostedBlockchains_GetChainFailover(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	testHostedBlockchain := HostedBlockchain{
+		ID: ethereum,
+		Backends: []Backend{
+			{URL: "https://primary.com"},
+			{URL: "https://secondary.com", BasicAuth: BasicAuth{Username: "foo", Password: "bar"}},
+		},
+		HealthCheck: &HealthCheck{Method: "eth_blockNumber"},
+	}
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{testHostedBlockchain.ID: testHostedBlockchain},
+	}
+	// never checked backends are healthy
+	chain, err := hb.GetChain(ethereum)
+	assert.Nil(t, err)
+	assert.Equal(t, "https://primary.com", chain.URL)
+	// primary down
+	hb.SetHealth(ethereum, []bool{false, true})
+	chain, err = hb.GetChain(ethereum)
+	assert.Nil(t, err)
+	assert.Equal(t, "https://secondary.com", chain.URL)
+	assert.Equal(t, "foo", chain.BasicAuth.Username)
+	// all down
+	hb.SetHealth(ethereum, []bool{false, false})
+	_, err = hb.GetChain(ethereum)
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeNoHealthyBackendError, int(err.Code()))
+}
+
+func TestHostedBlockchains_Swap(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {ID: ethereum, URL: "https://www.google.com:443"}},
+	}
+	// invalid chains are rejected and the current ones are kept
+	err := hb.Swap(map[string]HostedBlockchain{bitcoin: {ID: bitcoin, URL: ""}}, log.NewNopLogger())
+	assert.NotNil(t, err)
+	assert.True(t, hb.Contains(ethereum))
+	assert.False(t, hb.Contains(bitcoin))
+	// valid chains replace the current ones and clear the cached responses
+	InitRelayResponseCache(10)
+	defer InitRelayResponseCache(0)
+	globalRelayResponseCache.Add("cached", cachedRelayResponse{Response: "{}"})
+	err = hb.Swap(map[string]HostedBlockchain{bitcoin: {ID: bitcoin, URL: "https://www.google.com:443"}}, log.NewNopLogger())
+	assert.Nil(t, err)
+	assert.False(t, hb.Contains(ethereum))
+	assert.True(t, hb.Contains(bitcoin))
+	assert.Equal(t, []string{bitcoin}, hb.IDs())
+	assert.False(t, globalRelayResponseCache.Contains("cached"))
+}
@@ -0,0 +1,297 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+)
+
+var (
+	// persisted claim and proof jobs (retried until confirmed or expired, across restarts)
+	globalJobStore *JobStore
+)
+
+const (
+	// blocks to wait for a submitted tx to be included before it is submitted again
+	JobConfirmationBlocks = 2
+	// the maximum number of blocks between two attempts
+	JobMaxBackoff = 16
+)
+
+// "JobKind" - The transaction submitted by a job
+type JobKind string
+
+const (
+	ClaimJob JobKind = "claim"
+	ProofJob JobKind = "proof"
+)
+
+// "JobState" - The state of a claim or proof job
+type JobState string
+
+const (
+	JobPending   JobState = "pending"   // waiting for its next attempt
+	JobSubmitted JobState = "submitted" // the tx was broadcast, waiting for its inclusion
+	JobConfirmed JobState = "confirmed" // the tx was included in a block
+	JobExpired   JobState = "expired"   // the deadline passed before the tx was confirmed
+)
+
+// "IsFinal" - Returns true if the job will not be attempted again
+func (s JobState) IsFinal() bool {
+	return s == JobConfirmed || s == JobExpired
+}
+
+// "Job" - A persisted claim or proof submission
+type Job struct {
+	Kind              JobKind       `json:"kind"`
+	SessionHeader     SessionHeader `json:"header"`
+	EvidenceType      EvidenceType  `json:"evidence_type"`
+	State             JobState      `json:"state"`
+	Attempts          int           `json:"attempts"`
+	NextAttemptHeight int64         `json:"next_attempt_height"`
+	DeadlineHeight    int64         `json:"deadline_height"` // the last height the tx may be submitted at
+	SubmittedHeight   int64         `json:"submitted_height,omitempty"`
+	TxHash            string        `json:"tx_hash,omitempty"`
+	LastError         string        `json:"last_error,omitempty"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+}
+
+// "NewJob" - Returns a pending job, due at the height
+func NewJob(kind JobKind, header SessionHeader, evidenceType EvidenceType, height, deadline int64) Job {
+	return Job{
+		Kind:              kind,
+		SessionHeader:     header,
+		EvidenceType:      evidenceType,
+		State:             JobPending,
+		NextAttemptHeight: height,
+		DeadlineHeight:    deadline,
+	}
+}
+
+// "Key" - kind/chain/app/height/type
+func (j Job) Key() []byte {
+	return jobKey(j.Kind, j.SessionHeader, j.EvidenceType)
+}
+
+// "IsDue" - Returns true if the job should be attempted (or confirmed) at the height
+func (j Job) IsDue(height int64) bool {
+	return !j.State.IsFinal() && height >= j.NextAttemptHeight
+}
+
+// "Submitted" - Marks the job as broadcast at the height
+func (j *Job) Submitted(height int64, txHash string) {
+	j.Attempts++
+	j.State = JobSubmitted
+	j.SubmittedHeight = height
+	j.TxHash = txHash
+	j.LastError = ""
+	j.NextAttemptHeight = height + 1
+}
+
+// "Failed" - Schedules the next attempt with an exponential backoff; expires the job if past its deadline
+func (j *Job) Failed(height int64, err error) {
+	if j.State != JobSubmitted {
+		// a broadcast failure is an attempt too
+		j.Attempts++
+	}
+	j.State = JobPending
+	j.TxHash = ""
+	if err != nil {
+		j.LastError = err.Error()
+	}
+	j.NextAttemptHeight = height + JobBackoff(j.Attempts)
+	if j.NextAttemptHeight > j.DeadlineHeight {
+		j.NextAttemptHeight = j.DeadlineHeight
+	}
+	if height >= j.DeadlineHeight {
+		j.State = JobExpired
+	}
+}
+
+// "JobBackoff" - Returns the number of blocks to wait after the attempt (1, 2, 4 ... JobMaxBackoff)
+func JobBackoff(attempts int) int64 {
+	if attempts <= 1 {
+		return 1
+	}
+	if attempts > 5 {
+		return JobMaxBackoff
+	}
+	backoff := int64(1) << uint(attempts-1)
+	if backoff > JobMaxBackoff {
+		return JobMaxBackoff
+	}
+	return backoff
+}
+
+// "JobStore" - The persisted jobs w/ mutex
+type JobStore struct {
+	DB     db.DB               // persisted
+	logger log.Logger          // logger
+	active map[string]struct{} // keys of the jobs not final yet
+	l      sync.Mutex          // lock
+}
+
+// "NewJobStore" - Returns the job store persisted in d, indexing its active jobs
+func NewJobStore(d db.DB, logger log.Logger) (*JobStore, error) {
+	js := &JobStore{DB: d, logger: logger, active: make(map[string]struct{})}
+	it, err := d.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var job Job
+		if err := json.Unmarshal(it.Value(), &job); err != nil {
+			return nil, err
+		}
+		if !job.State.IsFinal() {
+			js.active[string(it.Key())] = struct{}{}
+		}
+	}
+	return js, nil
+}
+
+// "InitJobStore" - Initializes the job database
+func InitJobStore(dir, name string, options config.LevelDBOptions, logger log.Logger) {
+	d, err := sdk.NewLevelDB(name, dir, options.ToGoLevelDBOpts())
+	if err != nil {
+		panic(fmt.Sprintf("unable to open the job database: %s", err.Error()))
+	}
+	globalJobStore, err = NewJobStore(d, logger)
+	if err != nil {
+		panic(fmt.Sprintf("unable to load the job database: %s", err.Error()))
+	}
+}
+
+func jobKey(kind JobKind, header SessionHeader, evidenceType EvidenceType) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%020d/%d", kind, header.Chain, header.ApplicationPubKey, header.SessionBlockHeight, evidenceType))
+}
+
+// "ScheduleJob" - Persists the job unless it is already scheduled; returns true if scheduled
+func ScheduleJob(job Job) bool {
+	if globalJobStore == nil {
+		return false
+	}
+	globalJobStore.l.Lock()
+	defer globalJobStore.l.Unlock()
+	if ok, err := globalJobStore.DB.Has(job.Key()); err != nil || ok {
+		return false
+	}
+	if err := globalJobStore.set(job); err != nil {
+		globalJobStore.logger.Error(fmt.Sprintf("unable to schedule the %s job: %s", job.Kind, err.Error()))
+		return false
+	}
+	return true
+}
+
+// "SetJob" - Persists the job
+func SetJob(job Job) error {
+	if globalJobStore == nil {
+		return fmt.Errorf("the job database is not initialized")
+	}
+	globalJobStore.l.Lock()
+	defer globalJobStore.l.Unlock()
+	return globalJobStore.set(job)
+}
+
+func (js *JobStore) set(job Job) error {
+	job.UpdatedAt = time.Now().UTC()
+	bz, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	if err := js.DB.Set(job.Key(), bz); err != nil {
+		return err
+	}
+	if job.State.IsFinal() {
+		delete(js.active, string(job.Key()))
+	} else {
+		js.active[string(job.Key())] = struct{}{}
+	}
+	return nil
+}
+
+// "DeleteJob" - Removes the job
+func DeleteJob(job Job) error {
+	if globalJobStore == nil {
+		return nil
+	}
+	globalJobStore.l.Lock()
+	defer globalJobStore.l.Unlock()
+	if err := globalJobStore.DB.Delete(job.Key()); err != nil {
+		return err
+	}
+	delete(globalJobStore.active, string(job.Key()))
+	return nil
+}
+
+// "GetJobs" - Returns the jobs of the kind and state (all if empty), oldest sessions first
+func GetJobs(kind JobKind, state JobState) ([]Job, error) {
+	if globalJobStore == nil {
+		return nil, fmt.Errorf("the job database is not initialized")
+	}
+	globalJobStore.l.Lock()
+	defer globalJobStore.l.Unlock()
+	it, err := globalJobStore.DB.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	jobs := make([]Job, 0)
+	for ; it.Valid(); it.Next() {
+		var job Job
+		if err := json.Unmarshal(it.Value(), &job); err != nil {
+			return nil, err
+		}
+		if (kind == "" || job.Kind == kind) && (state == "" || job.State == state) {
+			jobs = append(jobs, job)
+		}
+	}
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return jobs[i].SessionHeader.SessionBlockHeight < jobs[j].SessionHeader.SessionBlockHeight
+	})
+	return jobs, nil
+}
+
+// "HasActiveJobs" - Returns true if any job is not final yet (from the index, the store is not read)
+func HasActiveJobs() bool {
+	if globalJobStore == nil {
+		return false
+	}
+	globalJobStore.l.Lock()
+	defer globalJobStore.l.Unlock()
+	return len(globalJobStore.active) != 0
+}
+
+// "ReportJobs" - Sets the job metrics to the number of jobs per kind and state
+func ReportJobs(jobs []Job) {
+	if GlobalServiceMetric() == nil {
+		return
+	}
+	counts := make(map[string]map[JobKind]map[JobState]int)
+	for _, job := range jobs {
+		for _, chain := range []string{"all", job.SessionHeader.Chain} {
+			if counts[chain] == nil {
+				counts[chain] = make(map[JobKind]map[JobState]int)
+			}
+			if counts[chain][job.Kind] == nil {
+				counts[chain][job.Kind] = make(map[JobState]int)
+			}
+			counts[chain][job.Kind][job.State]++
+		}
+	}
+	for _, chain := range append(GlobalServiceMetric().ChainIDs(), "all") {
+		for _, kind := range []JobKind{ClaimJob, ProofJob} {
+			for _, state := range []JobState{JobPending, JobSubmitted, JobConfirmed, JobExpired} {
+				GlobalServiceMetric().SetJobCountFor(chain, string(kind), string(state), counts[chain][kind][state])
+			}
+		}
+	}
+}
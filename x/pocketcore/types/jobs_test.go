@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestJobBackoff(t *testing.T) {
+	assert.Equal(t, int64(1), JobBackoff(0))
+	assert.Equal(t, int64(1), JobBackoff(1))
+	assert.Equal(t, int64(2), JobBackoff(2))
+	assert.Equal(t, int64(4), JobBackoff(3))
+	assert.Equal(t, int64(16), JobBackoff(5))
+	assert.Equal(t, int64(JobMaxBackoff), JobBackoff(100))
+}
+
+func TestJob_Transitions(t *testing.T) {
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: hex.EncodeToString([]byte{01}), SessionBlockHeight: 1}
+	job := NewJob(ClaimJob, header, RelayEvidence, 10, 20)
+	assert.True(t, job.IsDue(10))
+	assert.False(t, job.IsDue(9))
+	// broadcast failure
+	job.Failed(10, fmt.Errorf("broadcast failed"))
+	assert.Equal(t, JobPending, job.State)
+	assert.Equal(t, 1, job.Attempts)
+	assert.Equal(t, int64(11), job.NextAttemptHeight)
+	assert.Equal(t, "broadcast failed", job.LastError)
+	// submitted but not included
+	job.Submitted(11, "hash")
+	assert.Equal(t, JobSubmitted, job.State)
+	assert.Equal(t, 2, job.Attempts)
+	assert.Empty(t, job.LastError)
+	job.Failed(13, fmt.Errorf("not included"))
+	assert.Equal(t, JobPending, job.State)
+	assert.Equal(t, 2, job.Attempts)
+	assert.Equal(t, int64(15), job.NextAttemptHeight)
+	assert.Empty(t, job.TxHash)
+	// the backoff never passes the deadline
+	job.Attempts = 10
+	job.Failed(15, nil)
+	assert.Equal(t, int64(20), job.NextAttemptHeight)
+	assert.Equal(t, JobPending, job.State)
+	// expires at the deadline
+	job.Failed(20, fmt.Errorf("too late"))
+	assert.Equal(t, JobExpired, job.State)
+	assert.False(t, job.IsDue(21))
+}
+
+func TestJobStore(t *testing.T) {
+	prev := globalJobStore
+	d := db.NewMemDB()
+	var err error
+	globalJobStore, err = NewJobStore(d, log.NewNopLogger())
+	assert.Nil(t, err)
+	defer func() { globalJobStore = prev }()
+	appPubKey := getRandomPubKey().RawString()
+	h1 := SessionHeader{ApplicationPubKey: appPubKey, Chain: hex.EncodeToString([]byte{01}), SessionBlockHeight: 5}
+	h2 := SessionHeader{ApplicationPubKey: appPubKey, Chain: hex.EncodeToString([]byte{01}), SessionBlockHeight: 1}
+	assert.False(t, HasActiveJobs())
+	assert.True(t, ScheduleJob(NewJob(ClaimJob, h1, RelayEvidence, 10, 20)))
+	assert.True(t, ScheduleJob(NewJob(ClaimJob, h2, RelayEvidence, 10, 20)))
+	assert.True(t, ScheduleJob(NewJob(ProofJob, h2, RelayEvidence, 30, 40)))
+	// already scheduled
+	assert.False(t, ScheduleJob(NewJob(ClaimJob, h1, RelayEvidence, 12, 22)))
+	assert.True(t, HasActiveJobs())
+	// the active jobs are indexed again when the store is loaded
+	loaded, err := NewJobStore(d, log.NewNopLogger())
+	assert.Nil(t, err)
+	assert.Len(t, loaded.active, 3)
+	jobs, err := GetJobs("", "")
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 3)
+	claims, err := GetJobs(ClaimJob, "")
+	assert.Nil(t, err)
+	assert.Len(t, claims, 2)
+	// oldest session first
+	assert.Equal(t, int64(1), claims[0].SessionHeader.SessionBlockHeight)
+	assert.Equal(t, int64(10), claims[1].NextAttemptHeight)
+	// finalize every job
+	for _, job := range jobs {
+		job.State = JobConfirmed
+		assert.Nil(t, SetJob(job))
+	}
+	assert.False(t, HasActiveJobs())
+	jobs[1].State = JobPending
+	assert.Nil(t, SetJob(jobs[1]))
+	assert.True(t, HasActiveJobs())
+	assert.Nil(t, DeleteJob(jobs[1]))
+	assert.False(t, HasActiveJobs())
+	assert.True(t, ScheduleJob(jobs[1]))
+	jobs[1].State = JobConfirmed
+	assert.Nil(t, SetJob(jobs[1]))
+	confirmed, err := GetJobs("", JobConfirmed)
+	assert.Nil(t, err)
+	assert.Len(t, confirmed, 3)
+	assert.Nil(t, DeleteJob(jobs[0]))
+	jobs, err = GetJobs("", "")
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 2)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/tendermint/tendermint/libs/log"
 	"net/http"
+	"strconv"
 	"sync"
 )
 
@@ -30,6 +31,17 @@ const (
 	SessionsCountHelp       = "the number of unique sessions generated for: "
 	UPOKTCountName          = "tokens_earned_for_"
 	UPOKTCountHelp          = "the number of tokens earned in uPOKT for : "
+	CacheHitCountName       = "relay_cache_hits_for_"
+	CacheHitCountHelp       = "the number of relays served from the relay response cache for: "
+	BackendHealthName       = "backend_health_for_"
+	BackendHealthHelp       = "the health (1 healthy, 0 unhealthy) of each backend of: "
+	BackendHeightName       = "backend_block_height_for_"
+	BackendHeightHelp       = "the block height reported by the health check of each backend of: "
+	BackendLabel            = "backend"
+	JobCountName            = "claim_proof_jobs_for_"
+	JobCountHelp            = "the number of claim and proof jobs (by kind and state) for: "
+	JobKindLabel            = "kind"
+	JobStateLabel           = "state"
 )
 
 type ServiceMetrics struct {
@@ -85,6 +97,16 @@ func (sm *ServiceMetrics) StartPrometheusServer(addr string, maxOpenConn int) *h
 	return srv
 }
 
+// "AddChain" - Adds the metrics for a newly hosted chain, existing metrics are kept
+func (sm *ServiceMetrics) AddChain(networkID string) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	if _, ok := sm.NonNativeChains[networkID]; ok {
+		return
+	}
+	sm.NonNativeChains[networkID] = NewServiceMetricsFor(networkID)
+}
+
 func (sm *ServiceMetrics) AddRelayFor(networkID string) {
 	sm.l.Lock()
 	defer sm.l.Unlock()
@@ -119,6 +141,23 @@ func (sm *ServiceMetrics) AddChallengeFor(networkID string) {
 	sm.NonNativeChains[networkID] = nnc
 }
 
+func (sm *ServiceMetrics) AddCacheHitFor(networkID string) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	// attempt to locate nn chain
+	nnc, ok := sm.NonNativeChains[networkID]
+	if !ok {
+		sm.tmLogger.Error("unable to find corresponding networkID in service metrics: ", networkID)
+		return
+	}
+	// add to accumulated count
+	sm.CacheHitCount.Add(1)
+	// add to individual count
+	nnc.CacheHitCount.Add(1)
+	// update nnc
+	sm.NonNativeChains[networkID] = nnc
+}
+
 func (sm *ServiceMetrics) AddErrorFor(networkID string) {
 	sm.l.Lock()
 	defer sm.l.Unlock()
@@ -187,6 +226,57 @@ func (sm *ServiceMetrics) AddUPOKTEarnedFor(networkID string, upoktEarned float6
 	sm.NonNativeChains[networkID] = nnc
 }
 
+func (sm *ServiceMetrics) SetBackendHealthFor(networkID string, backend int, healthy bool, height int64) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	// attempt to locate nn chain
+	nnc, ok := sm.NonNativeChains[networkID]
+	if !ok {
+		sm.tmLogger.Error("unable to find corresponding networkID in service metrics: ", networkID)
+		return
+	}
+	h := 0.0
+	if healthy {
+		h = 1
+	}
+	// set the individual backend gauges
+	nnc.BackendHealth.With(BackendLabel, strconv.Itoa(backend)).Set(h)
+	nnc.BackendBlockHeight.With(BackendLabel, strconv.Itoa(backend)).Set(float64(height))
+	// update nnc
+	sm.NonNativeChains[networkID] = nnc
+}
+
+// "SetJobCountFor" - Sets the number of jobs of the kind and state, networkID "all" sets the accumulated count
+func (sm *ServiceMetrics) SetJobCountFor(networkID string, kind, state string, count int) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	if networkID == "all" {
+		sm.JobCount.With(JobKindLabel, kind, JobStateLabel, state).Set(float64(count))
+		return
+	}
+	// attempt to locate nn chain
+	nnc, ok := sm.NonNativeChains[networkID]
+	if !ok {
+		sm.tmLogger.Error("unable to find corresponding networkID in service metrics: ", networkID)
+		return
+	}
+	// set the individual job gauge
+	nnc.JobCount.With(JobKindLabel, kind, JobStateLabel, state).Set(float64(count))
+	// update nnc
+	sm.NonNativeChains[networkID] = nnc
+}
+
+// "ChainIDs" - Returns the network identifiers of the chains with metrics
+func (sm *ServiceMetrics) ChainIDs() []string {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	ids := make([]string, 0, len(sm.NonNativeChains))
+	for id := range sm.NonNativeChains {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 func KeyForServiceMetrics() []byte {
 	return []byte(ServiceMetricsKey)
 }
@@ -208,12 +298,16 @@ func NewServiceMetrics(hostedBlockchains *HostedBlockchains, logger log.Logger)
 }
 
 type ServiceMetric struct {
-	RelayCount       metrics.Counter   `json:"relay_count"`
-	ChallengeCount   metrics.Counter   `json:"challenge_count"`
-	ErrCount         metrics.Counter   `json:"err_count"`
-	AverageRelayTime metrics.Histogram `json:"avg_relay_time"`
-	TotalSessions    metrics.Counter   `json:"total_sessions"`
-	UPOKTEarned      metrics.Counter   `json:"upokt_earned"`
+	RelayCount         metrics.Counter   `json:"relay_count"`
+	ChallengeCount     metrics.Counter   `json:"challenge_count"`
+	ErrCount           metrics.Counter   `json:"err_count"`
+	AverageRelayTime   metrics.Histogram `json:"avg_relay_time"`
+	TotalSessions      metrics.Counter   `json:"total_sessions"`
+	UPOKTEarned        metrics.Counter   `json:"upokt_earned"`
+	CacheHitCount      metrics.Counter   `json:"cache_hit_count"`
+	BackendHealth      metrics.Gauge     `json:"backend_health"`
+	BackendBlockHeight metrics.Gauge     `json:"backend_block_height"`
+	JobCount           metrics.Gauge     `json:"job_count"`
 }
 
 func NewServiceMetricsFor(networkID string) ServiceMetric {
@@ -262,12 +356,44 @@ func NewServiceMetricsFor(networkID string) ServiceMetric {
 		Name:      UPOKTCountName + networkID,
 		Help:      UPOKTCountHelp + networkID,
 	}, nil)
+	// cache hit counter metric
+	cacheHitCounter := prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+		Namespace: ModuleName,
+		Subsystem: ServiceMetricsNamespace,
+		Name:      CacheHitCountName + networkID,
+		Help:      CacheHitCountHelp + networkID,
+	}, nil)
+	// backend health metric
+	backendHealth := prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+		Namespace: ModuleName,
+		Subsystem: ServiceMetricsNamespace,
+		Name:      BackendHealthName + networkID,
+		Help:      BackendHealthHelp + networkID,
+	}, []string{BackendLabel})
+	// backend block height metric
+	backendBlockHeight := prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+		Namespace: ModuleName,
+		Subsystem: ServiceMetricsNamespace,
+		Name:      BackendHeightName + networkID,
+		Help:      BackendHeightHelp + networkID,
+	}, []string{BackendLabel})
+	// claim and proof jobs metric
+	jobCount := prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+		Namespace: ModuleName,
+		Subsystem: ServiceMetricsNamespace,
+		Name:      JobCountName + networkID,
+		Help:      JobCountHelp + networkID,
+	}, []string{JobKindLabel, JobStateLabel})
 	return ServiceMetric{
-		RelayCount:       relayCounter,
-		ChallengeCount:   challengeCounter,
-		ErrCount:         errCounter,
-		AverageRelayTime: avgRelayTime,
-		TotalSessions:    totalSessions,
-		UPOKTEarned:      uPOKTEarned,
+		RelayCount:         relayCounter,
+		ChallengeCount:     challengeCounter,
+		ErrCount:           errCounter,
+		AverageRelayTime:   avgRelayTime,
+		TotalSessions:      totalSessions,
+		UPOKTEarned:        uPOKTEarned,
+		CacheHitCount:      cacheHitCounter,
+		BackendHealth:      backendHealth,
+		BackendBlockHeight: backendBlockHeight,
+		JobCount:           jobCount,
 	}
 }
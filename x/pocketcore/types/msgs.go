@@ -102,6 +102,7 @@ type MsgProof struct {
 }
 
 var _ codec.ProtoMarshaler = &MsgProof{}
+var _ sdk.FeatureMsg = MsgProof{}
 
 func (msg *MsgProof) Marshal() ([]byte, error) {
 	m := msg.ToProto()
@@ -204,6 +205,14 @@ func (msg MsgProof) GetRecipient() sdk.Address {
 	return nil
 }
 
+// WithoutInactiveFeatures drops the gateways of the delegated tokens before the application delegation feature
+func (msg MsgProof) WithoutInactiveFeatures(height int64) sdk.ProtoMsg {
+	if !ModuleCdc.IsAfterFeatureUpgrade(codec.AppDelegationFeatureKey, height) {
+		msg.Leaf = withoutGateways(msg.Leaf)
+	}
+	return &msg
+}
+
 func (msg MsgProof) GetLeaf() Proof {
 	return msg.Leaf
 }
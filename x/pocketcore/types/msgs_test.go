@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -316,3 +317,25 @@ func TestMsgProof_GetSignBytes(t *testing.T) {
 		MsgProof{}.GetSignBytes()
 	})
 }
+
+func TestMsgProof_WithoutInactiveFeatures(t *testing.T) {
+	defer func(f map[string]int64) { codec.UpgradeFeatureMap = f }(codec.UpgradeFeatureMap)
+	codec.UpgradeFeatureMap = map[string]int64{codec.AppDelegationFeatureKey: 100}
+	gateway := getRandomPubKey().RawString()
+	proof := RelayProof{Entropy: 1, Token: AAT{Version: "0.0.1", GatewayPublicKey: gateway}}
+	msg := MsgProof{Leaf: proof, EvidenceType: RelayEvidence}
+	// the gateways are dropped before the feature, as the binaries before it do
+	got := msg.WithoutInactiveFeatures(99).(*MsgProof)
+	assert.Empty(t, got.Leaf.(RelayProof).Token.GatewayPublicKey)
+	assert.Equal(t, int64(1), got.Leaf.(RelayProof).Entropy)
+	assert.Equal(t, gateway, msg.Leaf.(RelayProof).Token.GatewayPublicKey)
+	assert.Equal(t, &msg, msg.WithoutInactiveFeatures(100))
+	// the responses of a challenge
+	resp := RelayResponse{Proof: proof}
+	challenge := MsgProof{Leaf: ChallengeProofInvalidData{MajorityResponses: []RelayResponse{resp, resp}, MinorityResponse: resp}, EvidenceType: ChallengeEvidence}
+	stripped := challenge.WithoutInactiveFeatures(99).(*MsgProof).Leaf.(ChallengeProofInvalidData)
+	for _, r := range append(stripped.MajorityResponses, stripped.MinorityResponse) {
+		assert.Empty(t, r.Proof.Token.GatewayPublicKey)
+	}
+	assert.Equal(t, gateway, challenge.Leaf.(ChallengeProofInvalidData).MajorityResponses[0].Proof.Token.GatewayPublicKey)
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	appexported "github.com/pokt-network/pocket-core/x/apps/exported"
+)
+
+const (
+	DefaultNodeSessionsPerPage = 10   // the staked applications checked per page of the node sessions by default
+	MaxNodeSessionsPerPage     = 25   // the maximum staked applications checked per page of the node sessions
+	nodeSessionsCacheSize      = 1000 // the pages of node sessions cached
+	sessionAppsCacheSize       = 4    // the session block heights whose staked applications are cached
+)
+
+var (
+	// the pages of node sessions per node and session block height, apart from the sessions cached to serve the relays
+	globalNodeSessionsCache = sdk.NewCache(nodeSessionsCacheSize)
+	// the staked applications per session block height
+	globalSessionAppsCache = sdk.NewCache(sessionAppsCacheSize)
+)
+
+// "NodeSessions" - The sessions a node belongs to at a session block height, for a page of the staked applications
+type NodeSessions struct {
+	Address            sdk.Address `json:"address"`
+	SessionBlockHeight int64       `json:"session_block_height"`
+	Sessions           []Session   `json:"sessions"`
+	Page               int         `json:"page"`
+	TotalPages         int         `json:"total_pages"`
+}
+
+// "NodeSessionsPagination" - Returns the page and the applications per page of the node sessions within bounds
+func NodeSessionsPagination(page, perPage int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if perPage <= 0 {
+		perPage = DefaultNodeSessionsPerPage
+	}
+	if perPage > MaxNodeSessionsPerPage {
+		perPage = MaxNodeSessionsPerPage
+	}
+	return page, perPage
+}
+
+// "nodeSessionsKey" - address/session block height/page/per page
+func nodeSessionsKey(address sdk.Address, sessionBlockHeight int64, page, perPage int) string {
+	return fmt.Sprintf("%s/%d/%d/%d", address.String(), sessionBlockHeight, page, perPage)
+}
+
+// "GetNodeSessions" - Returns the cached page of the sessions of the node at the session block height
+func GetNodeSessions(address sdk.Address, sessionBlockHeight int64, page, perPage int) (NodeSessions, bool) {
+	ns, found := globalNodeSessionsCache.Get(nodeSessionsKey(address, sessionBlockHeight, page, perPage))
+	if !found {
+		return NodeSessions{}, false
+	}
+	return ns.(NodeSessions), true
+}
+
+// "SetNodeSessions" - Caches the page of the sessions of the node
+func SetNodeSessions(ns NodeSessions, perPage int) {
+	globalNodeSessionsCache.Add(nodeSessionsKey(ns.Address, ns.SessionBlockHeight, ns.Page, perPage), ns)
+}
+
+// "GetSessionApps" - Returns the cached staked applications at the session block height
+func GetSessionApps(sessionBlockHeight int64) ([]appexported.ApplicationI, bool) {
+	apps, found := globalSessionAppsCache.Get(fmt.Sprintf("%d", sessionBlockHeight))
+	if !found {
+		return nil, false
+	}
+	return apps.([]appexported.ApplicationI), true
+}
+
+// "SetSessionApps" - Caches the staked applications at the session block height
+func SetSessionApps(sessionBlockHeight int64, apps []appexported.ApplicationI) {
+	globalSessionAppsCache.Add(fmt.Sprintf("%d", sessionBlockHeight), apps)
+}
+
+// "ClearNodeSessionsCache" - Clears the cached node sessions and staked applications
+func ClearNodeSessionsCache() {
+	globalNodeSessionsCache.Purge()
+	globalSessionAppsCache.Purge()
+}
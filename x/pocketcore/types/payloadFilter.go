@@ -0,0 +1,182 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// "PayloadFilter" - The json-rpc method and REST path rules of a hosted blockchain
+// a rule ending with '*' matches by prefix (e.g. debug_*), paths match on whole segments (/v1/admin matches /v1/admin/peers, not /v1/administrator)
+type PayloadFilter struct {
+	AllowedMethods []string `json:"allowed_methods,omitempty"` // json-rpc methods allowed (all when empty)
+	DeniedMethods  []string `json:"denied_methods,omitempty"`  // json-rpc methods denied
+	AllowedPaths   []string `json:"allowed_paths,omitempty"`   // REST path prefixes allowed (all when empty)
+	DeniedPaths    []string `json:"denied_paths,omitempty"`    // REST path prefixes denied
+}
+
+// "Validate" - Validates the payload filter object
+func (f PayloadFilter) Validate() error {
+	for _, rule := range append(f.AllowedMethods, f.DeniedMethods...) {
+		if rule == "" || strings.Contains(strings.TrimSuffix(rule, "*"), "*") {
+			return fmt.Errorf("invalid method rule: %q", rule)
+		}
+	}
+	for _, rule := range append(f.AllowedPaths, f.DeniedPaths...) {
+		if _, ok := pathSegments(strings.TrimSuffix(rule, "*")); rule == "" || !ok || strings.Contains(strings.TrimSuffix(rule, "*"), "*") {
+			return fmt.Errorf("invalid path rule: %q", rule)
+		}
+	}
+	return nil
+}
+
+// "Check" - Checks the payload against the method and path rules
+func (f PayloadFilter) Check(p Payload) sdk.Error {
+	// path rules
+	if p.Path != "" && (len(f.AllowedPaths) != 0 || len(f.DeniedPaths) != 0) {
+		// the backend resolves the path itself, so anything that could resolve elsewhere than it reads is refused
+		segments, ok := pathSegments(p.Path)
+		if !ok {
+			return NewForbiddenPayloadError(ModuleName, "path "+p.Path)
+		}
+		path := "/" + strings.Join(segments, "/")
+		for _, rule := range f.DeniedPaths {
+			if matchPath(rule, segments) {
+				return NewForbiddenPayloadError(ModuleName, "path "+path)
+			}
+		}
+		if len(f.AllowedPaths) != 0 && !matchAnyPath(f.AllowedPaths, segments) {
+			return NewForbiddenPayloadError(ModuleName, "path "+path)
+		}
+	}
+	// method rules
+	if len(f.AllowedMethods) == 0 && len(f.DeniedMethods) == 0 || p.Data == "" {
+		return nil
+	}
+	methods, ok := jsonRPCMethods(p.Data)
+	if !ok {
+		// the backend may still read a method from a payload that is not parsed here, so it is refused
+		return NewForbiddenPayloadError(ModuleName, "the payload is not a json-rpc request")
+	}
+	for _, method := range methods {
+		for _, rule := range f.DeniedMethods {
+			if matchMethod(rule, method) {
+				return NewForbiddenPayloadError(ModuleName, "method "+method)
+			}
+		}
+		if len(f.AllowedMethods) == 0 {
+			continue
+		}
+		allowed := false
+		for _, rule := range f.AllowedMethods {
+			if matchMethod(rule, method) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return NewForbiddenPayloadError(ModuleName, "method "+method)
+		}
+	}
+	return nil
+}
+
+// "jsonRPCMethods" - returns the method(s) of a single or batch json-rpc request;
+// it is read as the backends do (the first json value, a batch when it starts with '['), and data after it is refused
+func jsonRPCMethods(data string) (methods []string, ok bool) {
+	type request struct {
+		Method *string `json:"method"`
+	}
+	dec := json.NewDecoder(strings.NewReader(data))
+	var first json.RawMessage
+	if err := dec.Decode(&first); err != nil {
+		return nil, false
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, false
+	}
+	var batch []request
+	if trimmed := bytes.TrimSpace(first); len(trimmed) != 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(first, &batch); err != nil {
+			return nil, false
+		}
+	} else {
+		var single request
+		if err := json.Unmarshal(first, &single); err != nil {
+			return nil, false
+		}
+		batch = []request{single}
+	}
+	if len(batch) == 0 {
+		return nil, false
+	}
+	for _, r := range batch {
+		if r.Method == nil {
+			return nil, false
+		}
+		methods = append(methods, *r.Method)
+	}
+	return methods, true
+}
+
+func matchMethod(rule, method string) bool {
+	if strings.HasSuffix(rule, "*") {
+		return strings.HasPrefix(method, strings.TrimSuffix(rule, "*"))
+	}
+	return rule == method
+}
+
+// "pathSegments" - returns the unescaped segments of the path (without its query);
+// false when it can not be unescaped or has a dot segment
+func pathSegments(p string) (segments []string, ok bool) {
+	if i := strings.IndexAny(p, "?#"); i >= 0 {
+		p = p[:i]
+	}
+	unescaped, err := url.PathUnescape(p)
+	if err != nil {
+		return nil, false
+	}
+	for _, segment := range strings.Split(unescaped, "/") {
+		switch segment {
+		case "":
+			continue
+		case ".", "..":
+			return nil, false
+		}
+		segments = append(segments, segment)
+	}
+	return segments, true
+}
+
+// "matchPath" - the path matches when it starts with the segments of the rule;
+// a rule ending with '*' (not '/*') matches its last segment by prefix
+func matchPath(rule string, path []string) bool {
+	prefix := strings.HasSuffix(rule, "*") && !strings.HasSuffix(rule, "/*")
+	segments, ok := pathSegments(strings.TrimSuffix(rule, "*"))
+	if !ok || len(segments) > len(path) {
+		return false
+	}
+	for i, segment := range segments {
+		if prefix && i == len(segments)-1 {
+			return strings.HasPrefix(path[i], segment)
+		}
+		if path[i] != segment {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAnyPath(rules []string, path []string) bool {
+	for _, rule := range rules {
+		if matchPath(rule, path) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayloadFilter_Check(t *testing.T) {
+	filter := PayloadFilter{
+		AllowedMethods: []string{"eth_*", "net_version"},
+		DeniedMethods:  []string{"eth_sendTransaction"},
+		AllowedPaths:   []string{"/v1/"},
+		DeniedPaths:    []string{"/v1/admin"},
+	}
+	tests := []struct {
+		name    string
+		payload Payload
+		allowed bool
+	}{
+		{"allowed method", Payload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`}, true},
+		{"allowed exact method", Payload{Data: `{"jsonrpc":"2.0","method":"net_version","params":[],"id":1}`}, true},
+		{"denied method", Payload{Data: `{"jsonrpc":"2.0","method":"eth_sendTransaction","params":[],"id":1}`}, false},
+		{"not allowed method", Payload{Data: `{"jsonrpc":"2.0","method":"debug_traceTransaction","params":[],"id":1}`}, false},
+		{"batch with a denied method", Payload{Data: `[{"method":"eth_chainId","id":1},{"method":"eth_sendTransaction","id":2}]`}, false},
+		{"batch of allowed methods", Payload{Data: `[{"method":"eth_chainId","id":1},{"method":"net_version","id":2}]`}, true},
+		{"not json-rpc", Payload{Data: "foo"}, false},
+		{"trailing data", Payload{Data: `{"method":"eth_chainId","id":1}xyz`}, false},
+		{"trailing request", Payload{Data: `{"method":"eth_chainId","id":1} {"method":"eth_sendTransaction","id":2}`}, false},
+		{"trailing whitespace", Payload{Data: "{\"method\":\"eth_chainId\",\"id\":1}\n"}, true},
+		{"malformed json", Payload{Data: `{"method":"eth_chainId",`}, false},
+		{"request without a method", Payload{Data: `{"id":1}`}, false},
+		{"empty batch", Payload{Data: `[]`}, false},
+		{"allowed path", Payload{Path: "v1/blocks/latest", Method: "GET"}, true},
+		{"denied path", Payload{Path: "/v1/admin/peers", Method: "GET"}, false},
+		{"not allowed path", Payload{Path: "/v2/blocks", Method: "GET"}, false},
+		{"path with a query", Payload{Path: "/v1/blocks?height=1", Method: "GET"}, true},
+		{"denied path with a query", Payload{Path: "/v1/admin?x=/v1/", Method: "GET"}, false},
+		{"dot segments", Payload{Path: "/v1/x/../admin", Method: "GET"}, false},
+		{"current dot segment", Payload{Path: "/v1/./admin", Method: "GET"}, false},
+		{"escaped dot segments", Payload{Path: "/v1/%2e%2e/admin", Method: "GET"}, false},
+		{"escaped denied path", Payload{Path: "/v1/%61dmin/peers", Method: "GET"}, false},
+		{"escaped slash", Payload{Path: "/v1%2Fadmin", Method: "GET"}, false},
+		{"duplicate slashes", Payload{Path: "/v1//admin", Method: "GET"}, false},
+		{"invalid escape", Payload{Path: "/v1/%zz", Method: "GET"}, false},
+		{"segment sharing the denied prefix", Payload{Path: "/v1/administrator", Method: "GET"}, true},
+		{"segment sharing the allowed prefix", Payload{Path: "/v10/blocks", Method: "GET"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := filter.Check(tt.payload)
+			if tt.allowed {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+				assert.Equal(t, CodeForbiddenPayloadError, int(err.Code()))
+			}
+		})
+	}
+	// a deny list alone refuses the payloads that are not read as json-rpc, e.g. trailing data a backend skips
+	deny := PayloadFilter{DeniedMethods: []string{"admin_*"}}
+	assert.NotNil(t, deny.Check(Payload{Data: "foo"}))
+	assert.NotNil(t, deny.Check(Payload{Data: `{"method":"admin_peers"}xyz`}))
+	assert.NotNil(t, deny.Check(Payload{Data: `{"method":"eth_chainId"`}))
+	assert.Nil(t, deny.Check(Payload{Data: `{"method":"eth_chainId"}`}))
+	// without method rules the payload is not read
+	assert.Nil(t, PayloadFilter{DeniedPaths: []string{"/v1/admin"}}.Check(Payload{Data: "foo"}))
+	// a path rule ending with '*' matches its last segment by prefix
+	prefix := PayloadFilter{DeniedPaths: []string{"/v1/admin*"}}
+	assert.NotNil(t, prefix.Check(Payload{Path: "/v1/administrator"}))
+	assert.Nil(t, prefix.Check(Payload{Path: "/v1/blocks"}))
+	assert.NotNil(t, PayloadFilter{DeniedPaths: []string{"/v1/*"}}.Check(Payload{Path: "/v1/blocks"}))
+	assert.Nil(t, PayloadFilter{DeniedPaths: []string{"/v1/*"}}.Check(Payload{Path: "/v10/blocks"}))
+	// path rules can not have dot segments
+	assert.NotNil(t, PayloadFilter{DeniedPaths: []string{"/v1/../admin"}}.Validate())
+}
+
+func TestHostedBlockchains_CheckPayload(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{
+			ethereum: {ID: ethereum, URL: "https://server.com", PayloadFilter: &PayloadFilter{DeniedMethods: []string{"debug_*"}}},
+			bitcoin:  {ID: bitcoin, URL: "https://server.com"},
+		},
+	}
+	payload := Payload{Data: `{"jsonrpc":"2.0","method":"debug_traceTransaction","params":[],"id":1}`}
+	assert.NotNil(t, hb.CheckPayload(ethereum, payload))
+	assert.Nil(t, hb.CheckPayload(bitcoin, payload))
+	assert.Nil(t, (&HostedBlockchains{M: hb.M}).Validate())
+	invalid := HostedBlockchains{M: map[string]HostedBlockchain{
+		ethereum: {ID: ethereum, URL: "https://server.com", PayloadFilter: &PayloadFilter{AllowedMethods: []string{"eth_*_foo*"}}},
+	}}
+	assert.NotNil(t, invalid.Validate())
+}
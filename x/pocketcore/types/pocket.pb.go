@@ -444,6 +444,7 @@ type AAT struct {
 	ApplicationPublicKey string `protobuf:"bytes,2,opt,name=applicationPublicKey,proto3" json:"app_pub_key"`
 	ClientPublicKey      string `protobuf:"bytes,3,opt,name=clientPublicKey,proto3" json:"client_pub_key"`
 	ApplicationSignature string `protobuf:"bytes,4,opt,name=applicationSignature,proto3" json:"signature"`
+	GatewayPublicKey     string `protobuf:"bytes,5,opt,name=gatewayPublicKey,proto3" json:"gateway_pub_key,omitempty"`
 }
 
 func (m *AAT) Reset()         { *m = AAT{} }
@@ -628,90 +629,92 @@ func init() {
 func init() { proto.RegisterFile("x/pocketcore/pocket.proto", fileDescriptor_fd7cbfa14fd73888) }
 
 var fileDescriptor_fd7cbfa14fd73888 = []byte{
-	// 1322 bytes of a gzipped FileDescriptorProto
+	// 1351 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0xdb, 0xc6,
-	0x13, 0x17, 0x4d, 0xc9, 0x8e, 0x47, 0x94, 0x3f, 0x36, 0x0e, 0xfe, 0x72, 0xfe, 0x80, 0xe9, 0x1a,
-	0x28, 0x62, 0x20, 0x88, 0x8c, 0x3a, 0x6d, 0x50, 0x04, 0x09, 0x50, 0x31, 0x35, 0xea, 0xb4, 0x4d,
-	0xe3, 0xac, 0x8d, 0x1e, 0x7a, 0x21, 0x28, 0x6a, 0x2d, 0xb1, 0xa2, 0xb8, 0xec, 0x72, 0xe5, 0x58,
-	0x6f, 0x90, 0x63, 0x1f, 0xa1, 0xe8, 0xa1, 0x87, 0x3c, 0x43, 0x1f, 0x20, 0xc7, 0x5c, 0x0a, 0xe4,
-	0x50, 0x30, 0x85, 0x7d, 0x23, 0xfa, 0x00, 0x45, 0x4e, 0xc5, 0x7e, 0x50, 0x22, 0x6d, 0xc7, 0x29,
-	0xfa, 0x71, 0x31, 0x57, 0x33, 0xbf, 0x99, 0x9d, 0xef, 0x59, 0xc3, 0xea, 0xf1, 0x56, 0x4c, 0xfd,
-	0x01, 0xe1, 0x3e, 0x65, 0x44, 0x1f, 0x5b, 0x31, 0xa3, 0x9c, 0x22, 0xeb, 0xb8, 0x35, 0x65, 0x5d,
-	0x5f, 0xe9, 0xd1, 0x1e, 0x95, 0x8c, 0x2d, 0x71, 0x52, 0x98, 0x8d, 0x9f, 0x0d, 0x68, 0xec, 0x93,
-	0x24, 0x09, 0x68, 0xb4, 0x4b, 0xbc, 0x2e, 0x61, 0xe8, 0x13, 0x58, 0xf6, 0xe2, 0x38, 0x0c, 0x7c,
-	0x8f, 0x07, 0x34, 0xda, 0x1b, 0x75, 0xbe, 0x20, 0xe3, 0xa6, 0xb1, 0x6e, 0x6c, 0xce, 0x3b, 0x28,
-	0x4b, 0xed, 0x05, 0x2f, 0x8e, 0xdd, 0x78, 0xd4, 0x09, 0x03, 0xdf, 0x1d, 0x90, 0x31, 0x3e, 0x0f,
-	0x46, 0x36, 0xd4, 0xfc, 0xbe, 0x17, 0x44, 0xcd, 0x19, 0x29, 0x35, 0x9f, 0xa5, 0xb6, 0x22, 0x60,
-	0xf5, 0x41, 0x0e, 0xa0, 0x44, 0xdd, 0xe9, 0x84, 0xd4, 0x1f, 0xec, 0x92, 0xa0, 0xd7, 0xe7, 0x4d,
-	0x73, 0xdd, 0xd8, 0x34, 0xd5, 0x1d, 0x9a, 0xeb, 0xf6, 0x25, 0x07, 0x5f, 0x80, 0xbe, 0x5b, 0x7d,
-	0xf6, 0x83, 0x5d, 0xd9, 0x78, 0x65, 0xc0, 0x9c, 0x36, 0x1f, 0x3d, 0x81, 0x46, 0x52, 0xf4, 0x44,
-	0x1a, 0x5d, 0xdf, 0xfe, 0x7f, 0xab, 0x18, 0x86, 0x56, 0xc9, 0x59, 0x67, 0xe1, 0x45, 0x6a, 0x57,
-	0xb2, 0xd4, 0x9e, 0xed, 0xcb, 0xdf, 0xb8, 0xac, 0x01, 0x7d, 0x04, 0xa0, 0x09, 0x22, 0x08, 0xc2,
-	0x1d, 0xcb, 0xb9, 0x96, 0xa5, 0xb6, 0x39, 0x20, 0xe3, 0x37, 0xa9, 0x0d, 0xfb, 0x13, 0x26, 0x2e,
-	0x00, 0xd1, 0x7d, 0xb0, 0xf4, 0xaf, 0xaf, 0x68, 0x97, 0x24, 0x4d, 0x73, 0xdd, 0xdc, 0xb4, 0x9c,
-	0x55, 0x11, 0x87, 0x48, 0x10, 0x9e, 0xbf, 0xb6, 0xad, 0xfd, 0x02, 0x00, 0x97, 0xe0, 0xda, 0xb5,
-	0x5f, 0x4d, 0xb8, 0xf2, 0x28, 0xe9, 0x3d, 0x08, 0xbd, 0x60, 0xf8, 0x5f, 0xf8, 0xf6, 0x25, 0xc0,
-	0x90, 0xb0, 0x41, 0x48, 0x30, 0xa5, 0x5c, 0xfa, 0x56, 0xdf, 0xfe, 0x5f, 0x59, 0xdf, 0xae, 0x97,
-	0xf4, 0xb1, 0x17, 0xf5, 0x88, 0x73, 0x55, 0xeb, 0xaa, 0x2b, 0x11, 0x97, 0x51, 0xca, 0x71, 0x41,
-	0x1e, 0x6d, 0x43, 0x9d, 0x53, 0xee, 0x85, 0x7b, 0x8c, 0xd2, 0xc3, 0x44, 0xe7, 0x72, 0x29, 0x4b,
-	0x6d, 0x4b, 0x92, 0xdd, 0x58, 0xd2, 0x71, 0x11, 0x84, 0x7a, 0x50, 0x3f, 0x64, 0x74, 0xd8, 0xee,
-	0x76, 0x19, 0x49, 0x92, 0x66, 0x55, 0x86, 0x77, 0x47, 0xc8, 0x08, 0xb2, 0xeb, 0x29, 0xfa, 0x9b,
-	0xd4, 0xfe, 0xa0, 0x17, 0xf0, 0xfe, 0xa8, 0xd3, 0xf2, 0xe9, 0x70, 0x2b, 0xa6, 0x03, 0x7e, 0x2b,
-	0x22, 0xfc, 0x29, 0x65, 0x03, 0x5d, 0xee, 0xb7, 0x64, 0xe9, 0xf3, 0x71, 0x4c, 0x92, 0x96, 0x56,
-	0x86, 0x8b, 0x9a, 0xd1, 0x0e, 0x58, 0xe4, 0x28, 0xe8, 0x92, 0xc8, 0x27, 0x07, 0xe3, 0x98, 0x34,
-	0x6b, 0xeb, 0xc6, 0x66, 0xcd, 0x79, 0x2f, 0x4b, 0xed, 0x46, 0x4e, 0x77, 0x85, 0xf8, 0x9b, 0xd4,
-	0xb6, 0x76, 0x0a, 0x40, 0x5c, 0x12, 0x43, 0x6d, 0x58, 0x22, 0xc7, 0x71, 0xc0, 0x64, 0xad, 0xeb,
-	0xa2, 0x9d, 0x95, 0x8e, 0x8a, 0x9a, 0x58, 0x9e, 0xf2, 0xf2, 0xba, 0x3d, 0x07, 0xbf, 0x7b, 0x45,
-	0xa4, 0xf6, 0xd9, 0x8f, 0xb6, 0xb1, 0xf1, 0xbb, 0x01, 0x8d, 0x47, 0x49, 0x6f, 0x4f, 0x74, 0xa1,
-	0x8c, 0x07, 0xc2, 0xa0, 0xa3, 0x2b, 0x7f, 0xea, 0x0c, 0xaf, 0x96, 0x33, 0xf2, 0x68, 0x0a, 0x70,
-	0xae, 0xe9, 0x9c, 0x34, 0x74, 0x4e, 0xf2, 0x10, 0x17, 0x94, 0xa0, 0x3b, 0x50, 0x0d, 0x89, 0x77,
-	0xa8, 0xd3, 0xbb, 0x52, 0x56, 0x26, 0x21, 0x0f, 0x1d, 0x4b, 0xeb, 0x91, 0x48, 0x2c, 0xff, 0x9e,
-	0x8b, 0x98, 0xf9, 0xb7, 0x22, 0x56, 0x70, 0xf7, 0x27, 0x03, 0x66, 0xd5, 0x7d, 0xe8, 0x2e, 0x00,
-	0x23, 0xa1, 0x37, 0x2e, 0xba, 0xd9, 0x2c, 0x5b, 0x86, 0x27, 0xfc, 0xdd, 0x0a, 0x2e, 0xa0, 0xd1,
-	0x13, 0x58, 0xf0, 0xfb, 0x5e, 0x18, 0x92, 0xa8, 0xa7, 0xc3, 0xa4, 0x3c, 0xbb, 0x51, 0x96, 0x7f,
-	0x50, 0xc2, 0x3c, 0x8c, 0x8e, 0xbc, 0x30, 0xe8, 0x7e, 0xea, 0x71, 0x6f, 0xb7, 0x82, 0xcf, 0x28,
-	0x50, 0xdd, 0xe6, 0xcc, 0x41, 0x4d, 0xc6, 0x6f, 0xe3, 0x74, 0x06, 0x1a, 0x32, 0x29, 0xb9, 0x5b,
-	0x68, 0x0b, 0xa0, 0x13, 0x52, 0x3a, 0x74, 0xc6, 0x9c, 0x24, 0xd2, 0x5e, 0xcb, 0x59, 0x14, 0xbd,
-	0x20, 0xa9, 0x6e, 0x47, 0x90, 0x71, 0x01, 0x82, 0xbe, 0x3e, 0xdb, 0xac, 0x33, 0xef, 0x6e, 0xd6,
-	0xab, 0x59, 0x6a, 0x2f, 0x4e, 0x42, 0x7b, 0x71, 0xc7, 0xde, 0x86, 0x7a, 0x34, 0x1a, 0x3e, 0x3e,
-	0x2c, 0xf5, 0xd8, 0xb2, 0xc8, 0x49, 0x34, 0x1a, 0xba, 0xf4, 0x70, 0x52, 0x01, 0x05, 0x14, 0xfa,
-	0x0c, 0x66, 0x15, 0xb9, 0x59, 0x5d, 0x37, 0xdf, 0x5a, 0x03, 0xab, 0xf9, 0xac, 0x50, 0xd8, 0xe7,
-	0xaf, 0xed, 0x39, 0xc5, 0x49, 0xb0, 0x26, 0xfd, 0x4b, 0x4d, 0xa4, 0x87, 0xdb, 0x33, 0x13, 0x60,
-	0x9a, 0x64, 0x31, 0x3d, 0x18, 0xf9, 0x6e, 0x44, 0x12, 0x2e, 0x46, 0x8e, 0xde, 0x36, 0x72, 0x7a,
-	0x68, 0xb2, 0xdb, 0x17, 0xa3, 0xa8, 0x08, 0x42, 0xef, 0xc3, 0x1c, 0x89, 0x38, 0xa3, 0xb1, 0x1a,
-	0xcc, 0xa6, 0x53, 0xcf, 0x52, 0x3b, 0x27, 0xe1, 0xfc, 0x80, 0x76, 0x2f, 0xd9, 0x35, 0xcd, 0x2c,
-	0xb5, 0x57, 0xf2, 0x5d, 0xd3, 0x11, 0xec, 0x4b, 0x36, 0x0e, 0xba, 0x07, 0x0b, 0x09, 0x61, 0x47,
-	0x81, 0x4f, 0x98, 0xde, 0x8a, 0x55, 0x69, 0xe7, 0x4a, 0x96, 0xda, 0x4b, 0x39, 0x47, 0xac, 0x46,
-	0xb9, 0x17, 0xcf, 0x60, 0x51, 0x4b, 0x56, 0x91, 0x3f, 0x50, 0x9b, 0xb1, 0x26, 0x25, 0x17, 0xb2,
-	0xd4, 0x2e, 0x50, 0x71, 0xe1, 0x8c, 0x3e, 0x84, 0x1a, 0xa7, 0x03, 0x12, 0xc9, 0x09, 0x53, 0xdf,
-	0x5e, 0x2e, 0xa7, 0xad, 0xdd, 0x3e, 0x70, 0xea, 0x3a, 0x67, 0xa6, 0xe7, 0x71, 0xac, 0xc0, 0xe8,
-	0x26, 0xcc, 0x27, 0x41, 0x2f, 0xf2, 0xf8, 0x88, 0x91, 0xe6, 0x9c, 0xbc, 0xa4, 0x91, 0xa5, 0xf6,
-	0x94, 0x88, 0xa7, 0x47, 0x9d, 0x8a, 0x93, 0x19, 0x58, 0x7d, 0x6b, 0xbf, 0x20, 0x02, 0xcb, 0x43,
-	0xef, 0x5b, 0xca, 0x02, 0x3e, 0xc6, 0x24, 0x89, 0x69, 0x94, 0xc8, 0x1e, 0x30, 0xcf, 0xd7, 0xb3,
-	0x4c, 0x67, 0x8e, 0x71, 0xae, 0x6b, 0xe3, 0x50, 0x2e, 0xed, 0xb2, 0x5c, 0x1c, 0x9f, 0xd7, 0x88,
-	0x3a, 0xb0, 0x34, 0x0c, 0xa2, 0x12, 0xf1, 0xe2, 0xae, 0x29, 0xdf, 0x92, 0x97, 0xed, 0x72, 0x2e,
-	0x3c, 0xb9, 0x05, 0x9f, 0xd3, 0x87, 0x38, 0x2c, 0x32, 0x12, 0x53, 0xc6, 0x09, 0xcb, 0x57, 0x8e,
-	0x29, 0x9b, 0xf9, 0x73, 0xa1, 0x21, 0x67, 0x25, 0xff, 0x6c, 0xef, 0x9c, 0xbd, 0x42, 0x07, 0xf9,
-	0xb9, 0x01, 0x8d, 0x92, 0xe9, 0xe5, 0x4c, 0x19, 0x97, 0x67, 0x0a, 0xdd, 0x80, 0x2b, 0xac, 0x18,
-	0x96, 0x79, 0x55, 0xec, 0xb1, 0x37, 0x0e, 0xa9, 0xd7, 0xc5, 0x13, 0x26, 0xba, 0xaf, 0xc7, 0x98,
-	0xf4, 0xec, 0x92, 0xb1, 0xea, 0x34, 0x74, 0xe4, 0x14, 0x1c, 0xab, 0x8f, 0x36, 0xf6, 0x0f, 0x03,
-	0xcc, 0x76, 0xfb, 0x40, 0x74, 0xd8, 0x11, 0x61, 0xa2, 0x0d, 0xb4, 0x81, 0xf2, 0x52, 0x4d, 0xc2,
-	0xf9, 0x01, 0x3d, 0x80, 0x95, 0xf2, 0x1b, 0x30, 0x0c, 0xfc, 0xfc, 0xb9, 0x34, 0xaf, 0x26, 0xa5,
-	0x7e, 0x33, 0xca, 0xc6, 0xb8, 0x10, 0x8c, 0xee, 0xc1, 0xa2, 0x1f, 0x06, 0x24, 0xe2, 0x53, 0x79,
-	0x73, 0xfa, 0xe6, 0x54, 0xac, 0x89, 0x8a, 0xb3, 0x50, 0xd4, 0x2e, 0x99, 0xb0, 0x3f, 0x89, 0x6b,
-	0xf5, 0xa2, 0xb8, 0x5e, 0x08, 0xd5, 0xae, 0xff, 0x62, 0x40, 0xbd, 0xb0, 0x63, 0xd1, 0x4d, 0xa8,
-	0x1f, 0x78, 0xac, 0x47, 0xf8, 0xc3, 0xa8, 0x4b, 0x8e, 0x65, 0x18, 0x4c, 0xf5, 0xa0, 0x0d, 0x04,
-	0x01, 0x17, 0xb9, 0xe2, 0x45, 0xd5, 0xcf, 0x5f, 0x4c, 0x49, 0x73, 0x46, 0x36, 0xc9, 0xbb, 0x5f,
-	0x54, 0x42, 0xc4, 0x65, 0x52, 0x06, 0x17, 0xe4, 0xd1, 0x0e, 0xcc, 0x72, 0xa9, 0x5c, 0xe7, 0xf2,
-	0xad, 0x9a, 0x56, 0xb4, 0x26, 0x4b, 0xc1, 0x95, 0x2e, 0xac, 0x85, 0xb5, 0x5f, 0x8f, 0xa1, 0x26,
-	0xc1, 0xe2, 0x6d, 0x1e, 0xd2, 0xa7, 0xfa, 0x01, 0x59, 0x55, 0xae, 0x48, 0x02, 0x56, 0x1f, 0x01,
-	0x18, 0xc5, 0xb1, 0x5e, 0x5a, 0x1a, 0x20, 0x09, 0x58, 0x7d, 0xb4, 0xc2, 0x00, 0xe6, 0x27, 0x16,
-	0xa0, 0x0d, 0xa8, 0xf6, 0xf3, 0xb9, 0x6d, 0xa9, 0xa9, 0xa6, 0x1e, 0x21, 0x12, 0x22, 0x79, 0xe8,
-	0x63, 0xa8, 0x49, 0xc3, 0x74, 0x5b, 0x5f, 0x3d, 0x53, 0x99, 0xd2, 0x93, 0x49, 0x51, 0x2a, 0x17,
-	0xd4, 0xc7, 0xd9, 0x7b, 0x71, 0xb2, 0x66, 0xbc, 0x3c, 0x59, 0x33, 0x7e, 0x3b, 0x59, 0x33, 0xbe,
-	0x3f, 0x5d, 0xab, 0xbc, 0x3c, 0x5d, 0xab, 0xbc, 0x3a, 0x5d, 0xab, 0x7c, 0x73, 0xe7, 0xaf, 0xf4,
-	0x67, 0xe9, 0xff, 0x23, 0xd9, 0xac, 0x9d, 0x59, 0xf9, 0xbf, 0xcf, 0xed, 0x3f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xa5, 0xfb, 0x71, 0x3c, 0x3c, 0x0d, 0x00, 0x00,
+	0x13, 0x17, 0x4d, 0xc9, 0x8e, 0x47, 0x92, 0x3f, 0x36, 0x0e, 0xfe, 0x74, 0xfe, 0xa8, 0xe9, 0x1a,
+	0x28, 0x62, 0x20, 0x8d, 0x8c, 0x3a, 0x6d, 0x50, 0x04, 0x09, 0x50, 0x31, 0x35, 0x6a, 0xb7, 0x4d,
+	0xe3, 0xac, 0x8d, 0x1e, 0x7a, 0x11, 0x28, 0x6a, 0x2d, 0xb1, 0xa2, 0xb8, 0xec, 0x72, 0xe5, 0x58,
+	0x6f, 0x90, 0x63, 0x1f, 0xa1, 0xe8, 0xa1, 0x87, 0x3c, 0x43, 0x6f, 0xbd, 0xe4, 0x98, 0x4b, 0x81,
+	0x1c, 0x0a, 0xa6, 0xb0, 0x6f, 0x44, 0x9f, 0x20, 0xa7, 0x62, 0x3f, 0x28, 0x91, 0x96, 0xe3, 0x06,
+	0xfd, 0xb8, 0x98, 0xab, 0x99, 0xdf, 0xcc, 0xce, 0xf7, 0xac, 0x61, 0xf5, 0x64, 0x2b, 0xa2, 0x5e,
+	0x9f, 0x70, 0x8f, 0x32, 0xa2, 0x8f, 0x8d, 0x88, 0x51, 0x4e, 0x51, 0xed, 0xa4, 0x31, 0x61, 0x5d,
+	0x5f, 0xe9, 0xd2, 0x2e, 0x95, 0x8c, 0x2d, 0x71, 0x52, 0x98, 0x8d, 0x9f, 0x0d, 0xa8, 0x1f, 0x90,
+	0x38, 0xf6, 0x69, 0xb8, 0x4b, 0xdc, 0x0e, 0x61, 0xe8, 0x13, 0x58, 0x76, 0xa3, 0x28, 0xf0, 0x3d,
+	0x97, 0xfb, 0x34, 0xdc, 0x1f, 0xb6, 0xbf, 0x20, 0x23, 0xcb, 0x58, 0x37, 0x36, 0xe7, 0x1d, 0x94,
+	0x26, 0xf6, 0x82, 0x1b, 0x45, 0xad, 0x68, 0xd8, 0x0e, 0x7c, 0xaf, 0xd5, 0x27, 0x23, 0x3c, 0x0d,
+	0x46, 0x36, 0x54, 0xbc, 0x9e, 0xeb, 0x87, 0xd6, 0x8c, 0x94, 0x9a, 0x4f, 0x13, 0x5b, 0x11, 0xb0,
+	0xfa, 0x20, 0x07, 0x50, 0xac, 0xee, 0x74, 0x02, 0xea, 0xf5, 0x77, 0x89, 0xdf, 0xed, 0x71, 0xcb,
+	0x5c, 0x37, 0x36, 0x4d, 0x75, 0x87, 0xe6, 0xb6, 0x7a, 0x92, 0x83, 0x2f, 0x40, 0xdf, 0x2d, 0x3f,
+	0xfd, 0xc1, 0x2e, 0x6d, 0xbc, 0x34, 0x60, 0x4e, 0x9b, 0x8f, 0x1e, 0x43, 0x3d, 0xce, 0x7b, 0x22,
+	0x8d, 0xae, 0x6e, 0xff, 0xbf, 0x91, 0x0f, 0x43, 0xa3, 0xe0, 0xac, 0xb3, 0xf0, 0x3c, 0xb1, 0x4b,
+	0x69, 0x62, 0xcf, 0xf6, 0xe4, 0x6f, 0x5c, 0xd4, 0x80, 0x3e, 0x02, 0xd0, 0x04, 0x11, 0x04, 0xe1,
+	0x4e, 0xcd, 0xb9, 0x96, 0x26, 0xb6, 0xd9, 0x27, 0xa3, 0xd7, 0x89, 0x0d, 0x07, 0x63, 0x26, 0xce,
+	0x01, 0xd1, 0x7d, 0xa8, 0xe9, 0x5f, 0x5f, 0xd1, 0x0e, 0x89, 0x2d, 0x73, 0xdd, 0xdc, 0xac, 0x39,
+	0xab, 0x22, 0x0e, 0xa1, 0x20, 0x3c, 0x7b, 0x65, 0xd7, 0x0e, 0x72, 0x00, 0x5c, 0x80, 0x6b, 0xd7,
+	0x7e, 0x33, 0xe1, 0xca, 0xc3, 0xb8, 0xfb, 0x20, 0x70, 0xfd, 0xc1, 0x7f, 0xe1, 0xdb, 0x97, 0x00,
+	0x03, 0xc2, 0xfa, 0x01, 0xc1, 0x94, 0x72, 0xe9, 0x5b, 0x75, 0xfb, 0x7f, 0x45, 0x7d, 0xbb, 0x6e,
+	0xdc, 0xc3, 0x6e, 0xd8, 0x25, 0xce, 0x55, 0xad, 0xab, 0xaa, 0x44, 0x5a, 0x8c, 0x52, 0x8e, 0x73,
+	0xf2, 0x68, 0x1b, 0xaa, 0x9c, 0x72, 0x37, 0xd8, 0x67, 0x94, 0x1e, 0xc5, 0x3a, 0x97, 0x4b, 0x69,
+	0x62, 0xd7, 0x24, 0xb9, 0x15, 0x49, 0x3a, 0xce, 0x83, 0x50, 0x17, 0xaa, 0x47, 0x8c, 0x0e, 0x9a,
+	0x9d, 0x0e, 0x23, 0x71, 0x6c, 0x95, 0x65, 0x78, 0x77, 0x84, 0x8c, 0x20, 0xb7, 0x5c, 0x45, 0x7f,
+	0x9d, 0xd8, 0x1f, 0x74, 0x7d, 0xde, 0x1b, 0xb6, 0x1b, 0x1e, 0x1d, 0x6c, 0x45, 0xb4, 0xcf, 0x6f,
+	0x85, 0x84, 0x3f, 0xa1, 0xac, 0xaf, 0xcb, 0xfd, 0x96, 0x2c, 0x7d, 0x3e, 0x8a, 0x48, 0xdc, 0xd0,
+	0xca, 0x70, 0x5e, 0x33, 0xda, 0x81, 0x1a, 0x39, 0xf6, 0x3b, 0x24, 0xf4, 0xc8, 0xe1, 0x28, 0x22,
+	0x56, 0x65, 0xdd, 0xd8, 0xac, 0x38, 0xef, 0xa6, 0x89, 0x5d, 0xcf, 0xe8, 0x2d, 0x21, 0xfe, 0x3a,
+	0xb1, 0x6b, 0x3b, 0x39, 0x20, 0x2e, 0x88, 0xa1, 0x26, 0x2c, 0x91, 0x93, 0xc8, 0x67, 0xb2, 0xd6,
+	0x75, 0xd1, 0xce, 0x4a, 0x47, 0x45, 0x4d, 0x2c, 0x4f, 0x78, 0x59, 0xdd, 0x4e, 0xc1, 0xef, 0x5e,
+	0x11, 0xa9, 0x7d, 0xfa, 0xa3, 0x6d, 0x6c, 0xfc, 0x61, 0x40, 0xfd, 0x61, 0xdc, 0xdd, 0x17, 0x5d,
+	0x28, 0xe3, 0x81, 0x30, 0xe8, 0xe8, 0xca, 0x9f, 0x3a, 0xc3, 0xab, 0xc5, 0x8c, 0x3c, 0x9c, 0x00,
+	0x9c, 0x6b, 0x3a, 0x27, 0x75, 0x9d, 0x93, 0x2c, 0xc4, 0x39, 0x25, 0xe8, 0x0e, 0x94, 0x03, 0xe2,
+	0x1e, 0xe9, 0xf4, 0xae, 0x14, 0x95, 0x49, 0xc8, 0x9e, 0x53, 0xd3, 0x7a, 0x24, 0x12, 0xcb, 0xbf,
+	0x53, 0x11, 0x33, 0xff, 0x56, 0xc4, 0x72, 0xee, 0xfe, 0x64, 0xc0, 0xac, 0xba, 0x0f, 0xdd, 0x05,
+	0x60, 0x24, 0x70, 0x47, 0x79, 0x37, 0xad, 0xa2, 0x65, 0x78, 0xcc, 0xdf, 0x2d, 0xe1, 0x1c, 0x1a,
+	0x3d, 0x86, 0x05, 0xaf, 0xe7, 0x06, 0x01, 0x09, 0xbb, 0x3a, 0x4c, 0xca, 0xb3, 0x1b, 0x45, 0xf9,
+	0x07, 0x05, 0xcc, 0x5e, 0x78, 0xec, 0x06, 0x7e, 0xe7, 0x53, 0x97, 0xbb, 0xbb, 0x25, 0x7c, 0x4e,
+	0x81, 0xea, 0x36, 0x67, 0x0e, 0x2a, 0x32, 0x7e, 0x1b, 0x67, 0x33, 0x50, 0x97, 0x49, 0xc9, 0xdc,
+	0x42, 0x5b, 0x00, 0xed, 0x80, 0xd2, 0x81, 0x33, 0xe2, 0x24, 0x96, 0xf6, 0xd6, 0x9c, 0x45, 0xd1,
+	0x0b, 0x92, 0xda, 0x6a, 0x0b, 0x32, 0xce, 0x41, 0xd0, 0xd7, 0xe7, 0x9b, 0x75, 0xe6, 0xaf, 0x9b,
+	0xf5, 0x6a, 0x9a, 0xd8, 0x8b, 0xe3, 0xd0, 0x5e, 0xdc, 0xb1, 0xb7, 0xa1, 0x1a, 0x0e, 0x07, 0x8f,
+	0x8e, 0x0a, 0x3d, 0xb6, 0x2c, 0x72, 0x12, 0x0e, 0x07, 0x2d, 0x7a, 0x34, 0xae, 0x80, 0x1c, 0x0a,
+	0x7d, 0x06, 0xb3, 0x8a, 0x6c, 0x95, 0xd7, 0xcd, 0x37, 0xd6, 0xc0, 0x6a, 0x36, 0x2b, 0x14, 0xf6,
+	0xd9, 0x2b, 0x7b, 0x4e, 0x71, 0x62, 0xac, 0x49, 0xff, 0x52, 0x13, 0xe9, 0xe1, 0xf6, 0xd4, 0x04,
+	0x98, 0x24, 0x59, 0x4c, 0x0f, 0x46, 0xbe, 0x1b, 0x92, 0x98, 0x8b, 0x91, 0xa3, 0xb7, 0x8d, 0x9c,
+	0x1e, 0x9a, 0xdc, 0xea, 0x89, 0x51, 0x94, 0x07, 0xa1, 0xf7, 0x60, 0x8e, 0x84, 0x9c, 0xd1, 0x48,
+	0x0d, 0x66, 0xd3, 0xa9, 0xa6, 0x89, 0x9d, 0x91, 0x70, 0x76, 0x40, 0xbb, 0x97, 0xec, 0x1a, 0x2b,
+	0x4d, 0xec, 0x95, 0x6c, 0xd7, 0xb4, 0x05, 0xfb, 0x92, 0x8d, 0x83, 0xee, 0xc1, 0x42, 0x4c, 0xd8,
+	0xb1, 0xef, 0x11, 0xa6, 0xb7, 0x62, 0x59, 0xda, 0xb9, 0x92, 0x26, 0xf6, 0x52, 0xc6, 0x11, 0xab,
+	0x51, 0xee, 0xc5, 0x73, 0x58, 0xd4, 0x90, 0x55, 0xe4, 0xf5, 0xd5, 0x66, 0xac, 0x48, 0xc9, 0x85,
+	0x34, 0xb1, 0x73, 0x54, 0x9c, 0x3b, 0xa3, 0x0f, 0xa1, 0xc2, 0x69, 0x9f, 0x84, 0x72, 0xc2, 0x54,
+	0xb7, 0x97, 0x8b, 0x69, 0x6b, 0x36, 0x0f, 0x9d, 0xaa, 0xce, 0x99, 0xe9, 0xba, 0x1c, 0x2b, 0x30,
+	0xba, 0x09, 0xf3, 0xb1, 0xdf, 0x0d, 0x5d, 0x3e, 0x64, 0xc4, 0x9a, 0x93, 0x97, 0xd4, 0xd3, 0xc4,
+	0x9e, 0x10, 0xf1, 0xe4, 0xa8, 0x53, 0x71, 0x3a, 0x03, 0xab, 0x6f, 0xec, 0x17, 0x44, 0x60, 0x79,
+	0xe0, 0x7e, 0x4b, 0x99, 0xcf, 0x47, 0x98, 0xc4, 0x11, 0x0d, 0x63, 0xd9, 0x03, 0xe6, 0x74, 0x3d,
+	0xcb, 0x74, 0x66, 0x18, 0xe7, 0xba, 0x36, 0x0e, 0x65, 0xd2, 0x2d, 0x96, 0x89, 0xe3, 0x69, 0x8d,
+	0xa8, 0x0d, 0x4b, 0x03, 0x3f, 0x2c, 0x10, 0x2f, 0xee, 0x9a, 0xe2, 0x2d, 0x59, 0xd9, 0x2e, 0x67,
+	0xc2, 0xe3, 0x5b, 0xf0, 0x94, 0x3e, 0xc4, 0x61, 0x91, 0x91, 0x88, 0x32, 0x4e, 0x58, 0xb6, 0x72,
+	0x4c, 0xd9, 0xcc, 0x9f, 0x0b, 0x0d, 0x19, 0x2b, 0xfe, 0x67, 0x7b, 0xe7, 0xfc, 0x15, 0x3a, 0xc8,
+	0xcf, 0x0c, 0xa8, 0x17, 0x4c, 0x2f, 0x66, 0xca, 0xb8, 0x3c, 0x53, 0xe8, 0x06, 0x5c, 0x61, 0xf9,
+	0xb0, 0xcc, 0xab, 0x62, 0x8f, 0xdc, 0x51, 0x40, 0xdd, 0x0e, 0x1e, 0x33, 0xd1, 0x7d, 0x3d, 0xc6,
+	0x2c, 0xf3, 0xf2, 0xb1, 0xea, 0xd4, 0x75, 0xe4, 0x14, 0x1c, 0xab, 0x8f, 0x36, 0xf6, 0x97, 0x19,
+	0x30, 0x9b, 0xcd, 0x43, 0xd1, 0x61, 0xc7, 0x84, 0x89, 0x36, 0xb0, 0x8c, 0xc9, 0xa5, 0x9a, 0x84,
+	0xb3, 0x03, 0x7a, 0x00, 0x2b, 0xc5, 0x37, 0x60, 0xe0, 0x7b, 0xd9, 0x73, 0x69, 0x5e, 0x4d, 0x4a,
+	0xfd, 0x66, 0x94, 0x8d, 0x71, 0x21, 0x18, 0xdd, 0x83, 0x45, 0x2f, 0xf0, 0x49, 0xc8, 0x27, 0xf2,
+	0xe6, 0xe4, 0xcd, 0xa9, 0x58, 0x63, 0x15, 0xe7, 0xa1, 0xa8, 0x59, 0x30, 0xe1, 0x60, 0x1c, 0xd7,
+	0xf2, 0x45, 0x71, 0xbd, 0x10, 0x8a, 0xf6, 0x60, 0xa9, 0xeb, 0x72, 0xf2, 0xc4, 0x1d, 0x4d, 0x2c,
+	0x50, 0x5d, 0xfa, 0x4e, 0x9a, 0xd8, 0xab, 0x9a, 0x97, 0x99, 0xf0, 0x3e, 0x1d, 0xf8, 0x9c, 0x0c,
+	0x22, 0x3e, 0xc2, 0x53, 0x62, 0x3a, 0x8a, 0xbf, 0x1a, 0x50, 0xcd, 0xad, 0x6b, 0x74, 0x13, 0xaa,
+	0x87, 0x2e, 0xeb, 0x12, 0xbe, 0x17, 0x76, 0xc8, 0x89, 0x8c, 0xa8, 0xa9, 0xde, 0xc6, 0xbe, 0x20,
+	0xe0, 0x3c, 0x57, 0x3c, 0xce, 0x7a, 0xd9, 0xe3, 0x2b, 0xb6, 0x66, 0xd6, 0xcd, 0xb7, 0x7a, 0x9c,
+	0x09, 0x91, 0x16, 0x93, 0x32, 0x38, 0x27, 0x8f, 0x76, 0x60, 0x96, 0x4b, 0xe5, 0xba, 0x2c, 0xde,
+	0xa8, 0x69, 0x45, 0x6b, 0xaa, 0x29, 0xb8, 0xd2, 0x85, 0xb5, 0xb0, 0xf6, 0xeb, 0x11, 0x54, 0x24,
+	0x58, 0x3c, 0xf3, 0x03, 0xfa, 0x44, 0xbf, 0x45, 0xcb, 0xca, 0x15, 0x49, 0xc0, 0xea, 0x23, 0x00,
+	0xc3, 0x28, 0xd2, 0xfb, 0x4f, 0x03, 0x24, 0x01, 0xab, 0x8f, 0x56, 0xe8, 0xc3, 0xfc, 0xd8, 0x02,
+	0xb4, 0x01, 0xe5, 0x5e, 0xb6, 0x02, 0x6a, 0x6a, 0x40, 0xaa, 0xf7, 0x8c, 0x84, 0x48, 0x1e, 0xfa,
+	0x18, 0x2a, 0xd2, 0x30, 0x3d, 0x21, 0xae, 0x9e, 0x2b, 0x72, 0xe9, 0xc9, 0xb8, 0xbe, 0x95, 0x0b,
+	0xea, 0xe3, 0xec, 0x3f, 0x3f, 0x5d, 0x33, 0x5e, 0x9c, 0xae, 0x19, 0xbf, 0x9f, 0xae, 0x19, 0xdf,
+	0x9f, 0xad, 0x95, 0x5e, 0x9c, 0xad, 0x95, 0x5e, 0x9e, 0xad, 0x95, 0xbe, 0xb9, 0xf3, 0x36, 0xad,
+	0x5e, 0xf8, 0x57, 0x4b, 0xf6, 0x7d, 0x7b, 0x56, 0xfe, 0x1b, 0x75, 0xfb, 0xcf, 0x01, 0x00, 0x9c,
+	0xb4, 0xa9, 0x9f, 0x87, 0x0d, 0x00, 0x00,
 }
 
 func (m *SessionHeader) Marshal() (dAtA []byte, err error) {
@@ -1250,6 +1253,13 @@ func (m *AAT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GatewayPublicKey) > 0 {
+		i -= len(m.GatewayPublicKey)
+		copy(dAtA[i:], m.GatewayPublicKey)
+		i = encodeVarintPocket(dAtA, i, uint64(len(m.GatewayPublicKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.ApplicationSignature) > 0 {
 		i -= len(m.ApplicationSignature)
 		copy(dAtA[i:], m.ApplicationSignature)
@@ -1660,6 +1670,10 @@ func (m *AAT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPocket(uint64(l))
 	}
+	l = len(m.GatewayPublicKey)
+	if l > 0 {
+		n += 1 + l + sovPocket(uint64(l))
+	}
 	return n
 }
 
@@ -3386,6 +3400,38 @@ func (m *AAT) Unmarshal(dAtA []byte) error {
 			}
 			m.ApplicationSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayPublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPocket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPocket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayPublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPocket(dAtA[iNdEx:])
@@ -12,15 +12,15 @@ import (
 
 // "Proof" - An interface representation of an economic proof of work/burn (relay or challenge)
 type Proof interface {
-	Hash() []byte                                                                                        // returns cryptographic hash of bz
-	Bytes() []byte                                                                                       // returns bytes representation
-	HashString() string                                                                                  // returns the hex string representation of the merkleHash
-	ValidateBasic() sdk.Error                                                                            // storeless validation check for the object
-	GetSigner() sdk.Address                                                                              // returns the main signer(s) for the proof (used in messages)
-	SessionHeader() SessionHeader                                                                        // returns the session header
-	Validate(appSupportedBlockchains []string, sessionNodeCount int, sessionBlockHeight int64) sdk.Error // validate the object
-	Store(max sdk.BigInt)                                                                                // handle the proof after validation
-	ToProto() ProofI                                                                                     // convert to protobuf
+	Hash() []byte                                                                                                           // returns cryptographic hash of bz
+	Bytes() []byte                                                                                                          // returns bytes representation
+	HashString() string                                                                                                     // returns the hex string representation of the merkleHash
+	ValidateBasic() sdk.Error                                                                                               // storeless validation check for the object
+	GetSigner() sdk.Address                                                                                                 // returns the main signer(s) for the proof (used in messages)
+	SessionHeader() SessionHeader                                                                                           // returns the session header
+	Validate(appSupportedBlockchains, appGatewayPubKeys []string, sessionNodeCount int, sessionBlockHeight int64) sdk.Error // validate the object
+	Store(max sdk.BigInt)                                                                                                   // handle the proof after validation
+	ToProto() ProofI                                                                                                        // convert to protobuf
 }
 
 type Proofs []Proof
@@ -53,10 +53,30 @@ func (ps ProofIs) FromProofI() (res Proofs) {
 	return
 }
 
+// "withoutGateways" - Returns the proof without the gateways of its delegated tokens
+func withoutGateways(p Proof) Proof {
+	switch p := p.(type) {
+	case RelayProof:
+		p.Token.GatewayPublicKey = ""
+		return p
+	case ChallengeProofInvalidData:
+		majority := make([]RelayResponse, len(p.MajorityResponses))
+		for i, resp := range p.MajorityResponses {
+			resp.Proof.Token.GatewayPublicKey = ""
+			majority[i] = resp
+		}
+		p.MajorityResponses = majority
+		p.MinorityResponse.Proof.Token.GatewayPublicKey = ""
+		return p
+	default:
+		return p
+	}
+}
+
 var _ Proof = RelayProof{} // ensure implements interface at compile time
 
 // "ValidateLocal" - Validates the proof object, where the owner of the proof is the local node
-func (rp RelayProof) ValidateLocal(appSupportedBlockchains []string, sessionNodeCount int, sessionBlockHeight int64, verifyAddr sdk.Address) sdk.Error {
+func (rp RelayProof) ValidateLocal(appSupportedBlockchains, appGatewayPubKeys []string, sessionNodeCount int, sessionBlockHeight int64, verifyAddr sdk.Address) sdk.Error {
 	//Basic Validations
 	err := rp.ValidateBasic()
 	if err != nil {
@@ -70,7 +90,7 @@ func (rp RelayProof) ValidateLocal(appSupportedBlockchains []string, sessionNode
 	if !sdk.Address(servicerPublicKey.Address()).Equals(verifyAddr) {
 		return NewInvalidNodePubKeyError(ModuleName) // the public key is not this nodes, so they would not get paid
 	}
-	err = rp.Validate(appSupportedBlockchains, sessionNodeCount, sessionBlockHeight)
+	err = rp.Validate(appSupportedBlockchains, appGatewayPubKeys, sessionNodeCount, sessionBlockHeight)
 	if err != nil {
 		return err
 	}
@@ -78,7 +98,7 @@ func (rp RelayProof) ValidateLocal(appSupportedBlockchains []string, sessionNode
 }
 
 // "Validate" - Validates the relay proof object
-func (rp RelayProof) Validate(appSupportedBlockchains []string, sessionNodeCount int, sessionBlockHeight int64) sdk.Error {
+func (rp RelayProof) Validate(appSupportedBlockchains, appGatewayPubKeys []string, sessionNodeCount int, sessionBlockHeight int64) sdk.Error {
 	// validate the session block height
 	if rp.SessionBlockHeight != sessionBlockHeight {
 		return NewInvalidBlockHeightError(ModuleName)
@@ -94,6 +114,10 @@ func (rp RelayProof) Validate(appSupportedBlockchains []string, sessionNodeCount
 	if !c1 {
 		return NewUnsupportedBlockchainAppError(ModuleName)
 	}
+	// check the gateway of a delegated token
+	if err := rp.Token.ValidateDelegation(appGatewayPubKeys); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -236,7 +260,8 @@ func (rp RelayProof) GetSigner() sdk.Address {
 var _ Proof = ChallengeProofInvalidData{} // compile time interface implementation
 
 // "ValidateLocal" - Validate local is used to validate a challenge request directly from a client
-func (c ChallengeProofInvalidData) ValidateLocal(h SessionHeader, maxRelays sdk.BigInt, supportedBlockchains []string, sessionNodeCount int, sessionNodes SessionNodes, selfAddr sdk.Address) sdk.Error {
+// the response comparison rules of the chain (optional) reject the challenges without a real data disagreement
+func (c ChallengeProofInvalidData) ValidateLocal(h SessionHeader, maxRelays sdk.BigInt, supportedBlockchains, appGatewayPubKeys []string, sessionNodeCount int, sessionNodes SessionNodes, selfAddr sdk.Address, comparison *ResponseComparison) sdk.Error {
 	// check if verifyPubKey in session (must be in session to do challenges)
 	if !sessionNodes.Contains(selfAddr) {
 		return NewNodeNotInSessionError(ModuleName)
@@ -252,15 +277,19 @@ func (c ChallengeProofInvalidData) ValidateLocal(h SessionHeader, maxRelays sdk.
 	if evidence.NumOfProofs >= maxPossibleChallenges.Int64() {
 		return NewOverServiceError(ModuleName)
 	}
-	err := c.Validate(supportedBlockchains, sessionNodeCount, sessionblockHeight)
+	err := c.Validate(supportedBlockchains, appGatewayPubKeys, sessionNodeCount, sessionblockHeight)
 	if err != nil {
 		return err
 	}
+	// the minority response must also disagree under the comparison rules of the chain
+	if comparison != nil && ResponsesEqual(comparison, c.MajorityResponses[0].Response, c.MinorityResponse.Response) {
+		return NewResponseAgreementError(ModuleName)
+	}
 	return nil
 }
 
 // "Validate" - validate is used to validate a challenge request
-func (c ChallengeProofInvalidData) Validate(appSupportedBlockchains []string, sessionNodeCount int, sessionBlockHeight int64) sdk.Error {
+func (c ChallengeProofInvalidData) Validate(appSupportedBlockchains, appGatewayPubKeys []string, sessionNodeCount int, sessionBlockHeight int64) sdk.Error {
 	majResponse := c.MajorityResponses[0]
 	majResponse2 := c.MajorityResponses[1]
 	// check for duplicates
@@ -293,9 +322,8 @@ func (c ChallengeProofInvalidData) Validate(appSupportedBlockchains []string, se
 		majResponse.Proof.Blockchain != c.MinorityResponse.Proof.Blockchain {
 		return NewMismatchedBlockchainsError(ModuleName)
 	}
-	// check for a true majority minority response
-	majResp, majResp2, minResp := sortJSONResponse(majResponse.Response), sortJSONResponse(majResponse2.Response), sortJSONResponse(c.MinorityResponse.Response)
-	if majResp != majResp2 || minResp == majResp {
+	// check for a true majority minority response; the chain compares the sorted responses, whatever the local rules
+	if !ResponsesEqual(nil, majResponse.Response, majResponse2.Response) || ResponsesEqual(nil, c.MinorityResponse.Response, majResponse.Response) {
 		return NewNoMajorityResponseError(ModuleName)
 	}
 	// check for supported blockchain
@@ -308,6 +336,12 @@ func (c ChallengeProofInvalidData) Validate(appSupportedBlockchains []string, se
 	if !supported {
 		return NewUnsupportedBlockchainAppError(ModuleName)
 	}
+	// check the gateways of delegated tokens
+	for _, resp := range []RelayResponse{majResponse, majResponse2, c.MinorityResponse} {
+		if err := resp.Proof.Token.ValidateDelegation(appGatewayPubKeys); err != nil {
+			return err
+		}
+	}
 	// check signatures
 	pubKey1, err := crypto.NewPublicKey(majResponse.Proof.ServicerPubKey)
 	if err != nil {
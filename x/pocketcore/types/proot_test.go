@@ -157,11 +157,52 @@ func TestRelayProof_ValidateLocal(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.proof.(RelayProof).ValidateLocal([]string{getTestSupportedBlockchain()}, tt.sessionNodeCount, 1, sdk.Address(verifyAddr))!= nil, tt.hasError)
+			assert.Equal(t, tt.proof.(RelayProof).ValidateLocal([]string{getTestSupportedBlockchain()}, nil, tt.sessionNodeCount, 1, sdk.Address(verifyAddr))!= nil, tt.hasError)
 		})
 	}
 }
 
+func TestRelayProof_ValidateDelegatedToken(t *testing.T) {
+	gatewayPrivateKey := GetRandomPrivateKey()
+	clientPrivateKey := GetRandomPrivateKey()
+	appPubKey := getRandomPubKey().RawString()
+	gatewayPubKey := gatewayPrivateKey.PublicKey().RawString()
+	sPK := getRandomPubKey()
+	ethereum := hex.EncodeToString([]byte{01})
+	payload := Payload{Data: "fake"}
+	proof := RelayProof{
+		Entropy:            0,
+		SessionBlockHeight: 1,
+		ServicerPubKey:     sPK.RawString(),
+		RequestHash:        payload.HashString(), // fake
+		Blockchain:         ethereum,
+		Token: AAT{
+			Version:              "0.0.1",
+			ApplicationPublicKey: appPubKey,
+			ClientPublicKey:      clientPrivateKey.PublicKey().RawString(),
+			ApplicationSignature: "",
+			GatewayPublicKey:     gatewayPubKey,
+		},
+		Signature: "",
+	}
+	gatewaySignature, er := gatewayPrivateKey.Sign(proof.Token.Hash())
+	if er != nil {
+		t.Fatalf(er.Error())
+	}
+	proof.Token.ApplicationSignature = hex.EncodeToString(gatewaySignature)
+	clientSignature, er := clientPrivateKey.Sign(proof.Hash())
+	if er != nil {
+		t.Fatalf(er.Error())
+	}
+	proof.Signature = hex.EncodeToString(clientSignature)
+	chains := []string{ethereum}
+	// the application did not delegate the gateway
+	assert.NotNil(t, proof.ValidateLocal(chains, nil, 5, 1, sdk.Address(sPK.Address())))
+	assert.NotNil(t, proof.ValidateLocal(chains, []string{getRandomPubKey().RawString()}, 5, 1, sdk.Address(sPK.Address())))
+	// the application delegated the gateway
+	assert.Nil(t, proof.ValidateLocal(chains, []string{gatewayPubKey}, 5, 1, sdk.Address(sPK.Address())))
+}
+
 func TestRelayProof_Bytes(t *testing.T) {
 	appPubKey := getRandomPubKey().RawString()
 	servicerPubKey := getRandomPubKey().RawString()
@@ -452,6 +493,7 @@ func TestChallengeProofInvalidData_ValidateLocal(t *testing.T) {
 		supportedBlockchains []string
 		sessionNodes         SessionNodes
 		reporterAddress      sdk.Address
+		comparison           *ResponseComparison
 		hasError             bool
 	}{
 		{
@@ -463,6 +505,26 @@ func TestChallengeProofInvalidData_ValidateLocal(t *testing.T) {
 			reporterAddress:      sdk.Address(reporterPubKey.Address()),
 			hasError:             false,
 		},
+		{
+			name:                 "valid proof, data disagreement under the comparison rules",
+			proof:                validChallengeProofIVD,
+			maxRelays:            sdk.NewInt(100000),
+			supportedBlockchains: []string{ethereum},
+			sessionNodes:         sessionNodes,
+			reporterAddress:      sdk.Address(reporterPubKey.Address()),
+			comparison:           &ResponseComparison{CanonicalJSON: true, IgnoredFields: []string{"id"}},
+			hasError:             false,
+		},
+		{
+			name:                 "invalidProof, agreement under the comparison rules",
+			proof:                validChallengeProofIVD,
+			maxRelays:            sdk.NewInt(100000),
+			supportedBlockchains: []string{ethereum},
+			sessionNodes:         sessionNodes,
+			reporterAddress:      sdk.Address(reporterPubKey.Address()),
+			comparison:           &ResponseComparison{IgnoredFields: []string{"result"}},
+			hasError:             true,
+		},
 		{
 			name:                 "invalidProof, reporter (self) not in session",
 			proof:                validChallengeProofIVD,
@@ -516,7 +578,7 @@ func TestChallengeProofInvalidData_ValidateLocal(t *testing.T) {
 				Chain:              tt.proof.MinorityResponse.Proof.Blockchain,
 				SessionBlockHeight: tt.proof.MinorityResponse.Proof.SessionBlockHeight,
 			}
-			if err := tt.proof.ValidateLocal(h, tt.maxRelays, tt.supportedBlockchains, 5, tt.sessionNodes, tt.reporterAddress); (err != nil) != tt.hasError {
+			if err := tt.proof.ValidateLocal(h, tt.maxRelays, tt.supportedBlockchains, nil, 5, tt.sessionNodes, tt.reporterAddress, tt.comparison); (err != nil) != tt.hasError {
 				fmt.Println(tt.name)
 				fmt.Println(err)
 				t.Fatalf(err.Error())
@@ -13,6 +13,7 @@ const (
 	QueryDispatch             = "dispatch"
 	QueryChallenge            = "challenge"
 	QueryParameters           = "parameters"
+	QueryNodeSessions         = "nodeSessions"
 )
 
 // "QueryRelayParams" - The parameters needed to submit a relay request
@@ -30,6 +31,13 @@ type QueryDispatchParams struct {
 	SessionHeader `json:"header"`
 }
 
+// "QueryNodeSessionsParams" - The parameters needed to retrieve the sessions of a node
+type QueryNodeSessionsParams struct {
+	Address sdk.Address `json:"address"`
+	Page    int         `json:"page"`
+	PerPage int         `json:"per_page"`
+}
+
 // "QueryReceiptParams" - The parameters needed to retrieve a receipt obj for a specific instance
 type QueryReceiptParams struct {
 	Address sdk.Address   `json:"address"`
@@ -0,0 +1,259 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// the blocks behind the head of the backends a block number must be to be cached
+const DefaultCacheConfirmations = 64
+
+var (
+	// cache for relay responses of idempotent json-rpc methods
+	globalRelayResponseCache *sdk.Cache
+	// block tags that can never be served from the cache
+	uncacheableBlockTags = []string{`"latest"`, `"pending"`, `"safe"`, `"finalized"`, `"earliest"`}
+	// the index of the block parameter of the ethereum methods taking one, which backends default to "latest"
+	knownBlockParams = map[string]int{
+		"eth_getBalance":                          1,
+		"eth_getCode":                             1,
+		"eth_getTransactionCount":                 1,
+		"eth_getStorageAt":                        2,
+		"eth_call":                                1,
+		"eth_estimateGas":                         1,
+		"eth_getProof":                            2,
+		"eth_feeHistory":                          1,
+		"eth_getBlockByNumber":                    0,
+		"eth_getBlockTransactionCountByNumber":    0,
+		"eth_getUncleCountByBlockNumber":          0,
+		"eth_getTransactionByBlockNumberAndIndex": 0,
+		"eth_getUncleByBlockNumberAndIndex":       0,
+	}
+)
+
+// "RelayCacheRule" - Defines a json-rpc method of a hosted blockchain whose responses may be cached
+type RelayCacheRule struct {
+	Method        string `json:"method"`                  // the json-rpc method
+	TTL           int64  `json:"ttl"`                     // time to live in ms (0 means no time expiry)
+	BlockScoped   bool   `json:"block_scoped"`            // expires once a new block is committed
+	BlockParam    *int   `json:"block_param,omitempty"`   // the index of the block parameter (negative if none), known for the ethereum methods
+	Confirmations int64  `json:"confirmations,omitempty"` // the blocks behind the head a block number must be to be cached (0 means the default)
+}
+
+// "Validate" - Validates the relay cache rule
+func (rule RelayCacheRule) Validate() error {
+	if rule.Method == "" {
+		return fmt.Errorf("the cache rule method is empty")
+	}
+	if rule.TTL < 0 {
+		return fmt.Errorf("the cache rule ttl for %s cannot be negative", rule.Method)
+	}
+	if rule.TTL == 0 && !rule.BlockScoped {
+		return fmt.Errorf("the cache rule for %s needs a ttl or to be block scoped", rule.Method)
+	}
+	if rule.Confirmations < 0 {
+		return fmt.Errorf("the cache rule confirmations for %s cannot be negative", rule.Method)
+	}
+	return nil
+}
+
+// "blockParam" - Returns the index of the block parameter of the method, false if it takes none
+func (rule RelayCacheRule) blockParam() (int, bool) {
+	if rule.BlockParam != nil {
+		return *rule.BlockParam, *rule.BlockParam >= 0
+	}
+	i, ok := knownBlockParams[rule.Method]
+	return i, ok
+}
+
+// "finalized" - Returns whether the block number is final (enough blocks behind the head of the backends)
+func (rule RelayCacheRule) finalized(number, head int64) bool {
+	confirmations := rule.Confirmations
+	if confirmations == 0 {
+		confirmations = DefaultCacheConfirmations
+	}
+	return head > 0 && number <= head-confirmations
+}
+
+// "cachedRelayResponse" - A relay response stored in the cache
+type cachedRelayResponse struct {
+	Response    string
+	BlockHeight int64
+	Expires     time.Time
+}
+
+// "InitRelayResponseCache" - Initializes the relay response cache, a size of 0 disables caching
+func InitRelayResponseCache(size int) {
+	if size <= 0 {
+		globalRelayResponseCache = nil
+		return
+	}
+	globalRelayResponseCache = sdk.NewCache(size)
+}
+
+// "ClearRelayResponseCache" - Clears all items from the relay response cache
+func ClearRelayResponseCache() {
+	if globalRelayResponseCache != nil {
+		globalRelayResponseCache.Purge()
+	}
+}
+
+// "ExecuteWithCache" - Serves the relay from the response cache if possible, else executes it (and caches the response)
+// a cached response is only a replacement for the backend call, the relay is still validated, stored and signed
+func (r Relay) ExecuteWithCache(hostedBlockchains *HostedBlockchains, blockHeight int64) (string, sdk.Error) {
+	if globalRelayResponseCache == nil {
+		return r.Execute(hostedBlockchains)
+	}
+	chain, err := hostedBlockchains.GetChain(r.Proof.Blockchain)
+	if err != nil || len(chain.CacheRules) == 0 {
+		return r.Execute(hostedBlockchains)
+	}
+	rule, key, id, cacheable := relayCacheKey(chain, r.Payload, hostedBlockchains.ChainHeight(r.Proof.Blockchain))
+	if !cacheable {
+		return r.Execute(hostedBlockchains)
+	}
+	// check the cache
+	if res, found := globalRelayResponseCache.Get(key); found {
+		cached := res.(cachedRelayResponse)
+		expired := (rule.BlockScoped && cached.BlockHeight != blockHeight) || (!cached.Expires.IsZero() && time.Now().After(cached.Expires))
+		if !expired {
+			if GlobalServiceMetric() != nil {
+				GlobalServiceMetric().AddCacheHitFor(r.Proof.Blockchain)
+			}
+			return withJSONRPCID(cached.Response, id), nil
+		}
+	}
+	resp, err := r.Execute(hostedBlockchains)
+	if err != nil {
+		return resp, err
+	}
+	// never cache json-rpc errors or null results (e.g. a block the backend does not have yet)
+	var rpcResponse struct {
+		Result json.RawMessage `json:"result"`
+		Error  json.RawMessage `json:"error"`
+	}
+	if er := json.Unmarshal([]byte(resp), &rpcResponse); er != nil || len(rpcResponse.Error) != 0 || len(rpcResponse.Result) == 0 || string(rpcResponse.Result) == "null" {
+		return resp, nil
+	}
+	cached := cachedRelayResponse{Response: resp, BlockHeight: blockHeight}
+	if rule.TTL > 0 {
+		cached.Expires = time.Now().Add(time.Duration(rule.TTL) * time.Millisecond)
+	}
+	globalRelayResponseCache.Add(key, cached)
+	return resp, nil
+}
+
+// "relayCacheKey" - returns the cache rule and key (chain + normalized payload hash) of a json-rpc payload
+// a method taking a block is only cached for an explicit block: a block hash or a number final at the head
+func relayCacheKey(chain HostedBlockchain, p Payload, head int64) (rule RelayCacheRule, key string, id json.RawMessage, cacheable bool) {
+	var request struct {
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+		ID     json.RawMessage `json:"id"`
+	}
+	// batches and non json-rpc payloads are not cached
+	if err := json.Unmarshal([]byte(p.Data), &request); err != nil || request.Method == "" {
+		return
+	}
+	found := false
+	for _, rule = range chain.CacheRules {
+		if rule.Method == request.Method {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return
+	}
+	// normalize the params
+	params := new(bytes.Buffer)
+	if len(request.Params) != 0 {
+		if err := json.Compact(params, request.Params); err != nil {
+			return
+		}
+	}
+	for _, tag := range uncacheableBlockTags {
+		if strings.Contains(params.String(), tag) {
+			return
+		}
+	}
+	if i, ok := rule.blockParam(); ok {
+		var list []json.RawMessage
+		// a missing block defaults to "latest"
+		if err := json.Unmarshal(params.Bytes(), &list); err != nil || i >= len(list) || !explicitBlock(rule, list[i], head) {
+			return
+		}
+	}
+	normalized, err := json.Marshal(struct {
+		Method     string `json:"method"`
+		Params     string `json:"params"`
+		Path       string `json:"path"`
+		HTTPMethod string `json:"http_method"`
+	}{request.Method, params.String(), p.Path, p.Method})
+	if err != nil {
+		return
+	}
+	return rule, chain.ID + hex.EncodeToString(Hash(normalized)), request.ID, true
+}
+
+// "explicitBlock" - returns whether the block parameter is a block hash or a final block number,
+// as a string or an eip-1898 object ({"blockHash": ...} or {"blockNumber": ...})
+func explicitBlock(rule RelayCacheRule, param json.RawMessage, head int64) bool {
+	var block string
+	if err := json.Unmarshal(param, &block); err != nil {
+		var object struct {
+			BlockHash   string `json:"blockHash"`
+			BlockNumber string `json:"blockNumber"`
+		}
+		if err := json.Unmarshal(param, &object); err != nil {
+			return false
+		}
+		if block = object.BlockHash; block == "" {
+			block = object.BlockNumber
+		}
+	}
+	if !strings.HasPrefix(block, "0x") {
+		// a block tag
+		return false
+	}
+	if len(block) == 66 {
+		_, err := hex.DecodeString(block[2:])
+		return err == nil
+	}
+	number, err := strconv.ParseInt(block[2:], 16, 64)
+	return err == nil && rule.finalized(number, head)
+}
+
+// "withJSONRPCID" - replaces the id of a cached json-rpc response with the id of the request
+// only the bytes of the id are replaced, the rest of the response is served as cached
+func withJSONRPCID(response string, id json.RawMessage) string {
+	if len(id) == 0 {
+		return response
+	}
+	dec := json.NewDecoder(strings.NewReader(response))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return response
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return response
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return response
+		}
+		if key == "id" {
+			end := int(dec.InputOffset())
+			return response[:end-len(value)] + string(id) + response[end:]
+		}
+	}
+	return response
+}
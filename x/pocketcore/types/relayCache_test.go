@@ -0,0 +1,111 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestRelay_ExecuteWithCache(t *testing.T) {
+	InitRelayResponseCache(10)
+	defer ClearRelayResponseCache()
+	ethereum := hex.EncodeToString([]byte{01})
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {
+			ID:         ethereum,
+			URL:        "https://server.com/relay/",
+			CacheRules: []RelayCacheRule{{Method: "eth_chainId", BlockScoped: true}},
+		}},
+	}
+	newRelay := func(data string) Relay {
+		r := Relay{
+			Payload: Payload{Data: data, Method: "POST"},
+			Proof:   RelayProof{Blockchain: ethereum},
+		}
+		r.Proof.RequestHash = r.RequestHashString()
+		return r
+	}
+	defer gock.Off()
+	// only a single backend call is mocked
+	gock.New("https://server.com").
+		Post("/relay").
+		Reply(200).
+		BodyString(`{"id":1,"jsonrpc":"2.0","result":"0x1"}`)
+	response, err := newRelay(`{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":1}`).ExecuteWithCache(&hb, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"id":1,"jsonrpc":"2.0","result":"0x1"}`, response)
+	// cache hit, the id is replaced with the one of the request
+	response, err = newRelay(`{"jsonrpc":"2.0", "method":"eth_chainId","params":[],"id":7}`).ExecuteWithCache(&hb, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"id":7,"jsonrpc":"2.0","result":"0x1"}`, response)
+	// block scoped, expired at the next height
+	_, err = newRelay(`{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":1}`).ExecuteWithCache(&hb, 6)
+	assert.NotNil(t, err)
+	// uncacheable methods and block tags
+	_, _, _, cacheable := relayCacheKey(hb.M[ethereum], Payload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`}, 0)
+	assert.False(t, cacheable)
+	for _, tag := range []string{"latest", "pending", "safe", "finalized", "earliest"} {
+		_, _, _, cacheable = relayCacheKey(hb.M[ethereum], Payload{Data: `{"jsonrpc":"2.0","method":"eth_chainId","params":["` + tag + `"],"id":1}`}, 0)
+		assert.False(t, cacheable, tag)
+	}
+}
+
+func TestRelayCacheKey_Block(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	noBlock := -1
+	chain := HostedBlockchain{
+		ID: ethereum,
+		CacheRules: []RelayCacheRule{
+			{Method: "eth_getBalance", TTL: 1000},
+			{Method: "eth_getBlockByNumber", TTL: 1000, Confirmations: 10},
+			{Method: "eth_getTransactionByHash", TTL: 1000, BlockParam: &noBlock},
+		},
+	}
+	hash := `"0x` + hex.EncodeToString(Hash([]byte("block"))) + `"`
+	tests := []struct {
+		name      string
+		data      string
+		head      int64
+		cacheable bool
+	}{
+		{"missing block", `{"method":"eth_getBalance","params":["0x01"]}`, 1000, false},
+		{"block tag", `{"method":"eth_getBalance","params":["0x01","latest"]}`, 1000, false},
+		{"block hash", `{"method":"eth_getBalance","params":["0x01",` + hash + `]}`, 0, true},
+		{"eip-1898 block hash", `{"method":"eth_getBalance","params":["0x01",{"blockHash":` + hash + `}]}`, 0, true},
+		{"final number", `{"method":"eth_getBalance","params":["0x01","0x10"]}`, 1000, true},
+		{"number near the head", `{"method":"eth_getBalance","params":["0x01","0x3c0"]}`, 1000, false},
+		{"number with an unknown head", `{"method":"eth_getBalance","params":["0x01","0x10"]}`, 0, false},
+		{"eip-1898 near the head", `{"method":"eth_getBalance","params":["0x01",{"blockNumber":"0x3e0"}]}`, 1000, false},
+		{"number within the rule confirmations", `{"method":"eth_getBlockByNumber","params":["0x3dc",false]}`, 1000, true},
+		{"number past the rule confirmations", `{"method":"eth_getBlockByNumber","params":["0x3e0",false]}`, 1000, false},
+		{"method without a block", `{"method":"eth_getTransactionByHash","params":[` + hash + `]}`, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, _, cacheable := relayCacheKey(chain, Payload{Data: tt.data}, tt.head)
+			assert.Equal(t, tt.cacheable, cacheable)
+		})
+	}
+}
+
+func TestWithJSONRPCID(t *testing.T) {
+	// the order of the keys and the other values are kept
+	assert.Equal(t, `{"jsonrpc":"2.0","result":{"b":1,"a":[2]},"id":"abc"}`, withJSONRPCID(`{"jsonrpc":"2.0","result":{"b":1,"a":[2]},"id":1}`, json.RawMessage(`"abc"`)))
+	assert.Equal(t, `{"result":{"id":5}, "id" : 7 }`, withJSONRPCID(`{"result":{"id":5}, "id" : 1 }`, json.RawMessage(`7`)))
+	// no id or not a json object
+	assert.Equal(t, `{"result":"0x1"}`, withJSONRPCID(`{"result":"0x1"}`, json.RawMessage(`7`)))
+	assert.Equal(t, `[{"id":1}]`, withJSONRPCID(`[{"id":1}]`, json.RawMessage(`7`)))
+	assert.Equal(t, `{"id":1}`, withJSONRPCID(`{"id":1}`, nil))
+}
+
+func TestRelayCacheRule_Validate(t *testing.T) {
+	assert.Nil(t, RelayCacheRule{Method: "eth_chainId", TTL: 1000}.Validate())
+	assert.Nil(t, RelayCacheRule{Method: "eth_chainId", BlockScoped: true}.Validate())
+	assert.NotNil(t, RelayCacheRule{TTL: 1000}.Validate())
+	assert.NotNil(t, RelayCacheRule{Method: "eth_chainId"}.Validate())
+	assert.NotNil(t, RelayCacheRule{Method: "eth_chainId", TTL: -1}.Validate())
+	assert.NotNil(t, RelayCacheRule{Method: "eth_getBalance", TTL: 1000, Confirmations: -1}.Validate())
+}
@@ -0,0 +1,209 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// "ResponseComparison" - The rules used to compare the responses of a hosted blockchain (challenges) and
+// to normalize its relay responses, so only real data disagreements make two responses differ
+// field paths are dot separated (e.g. result.timestamp) and apply to every element of an array (e.g. a json-rpc batch)
+type ResponseComparison struct {
+	CanonicalJSON    bool     `json:"canonical_json"`               // compare the numbers by value (e.g. 1.0 == 1) and the hex strings case insensitively, the keys are always sorted
+	IgnoredFields    []string `json:"ignored_fields,omitempty"`     // the fields ignored by the comparison (e.g. id)
+	BlockNumberField string   `json:"block_number_field,omitempty"` // the field of a block number (decimal or hex)
+	BlockNumberDrift int64    `json:"block_number_drift,omitempty"` // the block number difference tolerated between two responses
+}
+
+// "Validate" - Validates the response comparison object
+func (rc ResponseComparison) Validate() error {
+	for _, field := range append(rc.IgnoredFields, rc.BlockNumberField) {
+		if strings.HasPrefix(field, ".") || strings.HasSuffix(field, ".") || strings.Contains(field, "..") {
+			return fmt.Errorf("invalid field: %q", field)
+		}
+	}
+	if rc.BlockNumberDrift < 0 {
+		return fmt.Errorf("invalid block number drift: %d", rc.BlockNumberDrift)
+	}
+	if rc.BlockNumberDrift > 0 && rc.BlockNumberField == "" {
+		return fmt.Errorf("a block number drift needs a block number field")
+	}
+	return nil
+}
+
+// "Normalize" - Returns the canonical form of the response: the same data with sorted keys (and normalized numbers if
+// canonical), the ignored fields are kept; responses that are not json are returned as is
+func (rc ResponseComparison) Normalize(response string) string {
+	v, ok := decodeJSONResponse(response)
+	if !ok {
+		return response
+	}
+	if encoded := rc.encode(v); encoded != "" {
+		return encoded
+	}
+	return response
+}
+
+// "NormalizeResponse" - Returns the response normalized by the rules of the chain, or only sorted without rules
+func NormalizeResponse(comparison *ResponseComparison, response string) string {
+	if comparison == nil {
+		return SortJSONResponse(response)
+	}
+	return comparison.Normalize(response)
+}
+
+// "ResponsesEqual" - Returns true if the responses are equal under the rules of the chain, or once sorted without rules
+func ResponsesEqual(comparison *ResponseComparison, a, b string) bool {
+	if comparison == nil {
+		return SortJSONResponse(a) == SortJSONResponse(b)
+	}
+	return comparison.Equal(a, b)
+}
+
+// "Equal" - Returns true if the responses only differ by their formatting, ignored fields and tolerated block drift
+func (rc ResponseComparison) Equal(a, b string) bool {
+	va, okA := decodeJSONResponse(a)
+	vb, okB := decodeJSONResponse(b)
+	if !okA || !okB {
+		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	}
+	for _, field := range rc.IgnoredFields {
+		va = removeField(va, strings.Split(field, "."))
+		vb = removeField(vb, strings.Split(field, "."))
+	}
+	if rc.BlockNumberField != "" && rc.BlockNumberDrift > 0 {
+		path := strings.Split(rc.BlockNumberField, ".")
+		na, foundA := blockNumber(getField(va, path))
+		nb, foundB := blockNumber(getField(vb, path))
+		if foundA && foundB && new(big.Int).Abs(new(big.Int).Sub(na, nb)).Cmp(big.NewInt(rc.BlockNumberDrift)) <= 0 {
+			va = removeField(va, path)
+			vb = removeField(vb, path)
+		}
+	}
+	return rc.encode(va) == rc.encode(vb)
+}
+
+// "encode" - encodes the decoded json with sorted keys (and normalized numbers if canonical)
+func (rc ResponseComparison) encode(v interface{}) string {
+	if rc.CanonicalJSON {
+		v = normalizeNumbers(v)
+	}
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(bz)
+}
+
+// "decodeJSONResponse" - decodes a json object or array, keeping the numbers as written
+func decodeJSONResponse(response string) (v interface{}, ok bool) {
+	d := json.NewDecoder(bytes.NewBufferString(response))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil || d.More() {
+		return nil, false
+	}
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return v, true
+	}
+	return nil, false
+}
+
+// "normalizeNumbers" - formats the numbers by their exact value and lower cases the hex strings;
+// the hex digits are kept as written (e.g. 0x0000 is data, not the quantity 0x0)
+func normalizeNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			t[k] = normalizeNumbers(e)
+		}
+	case []interface{}:
+		for i, e := range t {
+			t[i] = normalizeNumbers(e)
+		}
+	case json.Number:
+		if r, ok := new(big.Rat).SetString(t.String()); ok {
+			return json.Number(exactDecimal(r))
+		}
+	case string:
+		if len(t) > 2 && (strings.HasPrefix(t, "0x") || strings.HasPrefix(t, "0X")) {
+			if _, ok := new(big.Int).SetString(t[2:], 16); ok {
+				return strings.ToLower(t)
+			}
+		}
+	}
+	return v
+}
+
+// "exactDecimal" - returns the shortest decimal of the rational (e.g. 1.50 -> 1.5), never rounded;
+// a rational without a finite decimal is returned as a fraction
+func exactDecimal(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	// the decimals needed are the largest power of 2 or 5 of the denominator
+	d, prec := new(big.Int).Set(r.Denom()), 0
+	for _, factor := range []int64{2, 5} {
+		f, n := big.NewInt(factor), 0
+		for new(big.Int).Mod(d, f).Sign() == 0 {
+			d.Quo(d, f)
+			n++
+		}
+		if n > prec {
+			prec = n
+		}
+	}
+	if d.Cmp(big.NewInt(1)) != 0 {
+		return r.String()
+	}
+	return r.FloatString(prec)
+}
+
+// "getField" - returns the value of the field (nil if not found)
+func getField(v interface{}, path []string) interface{} {
+	for _, key := range path {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+	return v
+}
+
+// "removeField" - removes the field from the objects (and the objects of the arrays)
+func removeField(v interface{}, path []string) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			delete(t, path[0])
+		} else if e, found := t[path[0]]; found {
+			t[path[0]] = removeField(e, path[1:])
+		}
+	case []interface{}:
+		for i, e := range t {
+			t[i] = removeField(e, path)
+		}
+	}
+	return v
+}
+
+// "blockNumber" - parses a decimal or hex block number
+func blockNumber(v interface{}) (*big.Int, bool) {
+	var s string
+	switch t := v.(type) {
+	case json.Number:
+		s = t.String()
+	case string:
+		s = t
+	default:
+		return nil, false
+	}
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return new(big.Int).SetString(s[2:], 16)
+	}
+	return new(big.Int).SetString(s, 10)
+}
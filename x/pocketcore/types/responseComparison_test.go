@@ -0,0 +1,80 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseComparison_Equal(t *testing.T) {
+	rules := ResponseComparison{
+		CanonicalJSON:    true,
+		IgnoredFields:    []string{"id", "result.timestamp"},
+		BlockNumberField: "result.number",
+		BlockNumberDrift: 2,
+	}
+	tests := []struct {
+		name  string
+		a, b  string
+		equal bool
+	}{
+		{"key order", `{"jsonrpc":"2.0","result":"0x1"}`, `{"result":"0x1","jsonrpc":"2.0"}`, true},
+		{"ignored id", `{"id":1,"result":"0x1"}`, `{"id":2,"result":"0x1"}`, true},
+		{"ignored nested field", `{"result":{"hash":"0xab","timestamp":"0x1"}}`, `{"result":{"hash":"0xab","timestamp":"0x2"}}`, true},
+		{"number formatting", `{"result":{"gas":1.0,"price":1.50,"fee":"0xAB"}}`, `{"result":{"gas":1,"price":15e-1,"fee":"0xab"}}`, true},
+		{"hex data is kept as written", `{"result":"0x0000"}`, `{"result":"0x0"}`, false},
+		{"decimals past 18 digits", `{"result":0.1000000000000000001}`, `{"result":0.1000000000000000002}`, false},
+		{"hashes are not quantities", `{"result":"0x00000000000000000000ab"}`, `{"result":"0xab"}`, false},
+		{"tolerated block drift", `{"result":{"number":"0x10","hash":"0xab"}}`, `{"result":{"number":"0x12","hash":"0xab"}}`, true},
+		{"block drift only ignores the block number", `{"result":{"number":"0x10","hash":"0xab"}}`, `{"result":{"number":"0x11","hash":"0xcd"}}`, false},
+		{"block drift exceeded", `{"result":{"number":16}}`, `{"result":{"number":19}}`, false},
+		{"batch ignores every id", `[{"id":1,"result":"0x1"},{"id":2,"result":"0x2"}]`, `[{"id":3,"result":"0x1"},{"id":4,"result":"0x2"}]`, true},
+		{"data disagreement", `{"id":1,"result":"0x1"}`, `{"id":1,"result":"0x2"}`, false},
+		{"not json", "foo", "foo", true},
+		{"not json disagreement", "foo", "bar", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.equal, rules.Equal(tt.a, tt.b))
+		})
+	}
+	// without canonical json the number formatting matters
+	assert.False(t, ResponseComparison{}.Equal(`{"fee":1.0}`, `{"fee":1}`))
+}
+
+func TestResponseComparison_Normalize(t *testing.T) {
+	rules := &ResponseComparison{CanonicalJSON: true, IgnoredFields: []string{"id"}}
+	// the same data in the canonical form of the comparison, the ignored fields are kept
+	assert.Equal(t, `{"id":7,"result":{"a":"0x0000ab","b":1.5}}`, rules.Normalize(`{"result":{"b":1.50,"a":"0x0000AB"},"id":7}`))
+	assert.Equal(t, `[{"id":1,"result":1}]`, rules.Normalize(`[{"result":1.0,"id":1}]`))
+	assert.Equal(t, "foo", rules.Normalize("foo"))
+	// the responses equal under the rules are normalized the same way (apart from the ignored fields)
+	a, b := `{"result":{"gas":1.0,"fee":"0xAB"}}`, `{"result":{"fee":"0xab","gas":1}}`
+	assert.True(t, ResponsesEqual(rules, a, b))
+	assert.Equal(t, NormalizeResponse(rules, a), NormalizeResponse(rules, b))
+	// without rules the responses are only sorted
+	assert.Equal(t, SortJSONResponse(a), NormalizeResponse(nil, a))
+	assert.False(t, ResponsesEqual(nil, a, b))
+	assert.True(t, ResponsesEqual(nil, `{"b":1,"a":2}`, `{"a":2,"b":1}`))
+}
+
+func TestNormalizeNumbers(t *testing.T) {
+	assert.Equal(t, json.Number("1"), normalizeNumbers(json.Number("1.00")))
+	assert.Equal(t, json.Number("1.5"), normalizeNumbers(json.Number("1.5")))
+	assert.Equal(t, json.Number("-0.125"), normalizeNumbers(json.Number("-125e-3")))
+	assert.Equal(t, json.Number("0.1000000000000000000001"), normalizeNumbers(json.Number("0.1000000000000000000001")))
+	assert.Equal(t, json.Number("100"), normalizeNumbers(json.Number("1e2")))
+	assert.Equal(t, "0x0000ab", normalizeNumbers("0x0000AB"))
+	assert.Equal(t, "0xzz", normalizeNumbers("0xzz"))
+	assert.Equal(t, "1/3", exactDecimal(big.NewRat(1, 3)))
+}
+
+func TestResponseComparison_Validate(t *testing.T) {
+	assert.Nil(t, ResponseComparison{IgnoredFields: []string{"id", "result.timestamp"}}.Validate())
+	assert.Nil(t, ResponseComparison{BlockNumberField: "result.number", BlockNumberDrift: 1}.Validate())
+	assert.NotNil(t, ResponseComparison{IgnoredFields: []string{"result..timestamp"}}.Validate())
+	assert.NotNil(t, ResponseComparison{BlockNumberDrift: 1}.Validate())
+	assert.NotNil(t, ResponseComparison{BlockNumberField: "number", BlockNumberDrift: -1}.Validate())
+}
@@ -42,6 +42,10 @@ func (r *Relay) Validate(ctx sdk.Ctx, posKeeper PosKeeper, appsKeeper AppsKeeper
 	if !hb.Contains(r.Proof.Blockchain) {
 		return sdk.ZeroInt(), NewUnsupportedBlockchainNodeError(ModuleName)
 	}
+	// ensure the payload is allowed by the method and path rules of the chain
+	if err := hb.CheckPayload(r.Proof.Blockchain, r.Payload); err != nil {
+		return sdk.ZeroInt(), err
+	}
 	// ensure session block height == one in the relay proof
 	if r.Proof.SessionBlockHeight != sessionBlockHeight {
 		return sdk.ZeroInt(), NewInvalidBlockHeightError(ModuleName)
@@ -80,7 +84,7 @@ func (r *Relay) Validate(ctx sdk.Ctx, posKeeper PosKeeper, appsKeeper AppsKeeper
 		return sdk.ZeroInt(), NewOverServiceError(ModuleName)
 	}
 	// validate the Proof
-	if err := r.Proof.ValidateLocal(app.GetChains(), int(sessionNodeCount), sessionBlockHeight, node); err != nil {
+	if err := r.Proof.ValidateLocal(app.GetChains(), app.GetGatewayPublicKeys(), int(sessionNodeCount), sessionBlockHeight, node); err != nil {
 		return sdk.ZeroInt(), err
 	}
 	// check cache
@@ -125,7 +129,7 @@ func (r Relay) Execute(hostedBlockchains *HostedBlockchains) (string, sdk.Error)
 		url = url + "/" + strings.Trim(r.Payload.Path, `/`)
 	}
 	// do basic http request on the relay
-	res, er := executeHTTPRequest(r.Payload.Data, url, GlobalPocketConfig.UserAgent, chain.BasicAuth, r.Payload.Method, r.Payload.Headers)
+	res, er := executeHTTPRequest(r.Payload.Data, url, GlobalPocketConfig.UserAgent, chain.BasicAuth, r.Payload.Method, r.Payload.Headers, chain.ResponseComparison)
 	if er != nil {
 		// metric track
 		GlobalServiceMetric().AddErrorFor(r.Proof.Blockchain)
@@ -286,7 +290,8 @@ type DispatchSession struct {
 }
 
 // "executeHTTPRequest" takes in the raw json string and forwards it to the RPC endpoint
-func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, method string, headers map[string]string) (string, error) {
+// with JSONSortRelayResponses, the response is normalized by the comparison rules of the chain (see NormalizeResponse)
+func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, method string, headers map[string]string, comparison *ResponseComparison) (string, error) {
 	// generate an http request
 	req, err := http.NewRequest(method, url, bytes.NewBuffer([]byte(payload)))
 	if err != nil {
@@ -318,14 +323,14 @@ func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, met
 	}
 	defer resp.Body.Close()
 	if GlobalPocketConfig.JSONSortRelayResponses {
-		body = []byte(sortJSONResponse(string(body)))
+		body = []byte(NormalizeResponse(comparison, string(body)))
 	}
 	// return
 	return string(body), nil
 }
 
-// "sortJSONResponse" - sorts json from a relay response
-func sortJSONResponse(response string) string {
+// "SortJSONResponse" - sorts json from a relay response (the normalized response compared by challenges)
+func SortJSONResponse(response string) string {
 	var rawJSON map[string]interface{}
 	// unmarshal into json
 	if err := json.Unmarshal([]byte(response), &rawJSON); err != nil {
@@ -187,6 +187,18 @@ func TestRelay_Execute(t *testing.T) {
 	response, err := validRelay.Execute(&hb)
 	assert.True(t, err == nil)
 	assert.Equal(t, response, "bar")
+	// the responses are normalized by the comparison rules of the chain
+	sortResponses := GlobalPocketConfig.JSONSortRelayResponses
+	GlobalPocketConfig.JSONSortRelayResponses = true
+	defer func() { GlobalPocketConfig.JSONSortRelayResponses = sortResponses }()
+	gock.New("https://server.com").
+		Post("/relay").
+		Reply(200).
+		BodyString(`{"result":{"gas":1.50,"hash":"0xAB"},"id":1}`)
+	hb.M[ethereum] = HostedBlockchain{ID: ethereum, URL: "https://server.com/relay/", ResponseComparison: &ResponseComparison{CanonicalJSON: true, IgnoredFields: []string{"id"}}}
+	response, err = validRelay.Execute(&hb)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"id":1,"result":{"gas":1.5,"hash":"0xab"}}`, response)
 }
 
 func TestRelay_HandleProof(t *testing.T) {
@@ -275,8 +287,8 @@ func TestSortJSON(t *testing.T) {
 	j1 := `{"foo":0,"bar":1}`
 	j2 := `{"bar":1,"foo":0}`
 	// sort
-	objs := sortJSONResponse(j1)
-	objs2 := sortJSONResponse(j2)
+	objs := SortJSONResponse(j1)
+	objs2 := SortJSONResponse(j2)
 	// compare
 	assert.Equal(t, objs, objs2)
 }
@@ -396,6 +408,18 @@ func (m MockPosKeeper) StakeDenom(ctx sdk.Ctx) (res string) {
 	panic("implement me")
 }
 
+func (m MockPosKeeper) RelaysToTokensMultiplier(ctx sdk.Ctx) sdk.BigInt {
+	panic("implement me")
+}
+
+func (m MockPosKeeper) DAOAllocation(ctx sdk.Ctx) (res int64) {
+	panic("implement me")
+}
+
+func (m MockPosKeeper) ProposerAllocation(ctx sdk.Ctx) (res int64) {
+	panic("implement me")
+}
+
 func makeTestCodec() *codec.Codec {
 	var cdc = codec.NewCodec(types2.NewInterfaceRegistry())
 	auth.RegisterCodec(cdc)
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+)
+
+var (
+	// the signer of the relay responses, claims and proofs (nil -> the private validator key file)
+	globalSigner crypto.Signer
+	signerL      sync.RWMutex
+)
+
+// "RemoteSigner" - Signs with the servicer key of a remote signer connected over a privval socket (tcp or unix)
+type RemoteSigner struct {
+	listener *privval.SignerListenerEndpoint
+	client   *privval.SignerClient
+	pubKey   crypto.PublicKey
+}
+
+var _ crypto.Signer = (*RemoteSigner)(nil)
+
+// "NewRemoteSigner" - Listens on the address and waits (up to the timeout) for the remote signer to connect
+func NewRemoteSigner(listenAddr string, timeout time.Duration, logger log.Logger) (*RemoteSigner, error) {
+	listener, err := privval.NewSignerListener(listenAddr, logger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen for the remote signer on %s: %s", listenAddr, err.Error())
+	}
+	client, err := privval.NewSignerClient(listener)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.WaitForConnection(timeout); err != nil {
+		_ = listener.Stop()
+		return nil, fmt.Errorf("the remote signer did not connect to %s: %s", listenAddr, err.Error())
+	}
+	pk, err := client.GetPubKey()
+	if err != nil {
+		_ = listener.Stop()
+		return nil, fmt.Errorf("unable to retrieve the public key of the remote signer: %s", err.Error())
+	}
+	pubKey, err := crypto.PubKeyToPublicKey(pk)
+	if err != nil {
+		_ = listener.Stop()
+		return nil, err
+	}
+	return &RemoteSigner{listener: listener, client: client, pubKey: pubKey}, nil
+}
+
+// "PublicKey" - The servicer public key (retrieved once, when the signer connected)
+func (rs *RemoteSigner) PublicKey() crypto.PublicKey {
+	return rs.pubKey
+}
+
+// "Sign" - Signs the message remotely; the signature is verified against the servicer public key
+func (rs *RemoteSigner) Sign(msg []byte) ([]byte, error) {
+	sig, err := rs.client.SignBytes(msg)
+	if err != nil {
+		return nil, err
+	}
+	if !rs.pubKey.VerifyBytes(msg, sig) {
+		return nil, fmt.Errorf("the remote signer returned an invalid signature for %s", rs.pubKey.RawString())
+	}
+	return sig, nil
+}
+
+// "Close" - Closes the connection to the remote signer and stops listening
+func (rs *RemoteSigner) Close() error {
+	return rs.listener.Stop()
+}
+
+// "InitSigner" - Sets the signer of the servicer key
+func InitSigner(s crypto.Signer) {
+	signerL.Lock()
+	defer signerL.Unlock()
+	globalSigner = s
+}
+
+// "GetSigner" - Returns the signer of the servicer key; the private validator key file if no signer is set
+func GetSigner() (crypto.Signer, sdk.Error) {
+	signerL.RLock()
+	s := globalSigner
+	signerL.RUnlock()
+	if s != nil {
+		return s, nil
+	}
+	pvKey, err := GetPVKeyFile()
+	if err != nil {
+		return nil, err
+	}
+	pk, er := crypto.PrivKeyToPrivateKey(pvKey.PrivKey)
+	if er != nil {
+		return nil, NewKeybaseError(ModuleName, er)
+	}
+	return pk, nil
+}
+
+// "StopSigner" - Closes the remote signer (if any) and falls back to the private validator key file
+func StopSigner() {
+	signerL.Lock()
+	defer signerL.Unlock()
+	if rs, ok := globalSigner.(*RemoteSigner); ok {
+		_ = rs.Close()
+	}
+	globalSigner = nil
+}
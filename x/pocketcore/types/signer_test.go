@@ -0,0 +1,91 @@
+package types
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/types"
+)
+
+func newTestRemoteSigner(t *testing.T, pv types.PrivValidator) (*RemoteSigner, func()) {
+	dir, err := ioutil.TempDir("", "remote_signer")
+	assert.Nil(t, err)
+	socket := filepath.Join(dir, "signer.sock")
+	// the remote signer dials the node
+	dialer := privval.NewSignerDialerEndpoint(log.NewNopLogger(), privval.DialUnixFn(socket))
+	server := privval.NewSignerServer(dialer, "pocket-test", pv)
+	done := make(chan *RemoteSigner)
+	go func() {
+		rs, err := NewRemoteSigner("unix://"+socket, 5*time.Second, log.NewNopLogger())
+		assert.Nil(t, err)
+		done <- rs
+	}()
+	// wait for the node to listen
+	for i := 0; i < 100; i++ {
+		if _, err := os.Stat(socket); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Nil(t, server.Start())
+	rs := <-done
+	return rs, func() {
+		_ = rs.Close()
+		_ = server.Stop()
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	key := ed25519.GenPrivKey()
+	rs, stop := newTestRemoteSigner(t, types.NewMockPVWithParams(key, false, false))
+	defer stop()
+	expected, err := crypto.PubKeyToPublicKey(key.PubKey())
+	assert.Nil(t, err)
+	assert.Equal(t, expected.RawString(), rs.PublicKey().RawString())
+	msg := []byte("relay response")
+	sig, err := rs.Sign(msg)
+	assert.Nil(t, err)
+	assert.True(t, expected.VerifyBytes(msg, sig))
+}
+
+func TestRemoteSigner_Timeout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "remote_signer")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	_, err = NewRemoteSigner("unix://"+filepath.Join(dir, "signer.sock"), 100*time.Millisecond, log.NewNopLogger())
+	assert.NotNil(t, err)
+}
+
+func TestGetSigner(t *testing.T) {
+	defer InitPVKeyFile(privval.FilePVKey{})
+	InitPVKeyFile(privval.FilePVKey{})
+	_, err := GetSigner()
+	assert.NotNil(t, err)
+	// the private validator key file
+	key := ed25519.GenPrivKey()
+	InitPVKeyFile(privval.FilePVKey{PrivKey: key, PubKey: key.PubKey(), Address: key.PubKey().Address()})
+	s, err := GetSigner()
+	assert.Nil(t, err)
+	assert.Equal(t, key.PubKey().Address(), s.PublicKey().Address())
+	// the remote signer takes precedence
+	remoteKey := ed25519.GenPrivKey()
+	rs, stop := newTestRemoteSigner(t, types.NewMockPVWithParams(remoteKey, false, false))
+	defer stop()
+	InitSigner(rs)
+	s, err = GetSigner()
+	assert.Nil(t, err)
+	assert.Equal(t, remoteKey.PubKey().Address(), s.PublicKey().Address())
+	StopSigner()
+	s, err = GetSigner()
+	assert.Nil(t, err)
+	assert.Equal(t, key.PubKey().Address(), s.PublicKey().Address())
+}
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"bytes"
+	"encoding/csv"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// "SessionSimulation" - The replay of the session generation over a range of heights
+type SessionSimulation struct {
+	FromHeight  int64             `json:"from_height"`
+	ToHeight    int64             `json:"to_height"`
+	Chain       string            `json:"chain,omitempty"`       // the chain filter (empty = every chain)
+	AppPubKey   string            `json:"app_pub_key,omitempty"` // the application filter (empty = every application)
+	Sessions    int64             `json:"sessions"`              // the number of sessions generated
+	Skipped     int64             `json:"skipped"`               // the sessions that could not be generated (not enough eligible nodes)
+	Nodes       []NodeSelection   `json:"nodes"`                 // the selection counts by node
+	AppSessions []AppSessionNodes `json:"app_sessions"`          // the nodes of every session
+	Uniformity  UniformityStats   `json:"uniformity"`
+}
+
+// "NodeSelection" - How often a node was selected in a session, compared to a uniform selection
+type NodeSelection struct {
+	Address    sdk.Address `json:"address"`
+	Selections int64       `json:"selections"`
+	Expected   float64     `json:"expected"` // the expected selections if every eligible node is equally likely to be selected
+}
+
+// "AppSessionNodes" - The nodes of the session of an application
+type AppSessionNodes struct {
+	SessionBlockHeight int64         `json:"session_block_height"`
+	AppPubKey          string        `json:"app_pub_key"`
+	Chain              string        `json:"chain"`
+	Nodes              []sdk.Address `json:"nodes"`
+}
+
+// "UniformityStats" - A chi-square goodness of fit of the selections against a uniform selection
+type UniformityStats struct {
+	ChiSquare        float64 `json:"chi_square"`
+	DegreesOfFreedom int64   `json:"degrees_of_freedom"`
+	PValue           float64 `json:"p_value"`   // the probability of a chi-square at least as large under a uniform selection
+	MinRatio         float64 `json:"min_ratio"` // the lowest selections / expected of a node
+	MaxRatio         float64 `json:"max_ratio"` // the highest selections / expected of a node
+}
+
+// "NewSessionSimulation" - Returns the simulation of the sessions with the node selections and their uniformity
+// (selections and expected selections are by node address)
+func NewSessionSimulation(fromHeight, toHeight int64, chain, appPubKey string, appSessions []AppSessionNodes, skipped int64, selections map[string]int64, expected map[string]float64) SessionSimulation {
+	nodes := make([]NodeSelection, 0, len(expected))
+	for addr, e := range expected {
+		a, _ := sdk.AddressFromHex(addr)
+		nodes = append(nodes, NodeSelection{Address: a, Selections: selections[addr], Expected: e})
+	}
+	// most selected first
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Selections != nodes[j].Selections {
+			return nodes[i].Selections > nodes[j].Selections
+		}
+		return nodes[i].Address.String() < nodes[j].Address.String()
+	})
+	return SessionSimulation{
+		FromHeight:  fromHeight,
+		ToHeight:    toHeight,
+		Chain:       chain,
+		AppPubKey:   appPubKey,
+		Sessions:    int64(len(appSessions)),
+		Skipped:     skipped,
+		Nodes:       nodes,
+		AppSessions: appSessions,
+		Uniformity:  NewUniformityStats(nodes),
+	}
+}
+
+// "NewUniformityStats" - Returns the chi-square statistics of the node selections
+func NewUniformityStats(nodes []NodeSelection) (stats UniformityStats) {
+	stats.MinRatio = math.Inf(1)
+	var n int64
+	for _, node := range nodes {
+		if node.Expected <= 0 {
+			continue
+		}
+		n++
+		diff := float64(node.Selections) - node.Expected
+		stats.ChiSquare += diff * diff / node.Expected
+		ratio := float64(node.Selections) / node.Expected
+		stats.MinRatio = math.Min(stats.MinRatio, ratio)
+		stats.MaxRatio = math.Max(stats.MaxRatio, ratio)
+	}
+	if n == 0 {
+		return UniformityStats{PValue: 1}
+	}
+	stats.DegreesOfFreedom = n - 1
+	stats.PValue = chiSquarePValue(stats.ChiSquare, stats.DegreesOfFreedom)
+	return
+}
+
+// "chiSquarePValue" - Returns the upper tail probability of the chi-square distribution
+// using the Wilson-Hilferty approximation (the chi-square cube root is approximately normal)
+func chiSquarePValue(chiSquare float64, degreesOfFreedom int64) float64 {
+	if degreesOfFreedom <= 0 {
+		return 1
+	}
+	k := float64(degreesOfFreedom)
+	z := (math.Cbrt(chiSquare/k) - (1 - 2/(9*k))) / math.Sqrt(2/(9*k))
+	return 0.5 * math.Erfc(z/math.Sqrt2)
+}
+
+// "CSV" - Returns the node selections and the nodes of the sessions as csv
+func (s SessionSimulation) CSV() (string, error) {
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	records := [][]string{{"address", "selections", "expected"}}
+	for _, n := range s.Nodes {
+		records = append(records, []string{n.Address.String(), strconv.FormatInt(n.Selections, 10), strconv.FormatFloat(n.Expected, 'f', 4, 64)})
+	}
+	records = append(records, []string{}, []string{"session_block_height", "app_pub_key", "chain", "nodes"})
+	for _, as := range s.AppSessions {
+		nodes := make([]string, len(as.Nodes))
+		for i, n := range as.Nodes {
+			nodes[i] = n.String()
+		}
+		records = append(records, []string{strconv.FormatInt(as.SessionBlockHeight, 10), as.AppPubKey, as.Chain, strings.Join(nodes, " ")})
+	}
+	records = append(records, []string{}, []string{"chi_square", "degrees_of_freedom", "p_value", "min_ratio", "max_ratio"},
+		[]string{strconv.FormatFloat(s.Uniformity.ChiSquare, 'f', 4, 64), strconv.FormatInt(s.Uniformity.DegreesOfFreedom, 10),
+			strconv.FormatFloat(s.Uniformity.PValue, 'f', 6, 64), strconv.FormatFloat(s.Uniformity.MinRatio, 'f', 4, 64),
+			strconv.FormatFloat(s.Uniformity.MaxRatio, 'f', 4, 64)})
+	if err := w.WriteAll(records); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUniformityStats(t *testing.T) {
+	// a perfectly uniform selection
+	nodes := []NodeSelection{{Selections: 10, Expected: 10}, {Selections: 10, Expected: 10}, {Selections: 10, Expected: 10}}
+	stats := NewUniformityStats(nodes)
+	assert.Equal(t, float64(0), stats.ChiSquare)
+	assert.Equal(t, int64(2), stats.DegreesOfFreedom)
+	assert.InDelta(t, 1, stats.PValue, 0.01)
+	assert.Equal(t, float64(1), stats.MinRatio)
+	assert.Equal(t, float64(1), stats.MaxRatio)
+	// a skewed selection
+	nodes = []NodeSelection{{Selections: 30, Expected: 10}, {Selections: 0, Expected: 10}, {Selections: 0, Expected: 10}}
+	stats = NewUniformityStats(nodes)
+	assert.Equal(t, float64(60), stats.ChiSquare)
+	assert.Less(t, stats.PValue, 0.001)
+	assert.Equal(t, float64(0), stats.MinRatio)
+	assert.Equal(t, float64(3), stats.MaxRatio)
+	// chi-square of 2 degrees of freedom at the 5% critical value
+	assert.InDelta(t, 0.05, chiSquarePValue(5.991, 2), 0.005)
+	// no nodes
+	assert.Equal(t, UniformityStats{PValue: 1}, NewUniformityStats(nil))
+}
+
+func TestSessionSimulation_CSV(t *testing.T) {
+	addr := sdk.Address(getRandomPubKey().Address())
+	sim := NewSessionSimulation(1, 10, "0001", "", []AppSessionNodes{{
+		SessionBlockHeight: 1,
+		AppPubKey:          "ab",
+		Chain:              "0001",
+		Nodes:              []sdk.Address{addr},
+	}}, 0, map[string]int64{addr.String(): 1}, map[string]float64{addr.String(): 1})
+	assert.Equal(t, int64(1), sim.Sessions)
+	assert.Len(t, sim.Nodes, 1)
+	res, err := sim.CSV()
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(res, "address,selections,expected\n"+addr.String()+",1,1.0000\n"))
+	assert.Contains(t, res, "1,ab,0001,"+addr.String()+"\n")
+}
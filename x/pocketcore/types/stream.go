@@ -0,0 +1,169 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// "StreamType" - The transport used to stream a relay back to the client
+type StreamType string
+
+const (
+	HTTPStream      StreamType = "http"      // chunked http response body
+	WebSocketStream StreamType = "websocket" // websocket subscription
+	streamBufferLen            = 32 * 1024
+)
+
+// "RelayStreamWriter" - Receives the streamed response of a relay, one chunk (or message) at a time
+type RelayStreamWriter interface {
+	WriteChunk(bz []byte) error
+}
+
+// "ExecuteStream" - Attempts to do a streaming request on the non-native blockchain specified
+// returns the hex encoded digest of the data streamed to the client, which the servicer signs in place of a response;
+// the stream is counted as one relay (its proof), however long it lasts
+func (r Relay) ExecuteStream(hostedBlockchains *HostedBlockchains, streamType StreamType, w RelayStreamWriter) (string, sdk.Error) {
+	// retrieve the hosted blockchain url requested
+	chain, err := hostedBlockchains.GetChain(r.Proof.Blockchain)
+	if err != nil {
+		// metric track
+		GlobalServiceMetric().AddErrorFor(r.Proof.Blockchain)
+		return "", err
+	}
+	url := strings.Trim(chain.URL, `/`)
+	if len(r.Payload.Path) > 0 {
+		url = url + "/" + strings.Trim(r.Payload.Path, `/`)
+	}
+	// every chunk sent to the client is written into the digest
+	digest := Hasher.New()
+	var er error
+	switch streamType {
+	case HTTPStream:
+		er = executeHTTPStream(r.Payload.Data, url, GlobalPocketConfig.UserAgent, chain.BasicAuth, r.Payload.Method, r.Payload.Headers, digest, w)
+	case WebSocketStream:
+		er = executeWebSocketStream(r.Payload.Data, url, GlobalPocketConfig.UserAgent, chain.BasicAuth, r.Payload.Headers, digest, w)
+	default:
+		er = fmt.Errorf("unsupported stream type: %s", streamType)
+	}
+	if er != nil {
+		// metric track
+		GlobalServiceMetric().AddErrorFor(r.Proof.Blockchain)
+		return "", NewStreamExecutionError(ModuleName, er)
+	}
+	return hex.EncodeToString(digest.Sum(nil)), nil
+}
+
+// "GetStreamTimeout" - Returns the maximum lifetime of a streamed relay
+func GetStreamTimeout() time.Duration {
+	if GlobalPocketConfig.RelayStreamTimeout <= 0 {
+		return time.Duration(sdk.DefaultRelayStreamTimeout) * time.Millisecond
+	}
+	return time.Duration(GlobalPocketConfig.RelayStreamTimeout) * time.Millisecond
+}
+
+// "executeHTTPStream" - forwards the raw payload to the RPC endpoint and streams the body back chunk by chunk
+func executeHTTPStream(payload, url, userAgent string, basicAuth BasicAuth, method string, headers map[string]string, digest hash.Hash, w RelayStreamWriter) error {
+	// generate an http request
+	req, err := http.NewRequest(method, url, bytes.NewBuffer([]byte(payload)))
+	if err != nil {
+		return err
+	}
+	if basicAuth.Username != "" {
+		req.SetBasicAuth(basicAuth.Username, basicAuth.Password)
+	}
+	if userAgent != "" {
+		req.Header.Set("User-Agent", userAgent)
+	}
+	// add headers if needed
+	if len(headers) == 0 {
+		req.Header.Set("Content-Type", "application/json")
+	} else {
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+	}
+	// execute the request; the whole stream is bound by the stream timeout
+	resp, err := (&http.Client{Timeout: GetStreamTimeout()}).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// ensure code is 200
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected code 200 from stream request got %v", resp.StatusCode)
+	}
+	buf := make([]byte, streamBufferLen)
+	for {
+		n, err := resp.Body.Read(buf)
+		if n > 0 {
+			digest.Write(buf[:n])
+			if er := w.WriteChunk(buf[:n]); er != nil {
+				return er
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// "executeWebSocketStream" - subscribes to the websocket endpoint with the raw payload and
+// forwards every message back to the client until either side closes or the stream times out
+func executeWebSocketStream(payload, url, userAgent string, basicAuth BasicAuth, headers map[string]string, digest hash.Hash, w RelayStreamWriter) error {
+	// the hosted chain url is configured as http(s), the websocket endpoint is the same host
+	url = strings.Replace(url, "http://", "ws://", 1)
+	url = strings.Replace(url, "https://", "wss://", 1)
+	header := http.Header{}
+	for k, v := range headers {
+		header.Set(k, v)
+	}
+	if userAgent != "" {
+		header.Set("User-Agent", userAgent)
+	}
+	if basicAuth.Username != "" {
+		req := http.Request{Header: header}
+		req.SetBasicAuth(basicAuth.Username, basicAuth.Password)
+	}
+	dialer := websocket.Dialer{HandshakeTimeout: globalRPCTimeout * time.Millisecond}
+	conn, _, err := dialer.Dial(url, header)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.SetReadDeadline(time.Now().Add(GetStreamTimeout())); err != nil {
+		return err
+	}
+	// send the subscription request
+	if err := conn.WriteMessage(websocket.TextMessage, []byte(payload)); err != nil {
+		return err
+	}
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				return nil
+			}
+			if ne, ok := err.(interface{ Timeout() bool }); ok && ne.Timeout() {
+				// the stream ran for its maximum lifetime
+				return nil
+			}
+			return err
+		}
+		digest.Write(msg)
+		if err := w.WriteChunk(msg); err != nil {
+			return err
+		}
+	}
+}
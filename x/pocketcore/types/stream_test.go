@@ -0,0 +1,74 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+type testStreamWriter struct {
+	chunks [][]byte
+}
+
+func (w *testStreamWriter) WriteChunk(bz []byte) error {
+	w.chunks = append(w.chunks, append([]byte{}, bz...))
+	return nil
+}
+
+func TestRelay_ExecuteStream(t *testing.T) {
+	clientPrivateKey := GetRandomPrivateKey()
+	clientPubKey := clientPrivateKey.PublicKey().RawString()
+	appPrivateKey := GetRandomPrivateKey()
+	appPubKey := appPrivateKey.PublicKey().RawString()
+	npk := getRandomPubKey()
+	nodePubKey := npk.RawString()
+	ethereum := hex.EncodeToString([]byte{01})
+	validRelay := Relay{
+		Payload: Payload{
+			Data:   "foo",
+			Method: "POST",
+		},
+		Proof: RelayProof{
+			Entropy:            1,
+			SessionBlockHeight: 1,
+			ServicerPubKey:     nodePubKey,
+			Blockchain:         ethereum,
+			Token: AAT{
+				Version:              "0.0.1",
+				ApplicationPublicKey: appPubKey,
+				ClientPublicKey:      clientPubKey,
+				ApplicationSignature: "",
+			},
+			Signature: "",
+		},
+	}
+	validRelay.Proof.RequestHash = validRelay.RequestHashString()
+	defer gock.Off() // Flush pending mocks after test execution
+
+	gock.New("https://server.com").
+		Post("/relay").
+		Reply(200).
+		BodyString("barbarbar")
+
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {
+			ID:  ethereum,
+			URL: "https://server.com/relay/",
+		}},
+	}
+	w := &testStreamWriter{}
+	digest, err := validRelay.ExecuteStream(&hb, HTTPStream, w)
+	assert.Nil(t, err)
+	var streamed []byte
+	for _, c := range w.chunks {
+		streamed = append(streamed, c...)
+	}
+	assert.Equal(t, "barbarbar", string(streamed))
+	assert.Equal(t, hex.EncodeToString(Hash([]byte("barbarbar"))), digest)
+	// unsupported stream type
+	_, err = validRelay.ExecuteStream(&hb, StreamType("foo"), &testStreamWriter{})
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeStreamExecutionError, int(err.Code()))
+}